@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type AttachmentOwner string
+
+const (
+	SongAttachment         AttachmentOwner = "song"
+	SetlistEntryAttachment AttachmentOwner = "setlist_entry"
+)
+
+// MaxAttachmentSize is the largest file in bytes that can be attached.
+const MaxAttachmentSize = 50 << 20
+
+// Attachment is a file such as a recording or sheet music attached to a song
+// or setlist entry. The contents live in a BlobStore under StorageKey,
+// Checksum is the hex encoded SHA-256 of the contents.
+type Attachment struct {
+	ID          int64           `json:"id"`
+	OwnerType   AttachmentOwner `json:"owner_type" gorm:"type:varchar(16);index:attachment_owner"`
+	OwnerID     int64           `json:"owner_id" gorm:"index:attachment_owner"`
+	CreatorID   int64           `json:"creator_id"`
+	Name        string          `json:"name" gorm:"type:varchar(255)"`
+	ContentType string          `json:"content_type" gorm:"type:varchar(127)"`
+	Size        int64           `json:"size"`
+	Checksum    string          `json:"checksum" gorm:"type:char(64)"`
+	StorageKey  string          `json:"-" gorm:"type:varchar(64)"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// BlobStore keeps the contents of attachments by key.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type AttachmentService interface {
+	FetchByID(ctx context.Context, id int64, principal *User) (*Attachment, error)
+	FetchBySong(ctx context.Context, sid int64) ([]Attachment, error)
+	FetchByEntry(ctx context.Context, setlistID, entryID int64, principal *User) ([]Attachment, error)
+	StoreForSong(ctx context.Context, sid int64, name string, content io.Reader, principal *User) (*Attachment, error)
+	StoreForEntry(
+		ctx context.Context,
+		setlistID, entryID int64,
+		name string,
+		content io.Reader,
+		principal *User,
+	) (*Attachment, error)
+	Open(ctx context.Context, id int64, principal *User) (*Attachment, io.ReadSeekCloser, error)
+	AuthSingleRemover[Attachment]
+}
+
+type AttachmentRepository interface {
+	GetByID(ctx context.Context, id int64) (*Attachment, error)
+	GetByOwner(ctx context.Context, owner AttachmentOwner, ownerID int64) ([]Attachment, error)
+	Create(ctx context.Context, attachment *Attachment) error
+	Delete(ctx context.Context, id int64) error
+}
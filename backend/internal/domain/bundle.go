@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -10,6 +11,7 @@ type Bundle struct {
 	ID        int64          `json:"id"`
 	Name      string         `json:"name" gorm:"type:varchar(255);uniqueIndex:name_id" `
 	ParentID  int64          `json:"parent_id" gorm:"uniqueIndex:name_id"`
+	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `json:"-"`
 }
 
@@ -17,13 +19,13 @@ type BundleService interface {
 	Fetcher[Bundle]
 	AuthSingleStorer[Bundle]
 	AuthSingleUpdater[Bundle]
-	AuthSingleRemover[Bundle]
+	AuthVersionedRemover[Bundle]
 }
 
 type BundleRepository interface {
 	Getter[Bundle]
 	Create(ctx context.Context, bundle *Bundle) error
-	Delete(ctx context.Context, bid int64) error
+	Delete(ctx context.Context, bid int64, version time.Time) error
 	Update(ctx context.Context, bundle *Bundle) error
 	GetLeaves(ctx context.Context) (*[]Bundle, error)
 }
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// CalendarToken is the secret in the url of the personal calendar feed of a user, so
+// calendar apps can subscribe without an access token. Rotating the token replaces it.
+type CalendarToken struct {
+	ID        int64     `json:"-"`
+	UserID    int64     `json:"-" gorm:"uniqueIndex"`
+	Token     string    `json:"token" gorm:"type:varchar(64);uniqueIndex"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CalendarEventStatus string
+
+const (
+	EventTentative CalendarEventStatus = "TENTATIVE"
+	EventConfirmed CalendarEventStatus = "CONFIRMED"
+)
+
+// CalendarEvent is a VEVENT in a calendar feed, an event without a duration has none.
+type CalendarEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Status      CalendarEventStatus
+	Start       time.Time
+	Duration    time.Duration
+	Modified    time.Time
+}
+
+// Calendar is a calendar feed, Generated is the time the feed was created and
+// stamps the events that were never modified.
+type Calendar struct {
+	Name      string
+	Events    []CalendarEvent
+	Generated time.Time
+}
+
+type CalendarService interface {
+	FetchFeed(ctx context.Context, principal *User) ([]byte, error)
+	FetchPersonalFeed(ctx context.Context, token string) ([]byte, error)
+	FetchToken(ctx context.Context, principal *User) (*CalendarToken, error)
+	RotateToken(ctx context.Context, principal *User) (*CalendarToken, error)
+	RevokeToken(ctx context.Context, principal *User) error
+}
+
+type CalendarTokenRepository interface {
+	GetByUser(ctx context.Context, uid int64) (*CalendarToken, error)
+	GetByToken(ctx context.Context, token string) (*CalendarToken, error)
+	Save(ctx context.Context, token *CalendarToken) error
+	DeleteByUser(ctx context.Context, uid int64) error
+}
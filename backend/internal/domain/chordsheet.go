@@ -0,0 +1,26 @@
+package domain
+
+// ChordSheet is the parsed form of a song's chord sheet, with the
+// sections in the order they are played.
+type ChordSheet struct {
+	Sections []ChordSheetSection `json:"sections"`
+}
+
+type ChordSheetSection struct {
+	Tag   string           `json:"tag"`
+	Lines []ChordSheetLine `json:"lines"`
+}
+
+// ChordSheetLine holds the lyrics of a line without inline chords,
+// each chord is anchored at the character offset in the lyrics it is played on.
+// Translations holds the same line in the other languages shown, by language.
+type ChordSheetLine struct {
+	Lyrics       string            `json:"lyrics"`
+	Chords       []ChordPosition   `json:"chords"`
+	Translations map[string]string `json:"translations,omitempty"`
+}
+
+type ChordPosition struct {
+	Offset int    `json:"offset"`
+	Symbol string `json:"symbol"`
+}
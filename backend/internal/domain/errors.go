@@ -9,11 +9,14 @@ import (
 type ErrType string
 
 const (
-	NotFound       ErrType = "ResourceNotFound"
-	BadRequest     ErrType = "BadRequest"
-	NotAuthorized  ErrType = "NotAuthorized"
-	Internal       ErrType = "Internal"
-	Initialization ErrType = "Initialization"
+	NotFound             ErrType = "ResourceNotFound"
+	BadRequest           ErrType = "BadRequest"
+	NotAuthorized        ErrType = "NotAuthorized"
+	Internal             ErrType = "Internal"
+	TooLarge             ErrType = "PayloadTooLarge"
+	Initialization       ErrType = "Initialization"
+	PreconditionFailed   ErrType = "PreconditionFailed"
+	PreconditionRequired ErrType = "PreconditionRequired"
 )
 
 type Error struct {
@@ -47,6 +50,12 @@ func (e *Error) Status() int {
 		return http.StatusInternalServerError
 	case NotFound:
 		return http.StatusNotFound
+	case TooLarge:
+		return http.StatusRequestEntityTooLarge
+	case PreconditionFailed:
+		return http.StatusPreconditionFailed
+	case PreconditionRequired:
+		return http.StatusPreconditionRequired
 	default:
 		return http.StatusInternalServerError
 	}
@@ -99,6 +108,27 @@ func NewBadRequestErr(message string) *Error {
 	}
 }
 
+func NewTooLargeErr(message string) *Error {
+	return &Error{
+		Type:    TooLarge,
+		Message: message,
+	}
+}
+
+func NewPreconditionFailedErr(message string) *Error {
+	return &Error{
+		Type:    PreconditionFailed,
+		Message: message,
+	}
+}
+
+func NewPreconditionRequiredErr(message string) *Error {
+	return &Error{
+		Type:    PreconditionRequired,
+		Message: message,
+	}
+}
+
 func NewInitializationErr(message string) *Error {
 	return &Error{
 		Type:    Initialization,
@@ -0,0 +1,21 @@
+package domain
+
+import "context"
+
+// Booklet holds everything that is printed for a setlist:
+// the people assigned to it and a chart for every entry in rank order.
+type Booklet struct {
+	Setlist     Setlist
+	Assignments []UserRole
+	Charts      []Chart
+}
+
+type Chart struct {
+	Sheet       Sheet
+	Arrangement []string
+	Notes       string
+}
+
+type ExportService interface {
+	ExportPDF(ctx context.Context, setlistID int64, principal *User) ([]byte, error)
+}
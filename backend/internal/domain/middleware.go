@@ -4,5 +4,6 @@ import "github.com/gin-gonic/gin"
 
 type MiddlewareHandler interface {
 	AuthenticateUser() gin.HandlerFunc
+	IdentifyUser() gin.HandlerFunc
 	JWTExtractEmail() gin.HandlerFunc
 }
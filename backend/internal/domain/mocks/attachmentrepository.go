@@ -0,0 +1,66 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAttachmentRepository struct {
+	mock.Mock
+}
+
+func (m MockAttachmentRepository) GetByID(ctx context.Context, id int64) (*domain.Attachment, error) {
+	ret := m.Called(ctx, id)
+
+	var r0 *domain.Attachment
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Attachment)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockAttachmentRepository) GetByOwner(ctx context.Context, owner domain.AttachmentOwner, ownerID int64) ([]domain.Attachment, error) {
+	ret := m.Called(ctx, owner, ownerID)
+
+	var r0 []domain.Attachment
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.Attachment)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockAttachmentRepository) Create(ctx context.Context, attachment *domain.Attachment) error {
+	ret := m.Called(ctx, attachment)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockAttachmentRepository) Delete(ctx context.Context, id int64) error {
+	ret := m.Called(ctx, id)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
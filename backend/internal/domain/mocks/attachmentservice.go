@@ -0,0 +1,125 @@
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAttachmentService struct {
+	mock.Mock
+}
+
+func (m MockAttachmentService) FetchByID(ctx context.Context, id int64, principal *domain.User) (*domain.Attachment, error) {
+	ret := m.Called(ctx, id, principal)
+
+	var r0 *domain.Attachment
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Attachment)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockAttachmentService) FetchBySong(ctx context.Context, sid int64) ([]domain.Attachment, error) {
+	ret := m.Called(ctx, sid)
+
+	var r0 []domain.Attachment
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.Attachment)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockAttachmentService) FetchByEntry(ctx context.Context, setlistID int64, entryID int64, principal *domain.User) ([]domain.Attachment, error) {
+	ret := m.Called(ctx, setlistID, entryID, principal)
+
+	var r0 []domain.Attachment
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.Attachment)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockAttachmentService) StoreForSong(ctx context.Context, sid int64, name string, content io.Reader, principal *domain.User) (*domain.Attachment, error) {
+	ret := m.Called(ctx, sid, name, content, principal)
+
+	var r0 *domain.Attachment
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Attachment)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockAttachmentService) StoreForEntry(ctx context.Context, setlistID int64, entryID int64, name string, content io.Reader, principal *domain.User) (*domain.Attachment, error) {
+	ret := m.Called(ctx, setlistID, entryID, name, content, principal)
+
+	var r0 *domain.Attachment
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Attachment)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockAttachmentService) Open(ctx context.Context, id int64, principal *domain.User) (*domain.Attachment, io.ReadSeekCloser, error) {
+	ret := m.Called(ctx, id, principal)
+
+	var r0 *domain.Attachment
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Attachment)
+	}
+
+	var r1 io.ReadSeekCloser
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(io.ReadSeekCloser)
+	}
+
+	var r2 error
+	if ret.Get(2) != nil {
+		r2 = ret.Get(2).(error)
+	}
+
+	return r0, r1, r2
+}
+
+func (m MockAttachmentService) Remove(ctx context.Context, id int64, principal *domain.User) error {
+	ret := m.Called(ctx, id, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
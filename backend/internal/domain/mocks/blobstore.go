@@ -0,0 +1,50 @@
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockBlobStore struct {
+	mock.Mock
+}
+
+func (m MockBlobStore) Put(ctx context.Context, key string, content io.Reader) error {
+	ret := m.Called(ctx, key, content)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockBlobStore) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	ret := m.Called(ctx, key)
+
+	var r0 io.ReadSeekCloser
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(io.ReadSeekCloser)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockBlobStore) Delete(ctx context.Context, key string) error {
+	ret := m.Called(ctx, key)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
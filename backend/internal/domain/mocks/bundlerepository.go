@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/stretchr/testify/mock"
@@ -70,8 +71,8 @@ func (m MockBundleRepository) Create(ctx context.Context, bundle *domain.Bundle)
 	return r0
 }
 
-func (m MockBundleRepository) Delete(ctx context.Context, bid int64) error {
-	ret := m.Called(ctx, bid)
+func (m MockBundleRepository) Delete(ctx context.Context, bid int64, version time.Time) error {
+	ret := m.Called(ctx, bid, version)
 
 	var r0 error
 	if ret.Get(0) != nil {
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/stretchr/testify/mock"
@@ -54,8 +55,8 @@ func (m MockBundleService) Store(ctx context.Context, bundle *domain.Bundle, pri
 	return r0
 }
 
-func (m MockBundleService) Remove(ctx context.Context, bid int64, principal *domain.User) error {
-	ret := m.Called(ctx, bid, principal)
+func (m MockBundleService) Remove(ctx context.Context, bid int64, version time.Time, principal *domain.User) error {
+	ret := m.Called(ctx, bid, version, principal)
 
 	var r0 error
 	if ret.Get(0) != nil {
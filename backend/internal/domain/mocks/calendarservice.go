@@ -0,0 +1,87 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCalendarService struct {
+	mock.Mock
+}
+
+func (m MockCalendarService) FetchFeed(ctx context.Context, principal *domain.User) ([]byte, error) {
+	ret := m.Called(ctx, principal)
+
+	var r0 []byte
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]byte)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockCalendarService) FetchPersonalFeed(ctx context.Context, token string) ([]byte, error) {
+	ret := m.Called(ctx, token)
+
+	var r0 []byte
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]byte)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockCalendarService) FetchToken(ctx context.Context, principal *domain.User) (*domain.CalendarToken, error) {
+	ret := m.Called(ctx, principal)
+
+	var r0 *domain.CalendarToken
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.CalendarToken)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockCalendarService) RotateToken(ctx context.Context, principal *domain.User) (*domain.CalendarToken, error) {
+	ret := m.Called(ctx, principal)
+
+	var r0 *domain.CalendarToken
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.CalendarToken)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockCalendarService) RevokeToken(ctx context.Context, principal *domain.User) error {
+	ret := m.Called(ctx, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
@@ -0,0 +1,66 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCalendarTokenRepository struct {
+	mock.Mock
+}
+
+func (m MockCalendarTokenRepository) GetByUser(ctx context.Context, uid int64) (*domain.CalendarToken, error) {
+	ret := m.Called(ctx, uid)
+
+	var r0 *domain.CalendarToken
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.CalendarToken)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockCalendarTokenRepository) GetByToken(ctx context.Context, token string) (*domain.CalendarToken, error) {
+	ret := m.Called(ctx, token)
+
+	var r0 *domain.CalendarToken
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.CalendarToken)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockCalendarTokenRepository) Save(ctx context.Context, token *domain.CalendarToken) error {
+	ret := m.Called(ctx, token)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockCalendarTokenRepository) DeleteByUser(ctx context.Context, uid int64) error {
+	ret := m.Called(ctx, uid)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
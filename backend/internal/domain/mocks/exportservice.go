@@ -0,0 +1,28 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockExportService struct {
+	mock.Mock
+}
+
+func (m MockExportService) ExportPDF(ctx context.Context, setlistID int64, principal *domain.User) ([]byte, error) {
+	ret := m.Called(ctx, setlistID, principal)
+
+	var r0 []byte
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]byte)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
@@ -124,3 +124,14 @@ func (m MockSetlistEntryRepository) UpdateBatch(ctx context.Context, setlistEntr
 
 	return r0
 }
+
+func (m MockSetlistEntryRepository) UpdateRanks(ctx context.Context, setlistEntries *[]domain.SetlistEntry) error {
+	ret := m.Called(ctx, setlistEntries)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
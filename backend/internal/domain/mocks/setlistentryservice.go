@@ -102,3 +102,87 @@ func (m MockSetlistEntryService) RemoveBySetlist(ctx context.Context, setlist *d
 
 	return r0
 }
+
+func (m MockSetlistEntryService) FetchSheet(ctx context.Context, setlistID, entryID int64, options *domain.SheetOptions) (*domain.Sheet, error) {
+	ret := m.Called(ctx, setlistID, entryID, options)
+
+	var r0 *domain.Sheet
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Sheet)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistEntryService) FetchArrangement(
+	ctx context.Context,
+	setlistID, entryID int64,
+	options *domain.SheetOptions,
+) (*domain.ExpandedArrangement, error) {
+	ret := m.Called(ctx, setlistID, entryID, options)
+
+	var r0 *domain.ExpandedArrangement
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.ExpandedArrangement)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistEntryService) FetchAnalysis(ctx context.Context, setlistID int64) (*domain.SetlistAnalysis, error) {
+	ret := m.Called(ctx, setlistID)
+
+	var r0 *domain.SetlistAnalysis
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.SetlistAnalysis)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistEntryService) FetchTimings(ctx context.Context, setlistEntries *[]domain.SetlistEntry) (map[int64]domain.SetlistTiming, error) {
+	ret := m.Called(ctx, setlistEntries)
+
+	var r0 map[int64]domain.SetlistTiming
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(map[int64]domain.SetlistTiming)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistEntryService) Reorder(ctx context.Context, setlistID int64, order []int64, principal *domain.User) (*[]domain.SetlistEntry, error) {
+	ret := m.Called(ctx, setlistID, order, principal)
+
+	var r0 *[]domain.SetlistEntry
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*[]domain.SetlistEntry)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
@@ -103,8 +103,8 @@ func (m MockSetlistRepository) Create(ctx context.Context, setlist *domain.Setli
 	return r0
 }
 
-func (m MockSetlistRepository) Delete(ctx context.Context, sid int64) error {
-	ret := m.Called(ctx, sid)
+func (m MockSetlistRepository) Delete(ctx context.Context, sid int64, version time.Time) error {
+	ret := m.Called(ctx, sid, version)
 
 	var r0 error
 	if ret.Get(0) != nil {
@@ -38,6 +38,22 @@ func (msrs MockSetlistRoleRepository) Get(ctx context.Context, setlistIDs []int6
 	return r0, r1
 }
 
+func (msrs MockSetlistRoleRepository) GetByUserRoles(ctx context.Context, userRoleIDs []int64) (*[]domain.SetlistRole, error) {
+	ret := msrs.Called(ctx, userRoleIDs)
+
+	var r0 *[]domain.SetlistRole
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*[]domain.SetlistRole)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
 func (msrs MockSetlistRoleRepository) Update(ctx context.Context, setlistRoles *[]domain.SetlistRole) error {
 	ret := msrs.Called(ctx, setlistRoles)
 
@@ -0,0 +1,116 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSetlistSeriesRepository struct {
+	mock.Mock
+}
+
+func (m MockSetlistSeriesRepository) GetByID(ctx context.Context, sid int64) (*domain.SetlistSeries, error) {
+	ret := m.Called(ctx, sid)
+
+	var r0 *domain.SetlistSeries
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.SetlistSeries)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistSeriesRepository) GetAll(ctx context.Context) (*[]domain.SetlistSeries, error) {
+	ret := m.Called(ctx)
+
+	var r0 *[]domain.SetlistSeries
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*[]domain.SetlistSeries)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistSeriesRepository) Create(ctx context.Context, series *domain.SetlistSeries) error {
+	ret := m.Called(ctx, series)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistSeriesRepository) Update(ctx context.Context, series *domain.SetlistSeries) error {
+	ret := m.Called(ctx, series)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistSeriesRepository) Delete(ctx context.Context, sid int64) error {
+	ret := m.Called(ctx, sid)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistSeriesRepository) GetOccurrences(ctx context.Context, sid int64, from time.Time, to time.Time) (*[]domain.SeriesOccurrence, error) {
+	ret := m.Called(ctx, sid, from, to)
+
+	var r0 *[]domain.SeriesOccurrence
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*[]domain.SeriesOccurrence)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistSeriesRepository) CreateOccurrence(ctx context.Context, occurrence *domain.SeriesOccurrence) error {
+	ret := m.Called(ctx, occurrence)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistSeriesRepository) DeleteOccurrence(ctx context.Context, sid int64, date time.Time) error {
+	ret := m.Called(ctx, sid, date)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
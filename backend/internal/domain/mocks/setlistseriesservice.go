@@ -0,0 +1,132 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSetlistSeriesService struct {
+	mock.Mock
+}
+
+func (m MockSetlistSeriesService) FetchByID(ctx context.Context, sid int64) (*domain.SetlistSeries, error) {
+	ret := m.Called(ctx, sid)
+
+	var r0 *domain.SetlistSeries
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.SetlistSeries)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistSeriesService) FetchAll(ctx context.Context) (*[]domain.SetlistSeries, error) {
+	ret := m.Called(ctx)
+
+	var r0 *[]domain.SetlistSeries
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*[]domain.SetlistSeries)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistSeriesService) Store(ctx context.Context, series *domain.SetlistSeries, principal *domain.User) error {
+	ret := m.Called(ctx, series, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistSeriesService) Update(ctx context.Context, series *domain.SetlistSeries, principal *domain.User) error {
+	ret := m.Called(ctx, series, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistSeriesService) Remove(ctx context.Context, sid int64, principal *domain.User) error {
+	ret := m.Called(ctx, sid, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistSeriesService) Preview(ctx context.Context, sid int64, from time.Time, to time.Time) (*[]domain.SeriesOccurrence, error) {
+	ret := m.Called(ctx, sid, from, to)
+
+	var r0 *[]domain.SeriesOccurrence
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*[]domain.SeriesOccurrence)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistSeriesService) Materialize(ctx context.Context, sid int64, until time.Time, principal *domain.User) (*[]domain.Setlist, error) {
+	ret := m.Called(ctx, sid, until, principal)
+
+	var r0 *[]domain.Setlist
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*[]domain.Setlist)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistSeriesService) Skip(ctx context.Context, sid int64, date time.Time, principal *domain.User) error {
+	ret := m.Called(ctx, sid, date, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistSeriesService) Unskip(ctx context.Context, sid int64, date time.Time, principal *domain.User) error {
+	ret := m.Called(ctx, sid, date, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
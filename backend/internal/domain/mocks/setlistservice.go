@@ -12,8 +12,8 @@ type MockSetlistService struct {
 	mock.Mock
 }
 
-func (m MockSetlistService) FetchByID(ctx context.Context, slid int64) (*domain.Setlist, error) {
-	ret := m.Called(ctx, slid)
+func (m MockSetlistService) FetchByID(ctx context.Context, slid int64, principal *domain.User) (*domain.Setlist, error) {
+	ret := m.Called(ctx, slid, principal)
 
 	var r0 *domain.Setlist
 	if ret.Get(0) != nil {
@@ -28,8 +28,8 @@ func (m MockSetlistService) FetchByID(ctx context.Context, slid int64) (*domain.
 	return r0, r1
 }
 
-func (m MockSetlistService) FetchAll(ctx context.Context) (*[]domain.Setlist, error) {
-	ret := m.Called(ctx)
+func (m MockSetlistService) FetchAll(ctx context.Context, principal *domain.User) (*[]domain.Setlist, error) {
+	ret := m.Called(ctx, principal)
 
 	var r0 *[]domain.Setlist
 	if ret.Get(0) != nil {
@@ -44,8 +44,8 @@ func (m MockSetlistService) FetchAll(ctx context.Context) (*[]domain.Setlist, er
 	return r0, r1
 }
 
-func (m MockSetlistService) Fetch(ctx context.Context, from time.Time, to time.Time) (*[]domain.Setlist, error) {
-	ret := m.Called(ctx, from, to)
+func (m MockSetlistService) Fetch(ctx context.Context, from time.Time, to time.Time, principal *domain.User) (*[]domain.Setlist, error) {
+	ret := m.Called(ctx, from, to, principal)
 
 	var r0 *[]domain.Setlist
 	if ret.Get(0) != nil {
@@ -60,8 +60,8 @@ func (m MockSetlistService) Fetch(ctx context.Context, from time.Time, to time.T
 	return r0, r1
 }
 
-func (m MockSetlistService) FetchByTimeframe(ctx context.Context, from time.Time, to time.Time) (*[]domain.Setlist, error) {
-	ret := m.Called(ctx, from, to)
+func (m MockSetlistService) FetchByTimeframe(ctx context.Context, from time.Time, to time.Time, principal *domain.User) (*[]domain.Setlist, error) {
+	ret := m.Called(ctx, from, to, principal)
 
 	var r0 *[]domain.Setlist
 	if ret.Get(0) != nil {
@@ -103,8 +103,8 @@ func (m MockSetlistService) Store(ctx context.Context, setlist *domain.Setlist,
 	return r0
 }
 
-func (m MockSetlistService) Remove(ctx context.Context, slid int64, principal *domain.User) error {
-	ret := m.Called(ctx, slid, principal)
+func (m MockSetlistService) Remove(ctx context.Context, slid int64, version time.Time, principal *domain.User) error {
+	ret := m.Called(ctx, slid, version, principal)
 
 	var r0 error
 	if ret.Get(0) != nil {
@@ -113,3 +113,51 @@ func (m MockSetlistService) Remove(ctx context.Context, slid int64, principal *d
 
 	return r0
 }
+
+func (m MockSetlistService) Publish(ctx context.Context, slid int64, principal *domain.User) (*domain.Setlist, error) {
+	ret := m.Called(ctx, slid, principal)
+
+	var r0 *domain.Setlist
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Setlist)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistService) Unpublish(ctx context.Context, slid int64, principal *domain.User) (*domain.Setlist, error) {
+	ret := m.Called(ctx, slid, principal)
+
+	var r0 *domain.Setlist
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Setlist)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistService) Archive(ctx context.Context, slid int64, principal *domain.User) (*domain.Setlist, error) {
+	ret := m.Called(ctx, slid, principal)
+
+	var r0 *domain.Setlist
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Setlist)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,77 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSetlistTemplateRepository struct {
+	mock.Mock
+}
+
+func (m MockSetlistTemplateRepository) GetByID(ctx context.Context, tid int64) (*domain.SetlistTemplate, error) {
+	ret := m.Called(ctx, tid)
+
+	var r0 *domain.SetlistTemplate
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.SetlistTemplate)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistTemplateRepository) GetAll(ctx context.Context) (*[]domain.SetlistTemplate, error) {
+	ret := m.Called(ctx)
+
+	var r0 *[]domain.SetlistTemplate
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*[]domain.SetlistTemplate)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistTemplateRepository) Create(ctx context.Context, template *domain.SetlistTemplate) error {
+	ret := m.Called(ctx, template)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistTemplateRepository) Update(ctx context.Context, template *domain.SetlistTemplate) error {
+	ret := m.Called(ctx, template)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistTemplateRepository) Delete(ctx context.Context, tid int64) error {
+	ret := m.Called(ctx, tid)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
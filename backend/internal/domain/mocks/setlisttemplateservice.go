@@ -0,0 +1,109 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSetlistTemplateService struct {
+	mock.Mock
+}
+
+func (m MockSetlistTemplateService) FetchByID(ctx context.Context, tid int64) (*domain.SetlistTemplate, error) {
+	ret := m.Called(ctx, tid)
+
+	var r0 *domain.SetlistTemplate
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.SetlistTemplate)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistTemplateService) FetchAll(ctx context.Context) (*[]domain.SetlistTemplate, error) {
+	ret := m.Called(ctx)
+
+	var r0 *[]domain.SetlistTemplate
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*[]domain.SetlistTemplate)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistTemplateService) Store(ctx context.Context, template *domain.SetlistTemplate, principal *domain.User) error {
+	ret := m.Called(ctx, template, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistTemplateService) Update(ctx context.Context, template *domain.SetlistTemplate, principal *domain.User) error {
+	ret := m.Called(ctx, template, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistTemplateService) Remove(ctx context.Context, tid int64, principal *domain.User) error {
+	ret := m.Called(ctx, tid, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSetlistTemplateService) Instantiate(ctx context.Context, tid int64, setlist *domain.Setlist, principal *domain.User) (*domain.CopiedSetlist, error) {
+	ret := m.Called(ctx, tid, setlist, principal)
+
+	var r0 *domain.CopiedSetlist
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.CopiedSetlist)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSetlistTemplateService) Clone(ctx context.Context, sid int64, setlist *domain.Setlist, principal *domain.User) (*domain.CopiedSetlist, error) {
+	ret := m.Called(ctx, sid, setlist, principal)
+
+	var r0 *domain.CopiedSetlist
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.CopiedSetlist)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/stretchr/testify/mock"
@@ -59,6 +60,38 @@ func (m MockSongRepository) Get(ctx context.Context, options *domain.SongFilterO
 	return r0, r1
 }
 
+func (m MockSongRepository) Count(ctx context.Context, options *domain.SongFilterOptions) (int64, error) {
+	ret := m.Called(ctx, options)
+
+	var r0 int64
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongRepository) CountTags(ctx context.Context, options *domain.SongFilterOptions) ([]domain.TagCount, error) {
+	ret := m.Called(ctx, options)
+
+	var r0 []domain.TagCount
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.TagCount)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
 func (m MockSongRepository) Create(ctx context.Context, song *domain.Song) error {
 	ret := m.Called(ctx, song)
 
@@ -70,8 +103,8 @@ func (m MockSongRepository) Create(ctx context.Context, song *domain.Song) error
 	return r0
 }
 
-func (m MockSongRepository) Delete(ctx context.Context, sid int64) error {
-	ret := m.Called(ctx, sid)
+func (m MockSongRepository) Delete(ctx context.Context, sid int64, version time.Time) error {
+	ret := m.Called(ctx, sid, version)
 
 	var r0 error
 	if ret.Get(0) != nil {
@@ -91,3 +124,14 @@ func (m MockSongRepository) Update(ctx context.Context, song *domain.Song) error
 
 	return r0
 }
+
+func (m MockSongRepository) Merge(ctx context.Context, sid int64, duplicateIDs []int64) error {
+	ret := m.Called(ctx, sid, duplicateIDs)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
@@ -0,0 +1,55 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSongRevisionRepository struct {
+	mock.Mock
+}
+
+func (m MockSongRevisionRepository) GetByID(ctx context.Context, id int64) (*domain.SongRevision, error) {
+	ret := m.Called(ctx, id)
+
+	var r0 *domain.SongRevision
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.SongRevision)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongRevisionRepository) GetBySong(ctx context.Context, sid int64) ([]domain.SongRevision, error) {
+	ret := m.Called(ctx, sid)
+
+	var r0 []domain.SongRevision
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.SongRevision)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongRevisionRepository) Create(ctx context.Context, revision *domain.SongRevision) error {
+	ret := m.Called(ctx, revision)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
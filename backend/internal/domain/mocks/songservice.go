@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/stretchr/testify/mock"
@@ -42,12 +43,28 @@ func (m MockSongService) FetchAll(ctx context.Context) (*[]domain.Song, error) {
 	return r0, r1
 }
 
-func (m MockSongService) Fetch(ctx context.Context, options *domain.SongFilterOptions) ([]domain.Song, error) {
+func (m MockSongService) Fetch(ctx context.Context, options *domain.SongFilterOptions) (*domain.SongPage, error) {
 	ret := m.Called(ctx, options)
 
-	var r0 []domain.Song
+	var r0 *domain.SongPage
 	if ret.Get(0) != nil {
-		r0 = ret.Get(0).([]domain.Song)
+		r0 = ret.Get(0).(*domain.SongPage)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongService) FetchSheet(ctx context.Context, sid int64, options *domain.SheetOptions) (*domain.Sheet, error) {
+	ret := m.Called(ctx, sid, options)
+
+	var r0 *domain.Sheet
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Sheet)
 	}
 
 	var r1 error
@@ -78,8 +95,142 @@ func (m MockSongService) Store(ctx context.Context, song *domain.Song, principal
 
 	return r0
 }
-func (m MockSongService) Remove(ctx context.Context, sid int64, principal *domain.User) error {
-	ret := m.Called(ctx, sid, principal)
+func (m MockSongService) Remove(ctx context.Context, sid int64, version time.Time, principal *domain.User) error {
+	ret := m.Called(ctx, sid, version, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSongService) FetchRevisions(ctx context.Context, sid int64) ([]domain.SongRevision, error) {
+	ret := m.Called(ctx, sid)
+
+	var r0 []domain.SongRevision
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.SongRevision)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongService) FetchRevisionDiff(ctx context.Context, sid, fromID, toID int64) (*domain.SongRevisionDiff, error) {
+	ret := m.Called(ctx, sid, fromID, toID)
+
+	var r0 *domain.SongRevisionDiff
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.SongRevisionDiff)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongService) Revert(ctx context.Context, sid, revisionID int64, principal *domain.User) (*domain.Song, error) {
+	ret := m.Called(ctx, sid, revisionID, principal)
+
+	var r0 *domain.Song
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Song)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongService) Search(ctx context.Context, query string, limit int) ([]domain.SongSearchResult, error) {
+	ret := m.Called(ctx, query, limit)
+
+	var r0 []domain.SongSearchResult
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.SongSearchResult)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongService) FetchDuplicates(ctx context.Context, threshold float64) ([]domain.DuplicateGroup, error) {
+	ret := m.Called(ctx, threshold)
+
+	var r0 []domain.DuplicateGroup
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.DuplicateGroup)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongService) Merge(ctx context.Context, sid int64, duplicateIDs []int64, principal *domain.User) (*domain.Song, error) {
+	ret := m.Called(ctx, sid, duplicateIDs, principal)
+
+	var r0 *domain.Song
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Song)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongService) FetchTranslations(ctx context.Context, sid int64) ([]domain.SongTranslation, error) {
+	ret := m.Called(ctx, sid)
+
+	var r0 []domain.SongTranslation
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.SongTranslation)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongService) StoreTranslation(ctx context.Context, translation *domain.SongTranslation, principal *domain.User) error {
+	ret := m.Called(ctx, translation, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSongService) RemoveTranslation(ctx context.Context, sid int64, language string, principal *domain.User) error {
+	ret := m.Called(ctx, sid, language, principal)
 
 	var r0 error
 	if ret.Get(0) != nil {
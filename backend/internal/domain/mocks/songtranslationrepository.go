@@ -0,0 +1,50 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSongTranslationRepository struct {
+	mock.Mock
+}
+
+func (m MockSongTranslationRepository) GetBySong(ctx context.Context, sid int64) ([]domain.SongTranslation, error) {
+	ret := m.Called(ctx, sid)
+
+	var r0 []domain.SongTranslation
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.SongTranslation)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockSongTranslationRepository) Save(ctx context.Context, translation *domain.SongTranslation) error {
+	ret := m.Called(ctx, translation)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockSongTranslationRepository) Delete(ctx context.Context, sid int64, language string) error {
+	ret := m.Called(ctx, sid, language)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockStatsRepository struct {
+	mock.Mock
+}
+
+func (m MockStatsRepository) GetPlayCounts(ctx context.Context, options *domain.PlayFilterOptions) ([]domain.SongStats, error) {
+	ret := m.Called(ctx, options)
+
+	var r0 []domain.SongStats
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.SongStats)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockStatsRepository) GetTransposeCounts(
+	ctx context.Context,
+	options *domain.PlayFilterOptions,
+) ([]domain.TransposeCount, error) {
+	ret := m.Called(ctx, options)
+
+	var r0 []domain.TransposeCount
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.TransposeCount)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
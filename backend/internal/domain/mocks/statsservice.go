@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockStatsService struct {
+	mock.Mock
+}
+
+func (m MockStatsService) FetchSongStats(ctx context.Context, sid int64) (*domain.SongStats, error) {
+	ret := m.Called(ctx, sid)
+
+	var r0 *domain.SongStats
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.SongStats)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockStatsService) FetchTopSongs(ctx context.Context, from, to time.Time, limit int) ([]domain.SongStats, error) {
+	ret := m.Called(ctx, from, to, limit)
+
+	var r0 []domain.SongStats
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.SongStats)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockStatsService) FetchCCLIReport(ctx context.Context, from, to time.Time, principal *domain.User) (*domain.CCLIReport, error) {
+	ret := m.Called(ctx, from, to, principal)
+
+	var r0 *domain.CCLIReport
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.CCLIReport)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
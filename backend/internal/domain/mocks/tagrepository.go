@@ -0,0 +1,120 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTagRepository struct {
+	mock.Mock
+}
+
+func (m MockTagRepository) GetByID(ctx context.Context, tid int64) (*domain.Tag, error) {
+	ret := m.Called(ctx, tid)
+
+	var r0 *domain.Tag
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Tag)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockTagRepository) GetAll(ctx context.Context) (*[]domain.Tag, error) {
+	ret := m.Called(ctx)
+
+	var r0 *[]domain.Tag
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*[]domain.Tag)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockTagRepository) GetByIDs(ctx context.Context, ids []int64) ([]domain.Tag, error) {
+	ret := m.Called(ctx, ids)
+
+	var r0 []domain.Tag
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.Tag)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockTagRepository) GetBySong(ctx context.Context, sid int64) ([]domain.Tag, error) {
+	ret := m.Called(ctx, sid)
+
+	var r0 []domain.Tag
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.Tag)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockTagRepository) SetSongTags(ctx context.Context, sid int64, tagIDs []int64) error {
+	ret := m.Called(ctx, sid, tagIDs)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockTagRepository) Create(ctx context.Context, tag *domain.Tag) error {
+	ret := m.Called(ctx, tag)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockTagRepository) Update(ctx context.Context, tag *domain.Tag) error {
+	ret := m.Called(ctx, tag)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockTagRepository) Delete(ctx context.Context, tid int64) error {
+	ret := m.Called(ctx, tid)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
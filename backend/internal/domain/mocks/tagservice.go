@@ -0,0 +1,109 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTagService struct {
+	mock.Mock
+}
+
+func (m MockTagService) FetchByID(ctx context.Context, tid int64) (*domain.Tag, error) {
+	ret := m.Called(ctx, tid)
+
+	var r0 *domain.Tag
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.Tag)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockTagService) FetchAll(ctx context.Context) (*[]domain.Tag, error) {
+	ret := m.Called(ctx)
+
+	var r0 *[]domain.Tag
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*[]domain.Tag)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockTagService) FetchBySong(ctx context.Context, sid int64) ([]domain.Tag, error) {
+	ret := m.Called(ctx, sid)
+
+	var r0 []domain.Tag
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.Tag)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockTagService) SetSongTags(ctx context.Context, sid int64, tagIDs []int64, principal *domain.User) ([]domain.Tag, error) {
+	ret := m.Called(ctx, sid, tagIDs, principal)
+
+	var r0 []domain.Tag
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]domain.Tag)
+	}
+
+	var r1 error
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).(error)
+	}
+
+	return r0, r1
+}
+
+func (m MockTagService) Store(ctx context.Context, tag *domain.Tag, principal *domain.User) error {
+	ret := m.Called(ctx, tag, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockTagService) Update(ctx context.Context, tag *domain.Tag, principal *domain.User) error {
+	ret := m.Called(ctx, tag, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
+
+func (m MockTagService) Remove(ctx context.Context, tid int64, principal *domain.User) error {
+	ret := m.Called(ctx, tid, principal)
+
+	var r0 error
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(error)
+	}
+
+	return r0
+}
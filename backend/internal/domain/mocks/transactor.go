@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransactor struct {
+	mock.Mock
+}
+
+// WithinTransaction runs fn with the given context unless the expectation returns an error,
+// which stands in for a transaction that could not be started.
+func (m MockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	ret := m.Called(ctx)
+
+	if ret.Get(0) != nil {
+		return ret.Get(0).(error)
+	}
+
+	return fn(ctx)
+}
@@ -0,0 +1,17 @@
+package domain
+
+// TextRange marks the characters from Start up to End in a text.
+type TextRange struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// SongSearchResult is a song matching a search, the snippet is the line
+// that matched best with the matching words marked by Highlights.
+type SongSearchResult struct {
+	Song       Song        `json:"song"`
+	Score      float64     `json:"score"`
+	Section    string      `json:"section"`
+	Snippet    string      `json:"snippet"`
+	Highlights []TextRange `json:"highlights"`
+}
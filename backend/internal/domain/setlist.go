@@ -5,21 +5,74 @@ import (
 	"time"
 )
 
+// SetlistStatus is the stage of a setlist in its lifecycle. Drafts are only visible to
+// their creator and editors until published, published setlists are locked once their
+// deadline has passed and can be archived afterwards.
+type SetlistStatus string
+
+const (
+	SetlistDraft     SetlistStatus = "draft"
+	SetlistPublished SetlistStatus = "published"
+	SetlistLocked    SetlistStatus = "locked"
+	SetlistArchived  SetlistStatus = "archived"
+)
+
+// Setlist.Status defaults to published in the database so setlists that existed before
+// the lifecycle stay visible, new setlists are explicitly stored as drafts.
 type Setlist struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	CreatorID int64     `json:"creator_id"`
-	Deadline  time.Time `json:"deadline"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        int64         `json:"id"`
+	Name      string        `json:"name"`
+	CreatorID int64         `json:"creator_id"`
+	Deadline  time.Time     `json:"deadline"`
+	Status    SetlistStatus `json:"status" gorm:"type:varchar(16);default:published"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// StatusAt returns the status of the setlist at the given time, locked is never
+// stored but follows from a published setlist whose deadline has passed.
+func (setlist Setlist) StatusAt(now time.Time) SetlistStatus {
+	switch setlist.Status {
+	case "", SetlistDraft:
+		return SetlistDraft
+	case SetlistPublished, SetlistLocked:
+		if setlist.Deadline.After(now) {
+			return SetlistPublished
+		}
+
+		return SetlistLocked
+	default:
+		return setlist.Status
+	}
+}
+
+// IsLocked reports whether the setlist can no longer be changed by anyone but admins.
+func (setlist Setlist) IsLocked(now time.Time) bool {
+	status := setlist.StatusAt(now)
+
+	return status == SetlistLocked || status == SetlistArchived
+}
+
+// IsVisibleTo reports whether the principal, which is nil for anonymous users,
+// can see the setlist. Drafts are only visible to their creator and editors.
+func (setlist Setlist) IsVisibleTo(principal *User) bool {
+	if setlist.StatusAt(time.Now()) != SetlistDraft {
+		return true
+	}
+
+	return principal != nil && (principal.HasClearance(EDITOR) || principal.ID == setlist.CreatorID)
 }
 
 type SetlistService interface {
 	AuthSingleStorer[Setlist]
-	Fetcher[Setlist]
-	Fetch(ctx context.Context, from time.Time, to time.Time) (*[]Setlist, error)
-	FetchByTimeframe(ctx context.Context, from time.Time, to time.Time) (*[]Setlist, error)
+	FetchByID(ctx context.Context, sid int64, principal *User) (*Setlist, error)
+	FetchAll(ctx context.Context, principal *User) (*[]Setlist, error)
+	Fetch(ctx context.Context, from time.Time, to time.Time, principal *User) (*[]Setlist, error)
+	FetchByTimeframe(ctx context.Context, from time.Time, to time.Time, principal *User) (*[]Setlist, error)
 	Update(ctx context.Context, setlist *Setlist, principal *User) (*Setlist, error)
-	AuthSingleRemover[Setlist]
+	Publish(ctx context.Context, sid int64, principal *User) (*Setlist, error)
+	Unpublish(ctx context.Context, sid int64, principal *User) (*Setlist, error)
+	Archive(ctx context.Context, sid int64, principal *User) (*Setlist, error)
+	AuthVersionedRemover[Setlist]
 }
 
 type SetlistRepository interface {
@@ -28,7 +81,7 @@ type SetlistRepository interface {
 	GetAll(ctx context.Context) (*[]Setlist, error)
 	Get(ctx context.Context, from time.Time, to time.Time) (*[]Setlist, error)
 	GetByTimeframe(ctx context.Context, from time.Time, to time.Time) (*[]Setlist, error)
-	Delete(ctx context.Context, slid int64) error
+	Delete(ctx context.Context, slid int64, version time.Time) error
 	Update(ctx context.Context, setlist *Setlist) (*Setlist, error)
 	Create(ctx context.Context, setlist *Setlist) error
 }
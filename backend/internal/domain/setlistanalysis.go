@@ -0,0 +1,44 @@
+package domain
+
+type SetlistWarningType string
+
+const (
+	KeyJumpWarning      SetlistWarningType = "key_jump"
+	TempoSwingWarning   SetlistWarningType = "tempo_swing"
+	RepeatedSongWarning SetlistWarningType = "repeated_song"
+)
+
+// SetlistWarning flags an awkward transition from one setlist entry to the next,
+// for a repeated song FromEntryID is the entry that first played the song.
+type SetlistWarning struct {
+	Type        SetlistWarningType `json:"type"`
+	FromEntryID int64              `json:"from_entry_id"`
+	ToEntryID   int64              `json:"to_entry_id"`
+	Message     string             `json:"message"`
+}
+
+// EntryTransition describes the change between two consecutive entries, keys
+// are the keys after transposition and KeyDistance is measured in semitones.
+type EntryTransition struct {
+	FromEntryID int64  `json:"from_entry_id"`
+	ToEntryID   int64  `json:"to_entry_id"`
+	FromKey     string `json:"from_key"`
+	ToKey       string `json:"to_key"`
+	KeyDistance int    `json:"key_distance"`
+	BpmChange   int    `json:"bpm_change"`
+}
+
+// TransposeSuggestion is a transposition of the entry that smooths the key
+// change from the entry before it.
+type TransposeSuggestion struct {
+	EntryID   int64  `json:"entry_id"`
+	Transpose int16  `json:"transpose"`
+	Key       string `json:"key"`
+}
+
+type SetlistAnalysis struct {
+	SetlistID   int64                 `json:"setlist_id"`
+	Transitions []EntryTransition     `json:"transitions"`
+	Warnings    []SetlistWarning      `json:"warnings"`
+	Suggestions []TransposeSuggestion `json:"suggestions"`
+}
@@ -6,23 +6,93 @@ import (
 	"gorm.io/datatypes"
 )
 
+type SetlistItemType string
+
+const (
+	SongItem      SetlistItemType = "song"
+	HeaderItem    SetlistItemType = "header"
+	TextItem      SetlistItemType = "text"
+	ScriptureItem SetlistItemType = "scripture"
+)
+
+func (itemType SetlistItemType) IsValid() bool {
+	switch itemType {
+	case SongItem, HeaderItem, TextItem, ScriptureItem:
+		return true
+	default:
+		return false
+	}
+}
+
+// SetlistEntry is an item of the run sheet of a setlist. Only song items refer to
+// a song and have a transpose, arrangement and languages, headers and free-text
+// items use Title and Content and scripture items hold a Reference to a passage.
 type SetlistEntry struct {
-	ID          int64          `json:"id"`
-	SongID      int64          `json:"song_id"`
-	SetlistID   int64          `json:"setlist_id"`
-	Transpose   int16          `json:"transpose"`
-	Notes       string         `json:"notes"`
-	Arrangement datatypes.JSON `json:"arrangement"`
-	Rank        int64          `json:"rank"`
+	ID          int64           `json:"id"`
+	Type        SetlistItemType `json:"type" gorm:"type:varchar(16);default:song"`
+	SongID      int64           `json:"song_id"`
+	SetlistID   int64           `json:"setlist_id"`
+	Title       string          `json:"title" gorm:"type:varchar(255)"`
+	Content     string          `json:"content" gorm:"type:text"`
+	Reference   string          `json:"reference" gorm:"type:varchar(255)"`
+	Transpose   int16           `json:"transpose"`
+	Notes       string          `json:"notes"`
+	Arrangement datatypes.JSON  `json:"arrangement"`
+	Languages   string          `json:"languages" gorm:"type:varchar(255)"`
+	Duration    uint            `json:"duration"`
+	Rank        int64           `json:"rank"`
+}
+
+// IsSong reports whether the entry is a song item, entries
+// stored before items were typed have no type and are songs.
+func (entry SetlistEntry) IsSong() bool {
+	return entry.Type == "" || entry.Type == SongItem
+}
+
+// ArrangedSection is a section of a song in the order it is played in a setlist entry.
+type ArrangedSection struct {
+	Tag  string `json:"tag"`
+	Text string `json:"text"`
+}
+
+// ExpandedArrangement holds the sections of a setlist entry in play order,
+// Text is the section texts joined in that order.
+type ExpandedArrangement struct {
+	EntryID  int64             `json:"entry_id"`
+	SongID   int64             `json:"song_id"`
+	Key      string            `json:"key"`
+	Sections []ArrangedSection `json:"sections"`
+	Text     string            `json:"text"`
+}
+
+// EntryTiming is the planned start of a setlist entry relative to the start
+// of the setlist and its duration, both in seconds.
+type EntryTiming struct {
+	EntryID  int64 `json:"entry_id"`
+	Start    uint  `json:"start"`
+	Duration uint  `json:"duration"`
+}
+
+// SetlistTiming holds the timings of the entries of a setlist in rank order,
+// RunTime is the total estimated run time in seconds.
+type SetlistTiming struct {
+	SetlistID int64         `json:"setlist_id"`
+	RunTime   uint          `json:"run_time"`
+	Entries   []EntryTiming `json:"entries"`
 }
 
 type SetlistEntryService interface {
 	AuthMultiStorer[SetlistEntry]
 	Fetcher[SetlistEntry]
 	FetchBySetlist(ctx context.Context, setlists *[]Setlist) (*[]SetlistEntry, error)
+	FetchSheet(ctx context.Context, setlistID, entryID int64, options *SheetOptions) (*Sheet, error)
+	FetchArrangement(ctx context.Context, setlistID, entryID int64, options *SheetOptions) (*ExpandedArrangement, error)
+	FetchAnalysis(ctx context.Context, setlistID int64) (*SetlistAnalysis, error)
+	FetchTimings(ctx context.Context, setlistEntries *[]SetlistEntry) (map[int64]SetlistTiming, error)
 	AuthMultiUpdater[SetlistEntry]
 	RemoveBatch(ctx context.Context, setlist *Setlist, ids []int64, principal *User) error
 	RemoveBySetlist(ctx context.Context, setlist *Setlist, principal *User) error
+	Reorder(ctx context.Context, setlistID int64, order []int64, principal *User) (*[]SetlistEntry, error)
 }
 
 type SetlistEntryRepository interface {
@@ -30,5 +100,6 @@ type SetlistEntryRepository interface {
 	GetBySetlist(ctx context.Context, setlists *[]Setlist) (*[]SetlistEntry, error)
 	Creator[SetlistEntry]
 	Updater[SetlistEntry]
+	UpdateRanks(ctx context.Context, setlistEntries *[]SetlistEntry) error
 	Deleter[SetlistEntry]
 }
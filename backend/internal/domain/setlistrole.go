@@ -17,6 +17,7 @@ type SetlistRoleService interface {
 type SetlistRoleRepository interface {
 	Create(ctx context.Context, setlistRoles *[]SetlistRole) error
 	Get(ctx context.Context, setlistIDs []int64) (*[]SetlistRole, error)
+	GetByUserRoles(ctx context.Context, userRoleIDs []int64) (*[]SetlistRole, error)
 	Update(ctx context.Context, setlistRoles *[]SetlistRole) error
 	Delete(ctx context.Context, setlistRoleIDs []int64) error
 }
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type RecurrenceFrequency string
+
+const (
+	Daily   RecurrenceFrequency = "DAILY"
+	Weekly  RecurrenceFrequency = "WEEKLY"
+	Monthly RecurrenceFrequency = "MONTHLY"
+)
+
+// RecurrenceDay is a BYDAY value of a rule, Ordinal picks the nth weekday
+// of the month counted from the end when negative, 0 picks every one.
+type RecurrenceDay struct {
+	Weekday time.Weekday
+	Ordinal int
+}
+
+// Recurrence is a parsed RRULE, see RFC 5545. Only the DAILY, WEEKLY and MONTHLY
+// frequencies and the INTERVAL, BYDAY, BYMONTHDAY, BYHOUR, BYMINUTE, COUNT and
+// UNTIL parts are supported.
+type Recurrence struct {
+	Frequency  RecurrenceFrequency
+	Interval   int
+	ByDay      []RecurrenceDay
+	ByMonthDay []int
+	ByHour     []int
+	ByMinute   []int
+	Count      int
+	Until      time.Time
+}
+
+// SetlistSeries generates a setlist for every occurrence of its rule, the
+// occurrences are computed from Start in the wall clock of Timezone. The
+// setlists are created from the template with TemplateID unless it is 0.
+type SetlistSeries struct {
+	ID         int64     `json:"id"`
+	Name       string    `json:"name" gorm:"type:varchar(255);uniqueIndex"`
+	CreatorID  int64     `json:"creator_id"`
+	Rule       string    `json:"rule" gorm:"type:varchar(255)"`
+	Timezone   string    `json:"timezone" gorm:"type:varchar(64)"`
+	Start      time.Time `json:"start"`
+	TemplateID int64     `json:"template_id"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// SeriesOccurrence records what happened to an occurrence of a series, either the
+// setlist created for it or that it was skipped. Occurrences that are not
+// recorded yet have an ID of 0.
+type SeriesOccurrence struct {
+	ID              int64     `json:"id"`
+	SetlistSeriesID int64     `json:"series_id" gorm:"uniqueIndex:series_occurrence"`
+	Date            time.Time `json:"date" gorm:"uniqueIndex:series_occurrence"`
+	SetlistID       int64     `json:"setlist_id"`
+	Skipped         bool      `json:"skipped"`
+}
+
+type SetlistSeriesService interface {
+	Fetcher[SetlistSeries]
+	AuthSingleStorer[SetlistSeries]
+	AuthSingleUpdater[SetlistSeries]
+	AuthSingleRemover[SetlistSeries]
+	Preview(ctx context.Context, sid int64, from time.Time, to time.Time) (*[]SeriesOccurrence, error)
+	Materialize(ctx context.Context, sid int64, until time.Time, principal *User) (*[]Setlist, error)
+	Skip(ctx context.Context, sid int64, date time.Time, principal *User) error
+	Unskip(ctx context.Context, sid int64, date time.Time, principal *User) error
+}
+
+type SetlistSeriesRepository interface {
+	Getter[SetlistSeries]
+	Create(ctx context.Context, series *SetlistSeries) error
+	Update(ctx context.Context, series *SetlistSeries) error
+	Delete(ctx context.Context, sid int64) error
+	GetOccurrences(ctx context.Context, sid int64, from time.Time, to time.Time) (*[]SeriesOccurrence, error)
+	CreateOccurrence(ctx context.Context, occurrence *SeriesOccurrence) error
+	DeleteOccurrence(ctx context.Context, sid int64, date time.Time) error
+}
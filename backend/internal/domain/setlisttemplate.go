@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// SetlistTemplate is a reusable setlist structure, setlists created from it get
+// an entry for every slot in rank order and the default assignments of its roles.
+type SetlistTemplate struct {
+	ID        int64          `json:"id"`
+	Name      string         `json:"name" gorm:"type:varchar(255);uniqueIndex"`
+	CreatorID int64          `json:"creator_id"`
+	Notes     string         `json:"notes"`
+	Slots     []TemplateSlot `json:"slots" gorm:"constraint:OnDelete:CASCADE"`
+	Roles     []TemplateRole `json:"roles" gorm:"constraint:OnDelete:CASCADE"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// TemplateSlot is a named item of a template. A song slot without a song is a
+// placeholder, it becomes a text item titled with the name of the slot until
+// a song is picked for the setlist.
+type TemplateSlot struct {
+	ID                int64           `json:"id"`
+	SetlistTemplateID int64           `json:"-" gorm:"index"`
+	Name              string          `json:"name" gorm:"type:varchar(255)"`
+	Type              SetlistItemType `json:"type" gorm:"type:varchar(16);default:song"`
+	SongID            int64           `json:"song_id"`
+	Title             string          `json:"title" gorm:"type:varchar(255)"`
+	Content           string          `json:"content" gorm:"type:text"`
+	Reference         string          `json:"reference" gorm:"type:varchar(255)"`
+	Transpose         int16           `json:"transpose"`
+	Notes             string          `json:"notes"`
+	Duration          uint            `json:"duration"`
+	Rank              int64           `json:"rank"`
+}
+
+// IsPlaceholder reports whether the slot is a song slot that has no song yet.
+func (slot TemplateSlot) IsPlaceholder() bool {
+	return (slot.Type == "" || slot.Type == SongItem) && slot.SongID == 0
+}
+
+// TemplateRole requires a role in setlists created from the template,
+// UserRoleID is the user role assigned by default or 0 when left open.
+type TemplateRole struct {
+	ID                int64 `json:"id"`
+	SetlistTemplateID int64 `json:"-" gorm:"index"`
+	RoleID            int64 `json:"role_id"`
+	UserRoleID        int64 `json:"userrole_id"`
+}
+
+// CopiedSetlist is a setlist created from a template or another setlist
+// together with the entries and role assignments copied into it.
+type CopiedSetlist struct {
+	Setlist Setlist        `json:"setlist"`
+	Entries []SetlistEntry `json:"entries"`
+	Roles   []SetlistRole  `json:"roles"`
+}
+
+type SetlistTemplateService interface {
+	Fetcher[SetlistTemplate]
+	AuthSingleStorer[SetlistTemplate]
+	AuthSingleUpdater[SetlistTemplate]
+	AuthSingleRemover[SetlistTemplate]
+	Instantiate(ctx context.Context, tid int64, setlist *Setlist, principal *User) (*CopiedSetlist, error)
+	Clone(ctx context.Context, sid int64, setlist *Setlist, principal *User) (*CopiedSetlist, error)
+}
+
+type SetlistTemplateRepository interface {
+	Getter[SetlistTemplate]
+	Create(ctx context.Context, template *SetlistTemplate) error
+	Update(ctx context.Context, template *SetlistTemplate) error
+	Delete(ctx context.Context, tid int64) error
+}
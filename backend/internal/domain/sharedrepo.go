@@ -21,3 +21,10 @@ type Deleter[T any] interface {
 	Delete(ctx context.Context, id int64) error
 	DeleteBatch(ctx context.Context, ids []int64) error
 }
+
+// Transactor runs a unit of work in a single transaction. Repositories called with the
+// context passed to fn take part in the transaction, which rolls back when fn returns an error.
+// A unit of work started inside another one joins the outer transaction.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
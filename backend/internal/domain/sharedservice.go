@@ -1,6 +1,9 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Fetcher[T any] interface {
 	FetchByID(ctx context.Context, id int64) (*T, error)
@@ -32,6 +35,12 @@ type AuthSingleRemover[T any] interface {
 	Remove(ctx context.Context, id int64, principal *User) error
 }
 
+// AuthVersionedRemover removes the resource only if it was not changed since the version,
+// a zero version removes it unconditionally.
+type AuthVersionedRemover[T any] interface {
+	Remove(ctx context.Context, id int64, version time.Time, principal *User) error
+}
+
 type AuthMultiRemover[T any] interface {
 	RemoveBatch(ctx context.Context, ids []int64, principal *User) error
 }
@@ -0,0 +1,38 @@
+package domain
+
+import "gorm.io/datatypes"
+
+type Notation string
+
+const (
+	LetterNotation    Notation = "letter"
+	NashvilleNotation Notation = "nashville"
+)
+
+func (notation Notation) IsValid() bool {
+	return notation == LetterNotation || notation == NashvilleNotation
+}
+
+// Sheet is a chord sheet of a song as it should be played,
+// with the transpose of a setlist entry already applied.
+// Sections holds the same chord sheet parsed into its sections, lines and chords.
+type Sheet struct {
+	SongID      int64               `json:"song_id"`
+	Title       string              `json:"title"`
+	Subtitle    string              `json:"subtitle"`
+	OriginalKey string              `json:"original_key"`
+	Key         string              `json:"key"`
+	Transpose   int16               `json:"transpose"`
+	Bpm         uint                `json:"bpm"`
+	Notation    Notation            `json:"notation"`
+	ChordSheet  datatypes.JSON      `json:"chord_sheet"`
+	Sections    []ChordSheetSection `json:"sections"`
+	Languages   []string            `json:"languages,omitempty"`
+}
+
+// SheetOptions holds how a sheet is rendered. The lyrics are shown in the first
+// of the Languages with the chords, the other languages are added per line.
+type SheetOptions struct {
+	Notation  Notation
+	Languages []string
+}
@@ -16,8 +16,15 @@ type Song struct {
 	Subtitle   string         `json:"subtitle" gorm:"type:varchar(255);uniqueIndex:title_subtitle"`
 	Key        string         `json:"key" gorm:"type:varchar(3);column:song_key"`
 	Bpm        uint           `json:"bpm"`
+	Duration   uint           `json:"duration"`
 	ChordSheet datatypes.JSON `json:"chord_sheet"`
+	CCLINumber string         `json:"ccli_number" gorm:"type:varchar(10);column:ccli_number"`
+	Authors    string         `json:"authors" gorm:"type:varchar(255)"`
+	Copyright  string         `json:"copyright" gorm:"type:varchar(255)"`
+	Publisher  string         `json:"publisher" gorm:"type:varchar(255)"`
+	Language   string         `json:"language" gorm:"type:varchar(35)"`
 	UpdatedAt  time.Time      `json:"updated_at"`
+	LastPlayed *time.Time     `json:"last_played,omitempty" gorm:"->;-:migration"`
 	DeletedAt  gorm.DeletedAt `json:"-"`
 }
 
@@ -40,19 +47,112 @@ func (song Song) IsValidKey() bool {
 	return false
 }
 
+// IsValidCCLINumber reports whether the CCLI song number is empty or made up of
+// at most 10 digits without leading zeros.
+func (song Song) IsValidCCLINumber() bool {
+	if song.CCLINumber == "" {
+		return true
+	}
+
+	if len(song.CCLINumber) > 10 || song.CCLINumber[0] == '0' {
+		return false
+	}
+
+	for _, char := range song.CCLINumber {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasValidLicenseFields reports whether the authors, copyright and
+// publisher fit in their columns.
+func (song Song) HasValidLicenseFields() bool {
+	const maxLength = 255
+
+	return len(song.Authors) <= maxLength && len(song.Copyright) <= maxLength && len(song.Publisher) <= maxLength
+}
+
+type SongSort string
+
+const (
+	SongSortID         SongSort = ""
+	SongSortTitle      SongSort = "title"
+	SongSortBpm        SongSort = "bpm"
+	SongSortUpdatedAt  SongSort = "updated_at"
+	SongSortLastPlayed SongSort = "last_played"
+)
+
+func (sort SongSort) IsValid() bool {
+	switch sort {
+	case SongSortID, SongSortTitle, SongSortBpm, SongSortUpdatedAt, SongSortLastPlayed:
+		return true
+	default:
+		return false
+	}
+}
+
+// SongCursor points at the last song of a page, Value holds the
+// sorted field of that song so the next page can continue after it.
+type SongCursor struct {
+	ID    int64  `json:"id"`
+	Value string `json:"value"`
+}
+
+// SongFilterOptions selects the songs to fetch, zero values are not filtered on.
+// CompatibleKey matches songs in the same key or its relative minor/major,
+// TIDs matches songs with any of the tags.
+// A Limit of zero returns every song after the cursor.
 type SongFilterOptions struct {
-	IDs   []int64
-	BIDs  []int64
-	CIDs  []int64
-	Title string
-	Keys  []string
-	Bpms  []uint
+	IDs           []int64
+	BIDs          []int64
+	CIDs          []int64
+	TIDs          []int64
+	Title         string
+	Keys          []string
+	Bpms          []uint
+	MinBpm        uint
+	MaxBpm        uint
+	CompatibleKey string
+	UpdatedSince  time.Time
+	Sort          SongSort
+	Descending    bool
+	After         *SongCursor
+	Limit         int
+}
+
+// SongPage is a single page of songs, Total counts the matching songs on all pages
+// and NextCursor is empty on the last page. TagCounts counts the matching songs per tag.
+type SongPage struct {
+	Songs      []Song     `json:"songs"`
+	Total      int64      `json:"total"`
+	NextCursor string     `json:"next_cursor"`
+	TagCounts  []TagCount `json:"tag_counts"`
+}
+
+// DuplicateGroup holds songs that are likely the same song,
+// Similarity is the highest similarity between two songs of the group.
+type DuplicateGroup struct {
+	Songs      []Song  `json:"songs"`
+	Similarity float64 `json:"similarity"`
 }
 
 type SongService interface {
 	Fetcher[Song]
-	Fetch(ctx context.Context, options *SongFilterOptions) ([]Song, error)
-	AuthSingleRemover[Song]
+	Fetch(ctx context.Context, options *SongFilterOptions) (*SongPage, error)
+	FetchSheet(ctx context.Context, sid int64, options *SheetOptions) (*Sheet, error)
+	Search(ctx context.Context, query string, limit int) ([]SongSearchResult, error)
+	FetchRevisions(ctx context.Context, sid int64) ([]SongRevision, error)
+	FetchRevisionDiff(ctx context.Context, sid, fromID, toID int64) (*SongRevisionDiff, error)
+	Revert(ctx context.Context, sid, revisionID int64, principal *User) (*Song, error)
+	FetchDuplicates(ctx context.Context, threshold float64) ([]DuplicateGroup, error)
+	Merge(ctx context.Context, sid int64, duplicateIDs []int64, principal *User) (*Song, error)
+	FetchTranslations(ctx context.Context, sid int64) ([]SongTranslation, error)
+	StoreTranslation(ctx context.Context, translation *SongTranslation, principal *User) error
+	RemoveTranslation(ctx context.Context, sid int64, language string, principal *User) error
+	AuthVersionedRemover[Song]
 	AuthSingleStorer[Song]
 	AuthSingleUpdater[Song]
 }
@@ -60,7 +160,10 @@ type SongService interface {
 type SongRepository interface {
 	Getter[Song]
 	Get(ctx context.Context, options *SongFilterOptions) ([]Song, error)
+	Count(ctx context.Context, options *SongFilterOptions) (int64, error)
+	CountTags(ctx context.Context, options *SongFilterOptions) ([]TagCount, error)
 	Create(ctx context.Context, song *Song) error
-	Delete(ctx context.Context, sid int64) error
+	Delete(ctx context.Context, sid int64, version time.Time) error
 	Update(ctx context.Context, song *Song) error
+	Merge(ctx context.Context, sid int64, duplicateIDs []int64) error
 }
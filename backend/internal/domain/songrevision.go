@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// SongRevision is an immutable copy of a song as it was saved by an editor.
+type SongRevision struct {
+	ID         int64          `json:"id"`
+	SongID     int64          `json:"song_id" gorm:"index"`
+	EditorID   int64          `json:"editor_id"`
+	BundleID   int64          `json:"bundle_id"`
+	Title      string         `json:"title" gorm:"type:varchar(255)"`
+	Subtitle   string         `json:"subtitle" gorm:"type:varchar(255)"`
+	Key        string         `json:"key" gorm:"type:varchar(3);column:song_key"`
+	Bpm        uint           `json:"bpm"`
+	Duration   uint           `json:"duration"`
+	ChordSheet datatypes.JSON `json:"chord_sheet"`
+	CCLINumber string         `json:"ccli_number" gorm:"type:varchar(10);column:ccli_number"`
+	Authors    string         `json:"authors" gorm:"type:varchar(255)"`
+	Copyright  string         `json:"copyright" gorm:"type:varchar(255)"`
+	Publisher  string         `json:"publisher" gorm:"type:varchar(255)"`
+	Language   string         `json:"language" gorm:"type:varchar(35)"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+type DiffStatus string
+
+const (
+	DiffAdded     DiffStatus = "added"
+	DiffRemoved   DiffStatus = "removed"
+	DiffChanged   DiffStatus = "changed"
+	DiffUnchanged DiffStatus = "unchanged"
+)
+
+type FieldDiff struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+type SectionDiff struct {
+	Tag    string     `json:"tag"`
+	Status DiffStatus `json:"status"`
+	From   string     `json:"from,omitempty"`
+	To     string     `json:"to,omitempty"`
+}
+
+// SongRevisionDiff lists the changes needed to go from one revision to the other.
+type SongRevisionDiff struct {
+	SongID   int64         `json:"song_id"`
+	FromID   int64         `json:"from_id"`
+	ToID     int64         `json:"to_id"`
+	Fields   []FieldDiff   `json:"fields"`
+	Sections []SectionDiff `json:"sections"`
+}
+
+type SongRevisionRepository interface {
+	GetByID(ctx context.Context, id int64) (*SongRevision, error)
+	GetBySong(ctx context.Context, sid int64) ([]SongRevision, error)
+	Create(ctx context.Context, revision *SongRevision) error
+}
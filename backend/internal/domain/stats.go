@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// SongStats describes how often a song was played, a song counts as played
+// once for every past setlist it is part of.
+type SongStats struct {
+	SongID          int64      `json:"song_id"`
+	Title           string     `json:"title"`
+	PlayCount       int64      `json:"play_count"`
+	FirstPlayed     *time.Time `json:"first_played"`
+	LastPlayed      *time.Time `json:"last_played"`
+	CommonTranspose int16      `json:"common_transpose"`
+}
+
+// TransposeCount counts the setlist entries playing a song with the same transpose.
+type TransposeCount struct {
+	SongID    int64
+	Transpose int16
+	Count     int64
+}
+
+// PlayFilterOptions selects the setlist entries with a deadline between From and To,
+// zero values are not filtered on.
+type PlayFilterOptions struct {
+	SongIDs []int64
+	From    time.Time
+	To      time.Time
+	Limit   int
+}
+
+// CCLIReportRow is the usage of a single song in a CCLI report,
+// songs without a CCLI song number are flagged with MissingCCLINumber.
+type CCLIReportRow struct {
+	SongID            int64  `json:"song_id"`
+	CCLINumber        string `json:"ccli_number"`
+	Title             string `json:"title"`
+	Authors           string `json:"authors"`
+	Copyright         string `json:"copyright"`
+	Publisher         string `json:"publisher"`
+	TimesUsed         int64  `json:"times_used"`
+	MissingCCLINumber bool   `json:"missing_ccli_number"`
+}
+
+type CCLIReport struct {
+	From time.Time       `json:"from"`
+	To   time.Time       `json:"to"`
+	Rows []CCLIReportRow `json:"rows"`
+}
+
+type StatsService interface {
+	FetchSongStats(ctx context.Context, sid int64) (*SongStats, error)
+	FetchTopSongs(ctx context.Context, from, to time.Time, limit int) ([]SongStats, error)
+	FetchCCLIReport(ctx context.Context, from, to time.Time, principal *User) (*CCLIReport, error)
+}
+
+type StatsRepository interface {
+	GetPlayCounts(ctx context.Context, options *PlayFilterOptions) ([]SongStats, error)
+	GetTransposeCounts(ctx context.Context, options *PlayFilterOptions) ([]TransposeCount, error)
+}
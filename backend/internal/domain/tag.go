@@ -0,0 +1,66 @@
+package domain
+
+import "context"
+
+type TagCategory string
+
+const (
+	TagTheme  TagCategory = "theme"
+	TagSeason TagCategory = "season"
+	TagOther  TagCategory = "other"
+)
+
+func (category TagCategory) IsValid() bool {
+	switch category {
+	case TagTheme, TagSeason, TagOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// Tag labels songs across bundles, e.g. the theme "grace" or the season "Advent".
+type Tag struct {
+	ID       int64       `json:"id"`
+	Name     string      `json:"name" gorm:"type:varchar(64);uniqueIndex"`
+	Category TagCategory `json:"category" gorm:"type:varchar(16)"`
+}
+
+// HasValidName reports whether the name of the tag fits in its column.
+func (tag Tag) HasValidName() bool {
+	const maxLength = 64
+
+	return len(tag.Name) <= maxLength
+}
+
+// SongTag links a song to one of its tags.
+type SongTag struct {
+	SongID int64 `json:"song_id" gorm:"primaryKey;autoIncrement:false"`
+	TagID  int64 `json:"tag_id" gorm:"primaryKey;autoIncrement:false;index"`
+}
+
+// TagCount counts the songs with a tag.
+type TagCount struct {
+	TagID int64  `json:"tag_id"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+type TagService interface {
+	Fetcher[Tag]
+	FetchBySong(ctx context.Context, sid int64) ([]Tag, error)
+	SetSongTags(ctx context.Context, sid int64, tagIDs []int64, principal *User) ([]Tag, error)
+	AuthSingleStorer[Tag]
+	AuthSingleUpdater[Tag]
+	AuthSingleRemover[Tag]
+}
+
+type TagRepository interface {
+	Getter[Tag]
+	GetByIDs(ctx context.Context, ids []int64) ([]Tag, error)
+	GetBySong(ctx context.Context, sid int64) ([]Tag, error)
+	SetSongTags(ctx context.Context, sid int64, tagIDs []int64) error
+	Create(ctx context.Context, tag *Tag) error
+	Update(ctx context.Context, tag *Tag) error
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// SongTranslation holds the lyrics of a song in another language. Lyrics maps
+// the section tags of the chord sheet to the translated lines without chords,
+// the chords of the chord sheet are shared by every translation.
+type SongTranslation struct {
+	ID        int64          `json:"id"`
+	SongID    int64          `json:"song_id" gorm:"uniqueIndex:song_language"`
+	Language  string         `json:"language" gorm:"type:varchar(35);uniqueIndex:song_language"`
+	Title     string         `json:"title" gorm:"type:varchar(255)"`
+	Lyrics    datatypes.JSON `json:"lyrics"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+type SongTranslationRepository interface {
+	GetBySong(ctx context.Context, sid int64) ([]SongTranslation, error)
+	Save(ctx context.Context, translation *SongTranslation) error
+	Delete(ctx context.Context, sid int64, language string) error
+}
@@ -0,0 +1,29 @@
+package attachmenthandler
+
+import (
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+type attachmentHandler struct {
+	as domain.AttachmentService
+}
+
+func Initialize(group *gin.RouterGroup, as domain.AttachmentService, mwh domain.MiddlewareHandler) {
+	attachmenthandler := &attachmentHandler{
+		as: as,
+	}
+
+	attachments := group.Group("attachments")
+	attachments.GET(":id", mwh.IdentifyUser(), attachmenthandler.GetByID)
+	attachments.GET(":id/download", mwh.IdentifyUser(), attachmenthandler.Download)
+	attachments.DELETE(":id/delete", mwh.AuthenticateUser(), attachmenthandler.Delete)
+
+	songs := group.Group("songs")
+	songs.GET(":id/attachments", attachmenthandler.GetBySong)
+	songs.POST(":id/attachments", mwh.AuthenticateUser(), attachmenthandler.UploadToSong)
+
+	setlists := group.Group("setlists")
+	setlists.GET(":id/entries/:eid/attachments", mwh.IdentifyUser(), attachmenthandler.GetByEntry)
+	setlists.POST(":id/entries/:eid/attachments", mwh.AuthenticateUser(), attachmenthandler.UploadToEntry)
+}
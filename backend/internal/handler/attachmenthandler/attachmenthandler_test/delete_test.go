@@ -0,0 +1,54 @@
+package attachmenthandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("Remove", context.TODO(), int64(1), mockUser).
+			Return(nil)
+
+		writer := prepareAndServe(t, mockAS, mockUser, newRequest(t, http.MethodDelete, "/attachments/1/delete"))
+
+		assert.Equal(t, http.StatusAccepted, writer.Code)
+		mockAS.AssertExpectations(t)
+	})
+
+	t.Run("Fail Remove error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("user is neither an editor nor creator of the attachment")
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("Remove", context.TODO(), int64(1), mockUser).
+			Return(expErr)
+
+		writer := prepareAndServe(t, mockAS, mockUser, newRequest(t, http.MethodDelete, "/attachments/1/delete"))
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockAS.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,110 @@
+package attachmenthandler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+type nopSeekCloser struct {
+	*bytes.Reader
+}
+
+func (nopSeekCloser) Close() error {
+	return nil
+}
+
+func TestDownload(t *testing.T) {
+	t.Parallel()
+
+	mockData := []byte("0123456789")
+	mockAttachment := &domain.Attachment{
+		ID:          1,
+		Name:        "practice.mp3",
+		ContentType: "audio/mpeg",
+		Size:        int64(len(mockData)),
+		Checksum:    "abc",
+		CreatedAt:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("Open", context.TODO(), mockAttachment.ID, (*domain.User)(nil)).
+			Return(mockAttachment, nopSeekCloser{bytes.NewReader(mockData)}, nil)
+
+		writer := prepareAndServe(t, mockAS, nil, newRequest(t, http.MethodGet, "/attachments/1/download"))
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, mockData, writer.Body.Bytes())
+		assert.Equal(t, "audio/mpeg", writer.Header().Get("Content-Type"))
+		assert.Equal(t, "inline; filename=practice.mp3", writer.Header().Get("Content-Disposition"))
+		assert.Equal(t, `"abc"`, writer.Header().Get("ETag"))
+		assert.Equal(t, "bytes", writer.Header().Get("Accept-Ranges"))
+		mockAS.AssertExpectations(t)
+	})
+
+	t.Run("Correct range", func(t *testing.T) {
+		t.Parallel()
+
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("Open", context.TODO(), mockAttachment.ID, (*domain.User)(nil)).
+			Return(mockAttachment, nopSeekCloser{bytes.NewReader(mockData)}, nil)
+
+		req := newRequest(t, http.MethodGet, "/attachments/1/download")
+		req.Header.Set("Range", "bytes=2-5")
+
+		writer := prepareAndServe(t, mockAS, nil, req)
+
+		assert.Equal(t, http.StatusPartialContent, writer.Code)
+		assert.Equal(t, []byte("2345"), writer.Body.Bytes())
+		assert.Equal(t, "bytes 2-5/10", writer.Header().Get("Content-Range"))
+		mockAS.AssertExpectations(t)
+	})
+
+	t.Run("Correct not modified", func(t *testing.T) {
+		t.Parallel()
+
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("Open", context.TODO(), mockAttachment.ID, (*domain.User)(nil)).
+			Return(mockAttachment, nopSeekCloser{bytes.NewReader(mockData)}, nil)
+
+		req := newRequest(t, http.MethodGet, "/attachments/1/download")
+		req.Header.Set("If-None-Match", `"abc"`)
+
+		writer := prepareAndServe(t, mockAS, nil, req)
+
+		assert.Equal(t, http.StatusNotModified, writer.Code)
+		assert.Empty(t, writer.Body.Bytes())
+		mockAS.AssertExpectations(t)
+	})
+
+	t.Run("Fail Open error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("Open", context.TODO(), mockAttachment.ID, (*domain.User)(nil)).
+			Return(nil, nil, expErr)
+
+		writer := prepareAndServe(t, mockAS, nil, newRequest(t, http.MethodGet, "/attachments/1/download"))
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		mockAS.AssertExpectations(t)
+	})
+}
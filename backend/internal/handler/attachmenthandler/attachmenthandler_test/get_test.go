@@ -0,0 +1,180 @@
+package attachmenthandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/attachmenthandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func prepareAndServe(
+	t *testing.T,
+	mockAS domain.AttachmentService,
+	mockUser *domain.User,
+	req *http.Request,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
+
+	attachmenthandler.Initialize(&router.RouterGroup, mockAS, mockMWH)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func newRequest(t *testing.T, method, path string) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.TODO(), method, path, nil)
+	assert.NoError(t, err)
+
+	return req
+}
+
+func TestGetByID(t *testing.T) {
+	t.Parallel()
+
+	mockAttachment := &domain.Attachment{ID: 1, Name: "sheet.pdf", ContentType: "application/pdf"}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("FetchByID", context.TODO(), mockAttachment.ID, (*domain.User)(nil)).
+			Return(mockAttachment, nil)
+
+		writer := prepareAndServe(t, mockAS, nil, newRequest(t, http.MethodGet, "/attachments/1"))
+
+		expectedBytes, err := json.Marshal(gin.H{"attachment": mockAttachment})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockAS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchByID error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("FetchByID", context.TODO(), mockAttachment.ID, (*domain.User)(nil)).
+			Return(nil, expErr)
+
+		writer := prepareAndServe(t, mockAS, nil, newRequest(t, http.MethodGet, "/attachments/1"))
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockAS.AssertExpectations(t)
+	})
+}
+
+func TestGetBySong(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockAttachments := []domain.Attachment{{ID: 1, OwnerType: domain.SongAttachment, OwnerID: 2}}
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("FetchBySong", context.TODO(), int64(2)).
+			Return(mockAttachments, nil)
+
+		writer := prepareAndServe(t, mockAS, nil, newRequest(t, http.MethodGet, "/songs/2/attachments"))
+
+		expectedBytes, err := json.Marshal(gin.H{"attachments": mockAttachments})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockAS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		mockAS := &mocks.MockAttachmentService{}
+
+		writer := prepareAndServe(t, mockAS, nil, newRequest(t, http.MethodGet, "/songs/a/attachments"))
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockAS.AssertExpectations(t)
+	})
+}
+
+func TestGetByEntry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockAttachments := []domain.Attachment{{ID: 1, OwnerType: domain.SetlistEntryAttachment, OwnerID: 3}}
+		mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("FetchByEntry", context.TODO(), int64(2), int64(3), mockUser).
+			Return(mockAttachments, nil)
+
+		writer := prepareAndServe(t, mockAS, mockUser, newRequest(t, http.MethodGet, "/setlists/2/entries/3/attachments"))
+
+		expectedBytes, err := json.Marshal(gin.H{"attachments": mockAttachments})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockAS.AssertExpectations(t)
+	})
+	t.Run("Fail FetchByEntry error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "2")
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("FetchByEntry", context.TODO(), int64(2), int64(3), (*domain.User)(nil)).
+			Return(nil, expErr)
+
+		writer := prepareAndServe(t, mockAS, nil, newRequest(t, http.MethodGet, "/setlists/2/entries/3/attachments"))
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockAS.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,134 @@
+package attachmenthandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newUploadRequest(t *testing.T, path, field, name string, content []byte) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	form := multipart.NewWriter(&body)
+
+	part, err := form.CreateFormFile(field, name)
+	assert.NoError(t, err)
+
+	_, err = part.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, form.Close())
+
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, path, &body)
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	return req
+}
+
+func TestUploadToSong(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+	mockContent := []byte("%PDF-1.7\n")
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockAttachment := &domain.Attachment{ID: 1, OwnerType: domain.SongAttachment, OwnerID: 2, Name: "sheet.pdf"}
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("StoreForSong", context.TODO(), int64(2), "sheet.pdf", mock.Anything, mockUser).
+			Return(mockAttachment, nil).
+			Run(func(args mock.Arguments) {
+				content, ok := args.Get(3).(io.Reader)
+				assert.True(t, ok)
+
+				stored, err := io.ReadAll(content)
+				assert.NoError(t, err)
+				assert.Equal(t, mockContent, stored)
+			})
+
+		req := newUploadRequest(t, "/songs/2/attachments", "file", "sheet.pdf", mockContent)
+		writer := prepareAndServe(t, mockAS, mockUser, req)
+
+		expectedBytes, err := json.Marshal(gin.H{"attachment": mockAttachment})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockAS.AssertExpectations(t)
+	})
+
+	t.Run("Fail missing file", func(t *testing.T) {
+		t.Parallel()
+
+		mockAS := &mocks.MockAttachmentService{}
+
+		req := newUploadRequest(t, "/songs/2/attachments", "upload", "sheet.pdf", mockContent)
+		writer := prepareAndServe(t, mockAS, mockUser, req)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockAS.AssertExpectations(t)
+	})
+
+	t.Run("Fail StoreForSong error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewTooLargeErr("attachment too large")
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("StoreForSong", context.TODO(), int64(2), "sheet.pdf", mock.Anything, mockUser).
+			Return(nil, expErr)
+
+		req := newUploadRequest(t, "/songs/2/attachments", "file", "sheet.pdf", mockContent)
+		writer := prepareAndServe(t, mockAS, mockUser, req)
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockAS.AssertExpectations(t)
+	})
+}
+
+func TestUploadToEntry(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockAttachment := &domain.Attachment{ID: 1, OwnerType: domain.SetlistEntryAttachment, OwnerID: 3, Name: "mix.mp3"}
+		mockAS := &mocks.MockAttachmentService{}
+
+		mockAS.
+			On("StoreForEntry", context.TODO(), int64(2), int64(3), "mix.mp3", mock.Anything, mockUser).
+			Return(mockAttachment, nil)
+
+		req := newUploadRequest(t, "/setlists/2/entries/3/attachments", "file", "mix.mp3", []byte("ID3"))
+		writer := prepareAndServe(t, mockAS, mockUser, req)
+
+		expectedBytes, err := json.Marshal(gin.H{"attachment": mockAttachment})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockAS.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,35 @@
+package attachmenthandler
+
+import (
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+func (ah attachmentHandler) Delete(ctx *gin.Context) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	if err := ah.as.Remove(context, fields["id"], user); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
@@ -0,0 +1,38 @@
+package attachmenthandler
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+// Download streams the attachment contents,
+// http.ServeContent takes care of Range and conditional requests.
+func (ah attachmentHandler) Download(ctx *gin.Context) {
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	attachment, content, err := ah.as.Open(context, fields["id"], util.Identified(ctx))
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+	defer content.Close()
+
+	ctx.Header("Content-Type", attachment.ContentType)
+	ctx.Header("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": attachment.Name}))
+	ctx.Header("ETag", fmt.Sprintf("%q", attachment.Checksum))
+
+	http.ServeContent(ctx.Writer, ctx.Request, attachment.Name, attachment.CreatedAt, content)
+}
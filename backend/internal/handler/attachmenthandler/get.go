@@ -0,0 +1,69 @@
+package attachmenthandler
+
+import (
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+func (ah attachmentHandler) GetByID(ctx *gin.Context) {
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	attachment, err := ah.as.FetchByID(context, fields["id"], util.Identified(ctx))
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"attachment": attachment})
+}
+
+func (ah attachmentHandler) GetBySong(ctx *gin.Context) {
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	attachments, err := ah.as.FetchBySong(context, fields["id"])
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"attachments": attachments})
+}
+
+func (ah attachmentHandler) GetByEntry(ctx *gin.Context) {
+	fields, err := util.BindNamedParams(ctx, "id", "eid")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	attachments, err := ah.as.FetchByEntry(context, fields["id"], fields["eid"], util.Identified(ctx))
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"attachments": attachments})
+}
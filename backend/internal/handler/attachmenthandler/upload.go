@@ -0,0 +1,103 @@
+package attachmenthandler
+
+import (
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead leaves room for the multipart boundaries and headers around the file.
+const multipartOverhead = 1 << 20
+
+// bindFile reads the "file" field of a multipart upload, bodies larger than
+// an attachment may be are rejected before they are read completely.
+func bindFile(ctx *gin.Context) (multipart.File, *multipart.FileHeader, error) {
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, domain.MaxAttachmentSize+multipartOverhead)
+
+	file, header, err := ctx.Request.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, nil, domain.NewTooLargeErr(fmt.Sprintf("attachment exceeds %d bytes", domain.MaxAttachmentSize))
+		}
+
+		return nil, nil, domain.NewBadRequestErr(err.Error())
+	}
+
+	return file, header, nil
+}
+
+func (ah attachmentHandler) UploadToSong(ctx *gin.Context) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	file, header, err := bindFile(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+	defer file.Close()
+
+	context := ctx.Request.Context()
+
+	attachment, err := ah.as.StoreForSong(context, fields["id"], header.Filename, file, user)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"attachment": attachment})
+}
+
+func (ah attachmentHandler) UploadToEntry(ctx *gin.Context) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	fields, err := util.BindNamedParams(ctx, "id", "eid")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	file, header, err := bindFile(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+	defer file.Close()
+
+	context := ctx.Request.Context()
+
+	attachment, err := ah.as.StoreForEntry(context, fields["id"], fields["eid"], header.Filename, file, user)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"attachment": attachment})
+}
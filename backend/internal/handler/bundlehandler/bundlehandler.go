@@ -1,7 +1,10 @@
 package bundlehandler
 
 import (
+	"time"
+
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -19,3 +22,26 @@ func Initialize(rg *gin.RouterGroup, bs domain.BundleService, mwh domain.Middlew
 	bundle.DELETE(":id/delete", mwh.AuthenticateUser(), bundlehandler.Delete)
 	bundle.PUT(":id/update", mwh.AuthenticateUser(), bundlehandler.UpdateByID)
 }
+
+// matchesVersion writes a precondition error with the current bundle when the If-Match
+// header of the request is missing or does not match the version of the bundle. It returns the
+// matched version so the change can be restricted to it.
+func (bh bundleHandler) matchesVersion(ctx *gin.Context, bundleID int64) (time.Time, bool) {
+	bundle, err := bh.bs.FetchByID(ctx.Request.Context(), bundleID)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err})
+
+		return time.Time{}, false
+	}
+
+	etag := util.ETag("bundle", bundle.ID, bundle.UpdatedAt)
+
+	if err := util.CheckIfMatch(ctx, etag); err != nil {
+		ctx.Header("ETag", etag)
+		ctx.JSON(domain.Status(err), gin.H{"error": err, "bundle": bundle})
+
+		return time.Time{}, false
+	}
+
+	return bundle.UpdatedAt, true
+}
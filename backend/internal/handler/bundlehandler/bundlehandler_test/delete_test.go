@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
@@ -35,6 +36,7 @@ func prepareAndServeDelete(
 		nil,
 	)
 	assert.NoError(t, err)
+	req.Header.Set("If-Match", mockBundleETag)
 
 	router.ServeHTTP(writer, req)
 
@@ -59,7 +61,10 @@ func TestDeleteCorrect(t *testing.T) {
 	}
 
 	mockBS.
-		On("Remove", context.TODO(), bid, mockUser).
+		On("FetchByID", context.TODO(), int64(1)).
+		Return(&domain.Bundle{ID: 1}, nil)
+	mockBS.
+		On("Remove", context.TODO(), bid, time.Time{}, mockUser).
 		Return(nil)
 	mockMWH.
 		On("AuthenticateUser").
@@ -138,7 +143,10 @@ func TestDeleteRemoveErr(t *testing.T) {
 	}
 
 	mockBS.
-		On("Remove", context.TODO(), bid, mockUser).
+		On("FetchByID", context.TODO(), int64(1)).
+		Return(&domain.Bundle{ID: 1}, nil)
+	mockBS.
+		On("Remove", context.TODO(), bid, time.Time{}, mockUser).
 		Return(mockErr)
 	mockMWH.
 		On("AuthenticateUser").
@@ -0,0 +1,137 @@
+package bundlehandler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/bundlehandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func prepareAndServeConditional(
+	t *testing.T,
+	mockBS domain.BundleService,
+	method string,
+	path string,
+	headers map[string]string,
+	body []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", &domain.User{ID: 1, Permission: domain.ADMIN})
+		ctx.Next()
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	bundlehandler.Initialize(&router.RouterGroup, mockBS, mockMWH)
+
+	req, err := http.NewRequestWithContext(context.TODO(), method, path, bytes.NewReader(body))
+	assert.NoError(t, err)
+
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestBundlePreconditions(t *testing.T) {
+	t.Parallel()
+
+	mockBundle := &domain.Bundle{ID: 1, Name: "Foo", UpdatedAt: time.Now().Truncate(time.Millisecond)}
+	currentETag := util.ETag("bundle", mockBundle.ID, mockBundle.UpdatedAt)
+
+	t.Run("Correct not modified", func(t *testing.T) {
+		t.Parallel()
+
+		mockBS := &mocks.MockBundleService{}
+		mockBS.
+			On("FetchByID", context.TODO(), mockBundle.ID).
+			Return(mockBundle, nil)
+
+		writer := prepareAndServeConditional(t, mockBS, http.MethodGet, "/bundles/1", map[string]string{"If-None-Match": currentETag}, nil)
+
+		assert.Equal(t, http.StatusNotModified, writer.Code)
+		assert.Equal(t, currentETag, writer.Header().Get("ETag"))
+		mockBS.AssertExpectations(t)
+	})
+
+	t.Run("Correct modified", func(t *testing.T) {
+		t.Parallel()
+
+		mockBS := &mocks.MockBundleService{}
+		mockBS.
+			On("FetchByID", context.TODO(), mockBundle.ID).
+			Return(mockBundle, nil)
+
+		writer := prepareAndServeConditional(t, mockBS, http.MethodGet, "/bundles/1", map[string]string{"If-None-Match": `"foo"`}, nil)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, currentETag, writer.Header().Get("ETag"))
+		mockBS.AssertExpectations(t)
+	})
+
+	t.Run("Fail delete stale version", func(t *testing.T) {
+		t.Parallel()
+
+		mockBS := &mocks.MockBundleService{}
+		mockBS.
+			On("FetchByID", context.TODO(), mockBundle.ID).
+			Return(mockBundle, nil)
+
+		writer := prepareAndServeConditional(t, mockBS, http.MethodDelete, "/bundles/1/delete", map[string]string{"If-Match": `"foo"`}, nil)
+
+		expErr := domain.NewPreconditionFailedErr("resource was changed by someone else, fetch it again before changing it")
+		expectedBody, err := json.Marshal(gin.H{"error": expErr, "bundle": mockBundle})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusPreconditionFailed, writer.Code)
+		assert.Equal(t, currentETag, writer.Header().Get("ETag"))
+		assert.JSONEq(t, string(expectedBody), writer.Body.String())
+		mockBS.AssertExpectations(t)
+	})
+
+	t.Run("Fail update changed concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewPreconditionFailedErr("resource was changed by someone else, fetch it again before changing it")
+		mockBS := &mocks.MockBundleService{}
+		mockBS.
+			On("FetchByID", context.TODO(), mockBundle.ID).
+			Return(mockBundle, nil)
+		mockBS.
+			On("Update", context.TODO(), &domain.Bundle{ID: 1, Name: "Bar", ParentID: 2, UpdatedAt: mockBundle.UpdatedAt}, mock.AnythingOfType("*domain.User")).
+			Return(expErr)
+
+		byteBody, err := json.Marshal(gin.H{"name": "Bar", "parent_id": 2})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeConditional(
+			t, mockBS, http.MethodPut, "/bundles/1/update", map[string]string{"If-Match": currentETag}, byteBody,
+		)
+
+		assert.Equal(t, http.StatusPreconditionFailed, writer.Code)
+		mockBS.AssertExpectations(t)
+	})
+}
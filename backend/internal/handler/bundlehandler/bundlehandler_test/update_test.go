@@ -7,15 +7,20 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/bundlehandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/gin-gonic/gin"
 	"github.com/goccy/go-json"
 	"github.com/stretchr/testify/assert"
 )
 
+// mockBundleETag is the version of the bundle returned by the FetchByID expectations.
+var mockBundleETag = util.ETag("bundle", 1, time.Time{})
+
 func prepareAndServeUpdate(
 	t *testing.T,
 	param string,
@@ -42,6 +47,7 @@ func prepareAndServeUpdate(
 			bodyReader,
 		)
 		assert.NoError(t, err)
+		req.Header.Set("If-Match", mockBundleETag)
 
 		request = req
 	} else {
@@ -52,6 +58,7 @@ func prepareAndServeUpdate(
 			nil,
 		)
 		assert.NoError(t, err)
+		req.Header.Set("If-Match", mockBundleETag)
 
 		request = req
 	}
@@ -83,6 +90,9 @@ func TestUpdateCorrect(t *testing.T) {
 		ctx.Next()
 	}
 
+	mockBS.
+		On("FetchByID", context.TODO(), int64(1)).
+		Return(&domain.Bundle{ID: 1}, nil)
 	mockBS.
 		On("Update", context.TODO(), mockBundle, mockUser).
 		Return(nil)
@@ -206,6 +216,9 @@ func TestUpdateErr(t *testing.T) {
 	mockMWH := &mocks.MockMiddlewareHandler{}
 	mockBS := &mocks.MockBundleService{}
 
+	mockBS.
+		On("FetchByID", context.TODO(), int64(1)).
+		Return(&domain.Bundle{ID: 1}, nil)
 	mockBS.
 		On("Update", context.TODO(), mockBundle, mockUser).
 		Return(mockErr)
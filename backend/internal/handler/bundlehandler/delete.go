@@ -39,7 +39,12 @@ func (bh *bundleHandler) Delete(ctx *gin.Context) {
 
 	context := ctx.Request.Context()
 
-	err = bh.bs.Remove(context, int64(bundleID), principal)
+	version, ok := bh.matchesVersion(ctx, int64(bundleID))
+	if !ok {
+		return
+	}
+
+	err = bh.bs.Remove(context, int64(bundleID), version, principal)
 	if err != nil {
 		ctx.JSON(domain.Status(err), gin.H{"error": err})
 
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -28,6 +29,10 @@ func (bh bundleHandler) GetByID(ctx *gin.Context) {
 		return
 	}
 
+	if util.NotModified(ctx, util.ETag("bundle", bundles.ID, bundles.UpdatedAt)) {
+		return
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{"bundle": bundles})
 }
 
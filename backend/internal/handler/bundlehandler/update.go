@@ -58,6 +58,13 @@ func (bh bundleHandler) UpdateByID(ctx *gin.Context) {
 
 	context := ctx.Request.Context()
 
+	version, ok := bh.matchesVersion(ctx, int64(bundleID))
+	if !ok {
+		return
+	}
+
+	bundle.UpdatedAt = version
+
 	err = bh.bs.Update(context, bundle, principal)
 	if err != nil {
 		ctx.JSON(domain.Status(err), gin.H{"error": err})
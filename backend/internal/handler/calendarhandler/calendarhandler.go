@@ -0,0 +1,28 @@
+package calendarhandler
+
+import (
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+type calendarHandler struct {
+	cs       domain.CalendarService
+	basePath string
+}
+
+func Initialize(group *gin.RouterGroup, cs domain.CalendarService, mwh domain.MiddlewareHandler) {
+	calendarhandler := &calendarHandler{
+		cs:       cs,
+		basePath: group.BasePath(),
+	}
+
+	group.GET("setlists.ics", mwh.IdentifyUser(), calendarhandler.GetFeed)
+	group.GET("calendars/:token/setlists.ics", calendarhandler.GetPersonalFeed)
+
+	calendar := group.Group("users/me/calendar", mwh.AuthenticateUser())
+	calendar.GET("", calendarhandler.GetToken)
+	calendar.POST("", calendarhandler.RotateToken)
+	calendar.DELETE("", calendarhandler.RevokeToken)
+}
@@ -0,0 +1,251 @@
+package calendarhandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/calendarhandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func prepareAndServe(
+	t *testing.T,
+	mockCS domain.CalendarService,
+	mockUser *domain.User,
+	method string,
+	path string,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		if mockUser != nil {
+			ctx.Set("user", mockUser)
+		}
+
+		ctx.Next()
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
+
+	calendarhandler.Initialize(router.Group("api/v1"), mockCS, mockMWH)
+
+	req, err := http.NewRequestWithContext(context.TODO(), method, path, nil)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestGetFeed(t *testing.T) {
+	t.Parallel()
+
+	mockFeed := []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n")
+
+	t.Run("Correct anonymous", func(t *testing.T) {
+		t.Parallel()
+
+		mockCS := &mocks.MockCalendarService{}
+
+		mockCS.
+			On("FetchFeed", context.TODO(), (*domain.User)(nil)).
+			Return(mockFeed, nil)
+
+		writer := prepareAndServe(t, mockCS, nil, http.MethodGet, "/api/v1/setlists.ics")
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "text/calendar; charset=utf-8", writer.Header().Get("Content-Type"))
+		assert.Equal(t, mockFeed, writer.Body.Bytes())
+		mockCS.AssertExpectations(t)
+	})
+
+	t.Run("Correct identified", func(t *testing.T) {
+		t.Parallel()
+
+		mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+		mockCS := &mocks.MockCalendarService{}
+
+		mockCS.
+			On("FetchFeed", context.TODO(), mockUser).
+			Return(mockFeed, nil)
+
+		writer := prepareAndServe(t, mockCS, mockUser, http.MethodGet, "/api/v1/setlists.ics")
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, mockFeed, writer.Body.Bytes())
+		mockCS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchFeed", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockCS := &mocks.MockCalendarService{}
+
+		mockCS.
+			On("FetchFeed", context.TODO(), (*domain.User)(nil)).
+			Return(nil, expErr)
+
+		writer := prepareAndServe(t, mockCS, nil, http.MethodGet, "/api/v1/setlists.ics")
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.Status(expErr), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockCS.AssertExpectations(t)
+	})
+}
+
+func TestGetPersonalFeed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockFeed := []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n")
+		mockCS := &mocks.MockCalendarService{}
+
+		mockCS.
+			On("FetchPersonalFeed", context.TODO(), "secret").
+			Return(mockFeed, nil)
+
+		writer := prepareAndServe(t, mockCS, nil, http.MethodGet, "/api/v1/calendars/secret/setlists.ics")
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "text/calendar; charset=utf-8", writer.Header().Get("Content-Type"))
+		assert.Equal(t, mockFeed, writer.Body.Bytes())
+		mockCS.AssertExpectations(t)
+	})
+
+	t.Run("Fail revoked token", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewObjectNotFoundErr("calendar")
+		mockCS := &mocks.MockCalendarService{}
+
+		mockCS.
+			On("FetchPersonalFeed", context.TODO(), "revoked").
+			Return(nil, expErr)
+
+		writer := prepareAndServe(t, mockCS, nil, http.MethodGet, "/api/v1/calendars/revoked/setlists.ics")
+
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		mockCS.AssertExpectations(t)
+	})
+}
+
+func TestToken(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+	mockToken := &domain.CalendarToken{
+		ID:        1,
+		UserID:    mockUser.ID,
+		Token:     "secret",
+		CreatedAt: time.Now().Truncate(time.Second),
+	}
+
+	t.Run("Correct get", func(t *testing.T) {
+		t.Parallel()
+
+		mockCS := &mocks.MockCalendarService{}
+
+		mockCS.
+			On("FetchToken", context.TODO(), mockUser).
+			Return(mockToken, nil)
+
+		writer := prepareAndServe(t, mockCS, mockUser, http.MethodGet, "/api/v1/users/me/calendar")
+
+		expectedBytes, err := json.Marshal(gin.H{
+			"calendar": mockToken,
+			"path":     "/api/v1/calendars/secret/setlists.ics",
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockCS.AssertExpectations(t)
+	})
+
+	t.Run("Correct rotate", func(t *testing.T) {
+		t.Parallel()
+
+		mockCS := &mocks.MockCalendarService{}
+
+		mockCS.
+			On("RotateToken", context.TODO(), mockUser).
+			Return(mockToken, nil)
+
+		writer := prepareAndServe(t, mockCS, mockUser, http.MethodPost, "/api/v1/users/me/calendar")
+
+		expectedBytes, err := json.Marshal(gin.H{
+			"calendar": mockToken,
+			"path":     "/api/v1/calendars/secret/setlists.ics",
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockCS.AssertExpectations(t)
+	})
+
+	t.Run("Correct revoke", func(t *testing.T) {
+		t.Parallel()
+
+		mockCS := &mocks.MockCalendarService{}
+
+		mockCS.
+			On("RevokeToken", context.TODO(), mockUser).
+			Return(nil)
+
+		writer := prepareAndServe(t, mockCS, mockUser, http.MethodDelete, "/api/v1/users/me/calendar")
+
+		assert.Equal(t, http.StatusAccepted, writer.Code)
+		mockCS.AssertExpectations(t)
+	})
+
+	t.Run("Fail no user", func(t *testing.T) {
+		t.Parallel()
+
+		mockCS := &mocks.MockCalendarService{}
+
+		writer := prepareAndServe(t, mockCS, nil, http.MethodPost, "/api/v1/users/me/calendar")
+
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+		mockCS.AssertExpectations(t)
+	})
+
+	t.Run("Fail get without token", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("user_id", "1")
+		mockCS := &mocks.MockCalendarService{}
+
+		mockCS.
+			On("FetchToken", context.TODO(), mockUser).
+			Return(nil, expErr)
+
+		writer := prepareAndServe(t, mockCS, mockUser, http.MethodGet, "/api/v1/users/me/calendar")
+
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		mockCS.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,37 @@
+package calendarhandler
+
+import (
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+func (ch calendarHandler) GetFeed(ctx *gin.Context) {
+	context := ctx.Request.Context()
+
+	feed, err := ch.cs.FetchFeed(context, util.Identified(ctx))
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Header("Content-Disposition", "inline; filename=\"setlists.ics\"")
+	ctx.Data(http.StatusOK, calendarContentType, feed)
+}
+
+func (ch calendarHandler) GetPersonalFeed(ctx *gin.Context) {
+	context := ctx.Request.Context()
+
+	feed, err := ch.cs.FetchPersonalFeed(context, ctx.Param("token"))
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Header("Content-Disposition", "inline; filename=\"setlists.ics\"")
+	ctx.Data(http.StatusOK, calendarContentType, feed)
+}
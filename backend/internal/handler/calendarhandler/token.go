@@ -0,0 +1,74 @@
+package calendarhandler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+// feedPath returns the path of the personal feed of the token.
+func (ch calendarHandler) feedPath(token *domain.CalendarToken) string {
+	return fmt.Sprintf("%s/calendars/%s/setlists.ics", ch.basePath, token.Token)
+}
+
+func (ch calendarHandler) GetToken(ctx *gin.Context) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	token, err := ch.cs.FetchToken(context, user)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"calendar": token, "path": ch.feedPath(token)})
+}
+
+func (ch calendarHandler) RotateToken(ctx *gin.Context) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	token, err := ch.cs.RotateToken(context, user)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"calendar": token, "path": ch.feedPath(token)})
+}
+
+func (ch calendarHandler) RevokeToken(ctx *gin.Context) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	if err := ch.cs.RevokeToken(context, user); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
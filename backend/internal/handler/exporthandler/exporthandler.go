@@ -0,0 +1,19 @@
+package exporthandler
+
+import (
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+type exportHandler struct {
+	es domain.ExportService
+}
+
+func Initialize(group *gin.RouterGroup, es domain.ExportService, mwh domain.MiddlewareHandler) {
+	exporthandler := &exportHandler{
+		es: es,
+	}
+
+	setlists := group.Group("setlists")
+	setlists.GET(":id/export.pdf", mwh.IdentifyUser(), exporthandler.GetSetlistPDF)
+}
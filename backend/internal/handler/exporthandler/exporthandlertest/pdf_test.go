@@ -0,0 +1,108 @@
+package exporthandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/exporthandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func prepareAndServeGet(t *testing.T, path string, mockES domain.ExportService) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	var mockIdentifyHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockIdentifyHF)
+
+	exporthandler.Initialize(&router.RouterGroup, mockES, mockMWH)
+
+	req, err := http.NewRequestWithContext(
+		context.TODO(),
+		http.MethodGet,
+		fmt.Sprintf("/setlists%s", path),
+		nil,
+	)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestGetSetlistPDF(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockPDF := []byte("%PDF-1.4\n%%EOF\n")
+		mockES := &mocks.MockExportService{}
+
+		mockES.
+			On("ExportPDF", context.TODO(), int64(1), (*domain.User)(nil)).
+			Return(mockPDF, nil)
+
+		writer := prepareAndServeGet(t, "/1/export.pdf", mockES)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "application/pdf", writer.Header().Get("Content-Type"))
+		assert.Equal(t, "inline; filename=\"setlist-1.pdf\"", writer.Header().Get("Content-Disposition"))
+		assert.Equal(t, mockPDF, writer.Body.Bytes())
+		mockES.AssertExpectations(t)
+	})
+
+	t.Run("Fail Invalid Param", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewBadRequestErr("Could not read a")
+		mockES := &mocks.MockExportService{}
+
+		writer := prepareAndServeGet(t, "/a/export.pdf", mockES)
+
+		expBody, err := json.Marshal(gin.H{
+			"error": mockErr.Error(),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.Status(mockErr), writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockES.AssertExpectations(t)
+	})
+
+	t.Run("Fail Export error", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewRecordNotFoundErr("id", "1")
+		mockES := &mocks.MockExportService{}
+
+		mockES.
+			On("ExportPDF", context.TODO(), int64(1), (*domain.User)(nil)).
+			Return(nil, mockErr)
+
+		writer := prepareAndServeGet(t, "/1/export.pdf", mockES)
+
+		expBody, err := json.Marshal(gin.H{
+			"error": mockErr.Error(),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.Status(mockErr), writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockES.AssertExpectations(t)
+	})
+}
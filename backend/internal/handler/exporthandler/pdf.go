@@ -0,0 +1,31 @@
+package exporthandler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+func (eh exportHandler) GetSetlistPDF(ctx *gin.Context) {
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	pdf, err := eh.es.ExportPDF(context, fields["id"], util.Identified(ctx))
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("inline; filename=\"setlist-%d.pdf\"", fields["id"]))
+	ctx.Data(http.StatusOK, "application/pdf", pdf)
+}
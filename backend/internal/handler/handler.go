@@ -4,12 +4,19 @@ import (
 	"log"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/attachmenthandler"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/bundlehandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/calendarhandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/exporthandler"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/mehandler"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/rolehandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/serieshandler"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/setlisthandler"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/setlistrolehandler"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/songhandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/statshandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/taghandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/templatehandler"
 	userhandler "github.com/96Asch/mkvstage-server/backend/internal/handler/userhandler"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/userrolehandler"
 	"github.com/gin-gonic/gin"
@@ -27,6 +34,14 @@ type Config struct {
 	SL     domain.SetlistService
 	SE     domain.SetlistEntryService
 	SLR    domain.SetlistRoleService
+	EX     domain.ExportService
+	ST     domain.StatsService
+	TG     domain.TagService
+	AT     domain.AttachmentService
+	TP     domain.SetlistTemplateService
+	SS     domain.SetlistSeriesService
+	CL     domain.CalendarService
+	TX     domain.Transactor
 }
 
 func (cfg *Config) New() *Config {
@@ -47,6 +62,13 @@ func Initialize(config *Config) {
 	songhandler.Initialize(version1, config.S, config.MH)
 	rolehandler.Initialize(version1, config.R, config.MH)
 	userrolehandler.Initialize(version1, config.UR, config.MH)
-	setlisthandler.Initialize(version1, config.SL, config.SE, config.S, config.MH)
+	setlisthandler.Initialize(version1, config.SL, config.SE, config.S, config.TX, config.MH)
 	setlistrolehandler.Initialize(version1, config.SLR, config.MH)
+	exporthandler.Initialize(version1, config.EX, config.MH)
+	statshandler.Initialize(version1, config.ST, config.MH)
+	taghandler.Initialize(version1, config.TG, config.MH)
+	attachmenthandler.Initialize(version1, config.AT, config.MH)
+	templatehandler.Initialize(version1, config.TP, config.MH)
+	serieshandler.Initialize(version1, config.SS, config.MH)
+	calendarhandler.Initialize(version1, config.CL, config.MH)
 }
@@ -44,6 +44,22 @@ func (gmh ginMiddlewareHandler) AuthenticateUser() gin.HandlerFunc {
 	}
 }
 
+// IdentifyUser sets the user of the access token like AuthenticateUser when one is given,
+// requests without an Authorization header continue without a user.
+func (gmh ginMiddlewareHandler) IdentifyUser() gin.HandlerFunc {
+	authenticate := gmh.AuthenticateUser()
+
+	return func(ctx *gin.Context) {
+		if ctx.GetHeader("Authorization") == "" {
+			ctx.Next()
+
+			return
+		}
+
+		authenticate(ctx)
+	}
+}
+
 func (gmh ginMiddlewareHandler) JWTExtractEmail() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		header := tokenHeader{}
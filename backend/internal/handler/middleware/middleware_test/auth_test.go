@@ -1,6 +1,7 @@
 package middleware_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -121,3 +122,91 @@ func TestAuthenticateUserFetchByEmailErr(t *testing.T) {
 	assert.Equal(t, expBody, writer.Body.Bytes())
 	mockTS.AssertExpectations(t)
 }
+
+func prepareAndServeIdentify(t *testing.T, mockUS domain.UserService, mockTS domain.TokenService, token string) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+	gmh := middleware.NewGinMiddlewareHandler(mockUS, mockTS)
+
+	router.GET("/identify", gmh.IdentifyUser(), func(ctx *gin.Context) {
+		_, exists := ctx.Get("user")
+		ctx.JSON(http.StatusOK, gin.H{"identified": exists})
+	})
+
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/identify", nil)
+	assert.NoError(t, err)
+
+	if token != "" {
+		req.Header.Add("Authorization", token)
+	}
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestIdentifyUser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct anonymous", func(t *testing.T) {
+		t.Parallel()
+
+		mockUS := &mocks.MockUserService{}
+		mockTS := &mocks.MockTokenService{}
+
+		writer := prepareAndServeIdentify(t, mockUS, mockTS, "")
+
+		expBody, err := json.Marshal(gin.H{"identified": false})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockTS.AssertExpectations(t)
+		mockUS.AssertExpectations(t)
+	})
+
+	t.Run("Correct token", func(t *testing.T) {
+		t.Parallel()
+
+		mockUser := &domain.User{ID: 1, Email: "Foo@Bar.com"}
+		mockUS := &mocks.MockUserService{}
+		mockTS := &mocks.MockTokenService{}
+
+		mockTS.
+			On("ExtractEmail", context.TODO(), mockAccess).
+			Return(mockUser.Email, nil)
+		mockUS.
+			On("FetchByEmail", context.TODO(), mockUser.Email).
+			Return(mockUser, nil)
+
+		writer := prepareAndServeIdentify(t, mockUS, mockTS, mockAccess)
+
+		expBody, err := json.Marshal(gin.H{"identified": true})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockTS.AssertExpectations(t)
+		mockUS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid token", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("invalid token")
+		mockUS := &mocks.MockUserService{}
+		mockTS := &mocks.MockTokenService{}
+
+		mockTS.
+			On("ExtractEmail", context.TODO(), mockAccess).
+			Return(nil, expErr)
+
+		writer := prepareAndServeIdentify(t, mockUS, mockTS, mockAccess)
+
+		assert.Equal(t, domain.Status(expErr), writer.Code)
+		mockTS.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,56 @@
+package serieshandler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type seriesReq struct {
+	Name       string    `json:"name" binding:"required,lte=255"`
+	Rule       string    `json:"rule" binding:"required,lte=255"`
+	Timezone   string    `json:"timezone" binding:"required,lte=64"`
+	Start      time.Time `json:"start" binding:"required"`
+	TemplateID int64     `json:"template_id"`
+}
+
+func (req *seriesReq) series(sid int64) *domain.SetlistSeries {
+	return &domain.SetlistSeries{
+		ID:         sid,
+		Name:       req.Name,
+		Rule:       req.Rule,
+		Timezone:   req.Timezone,
+		Start:      req.Start.UTC().Truncate(time.Minute),
+		TemplateID: req.TemplateID,
+	}
+}
+
+func (sh seriesHandler) Create(ctx *gin.Context) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	var req seriesReq
+	if err := util.BindModel(ctx, &req); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	series := req.series(0)
+	context := ctx.Request.Context()
+
+	if err := sh.sss.Store(context, series, user); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"series": series})
+}
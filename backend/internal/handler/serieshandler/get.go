@@ -0,0 +1,77 @@
+package serieshandler
+
+import (
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+func (sh seriesHandler) GetByID(ctx *gin.Context) {
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	series, err := sh.sss.FetchByID(context, fields["id"])
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"series": series})
+}
+
+func (sh seriesHandler) GetAll(ctx *gin.Context) {
+	context := ctx.Request.Context()
+
+	series, err := sh.sss.FetchAll(context)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"series": series})
+}
+
+func (sh seriesHandler) Preview(ctx *gin.Context) {
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	fromTime, fromErr := util.StringToTime(ctx.Query("from"))
+	toTime, toErr := util.StringToTime(ctx.Query("to"))
+
+	if fromErr != nil {
+		ctx.JSON(domain.Status(fromErr), gin.H{"error": fromErr.Error()})
+
+		return
+	}
+
+	if toErr != nil {
+		ctx.JSON(domain.Status(toErr), gin.H{"error": toErr.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	occurrences, err := sh.sss.Preview(context, fields["id"], fromTime, toTime)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"occurrences": occurrences})
+}
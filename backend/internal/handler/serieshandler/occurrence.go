@@ -0,0 +1,106 @@
+package serieshandler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type materializeReq struct {
+	Until time.Time `json:"until" binding:"required"`
+}
+
+type occurrenceReq struct {
+	Date time.Time `json:"date" binding:"required"`
+}
+
+func (sh seriesHandler) Materialize(ctx *gin.Context) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	var req materializeReq
+	if err := util.BindModel(ctx, &req); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	setlists, err := sh.sss.Materialize(context, fields["id"], req.Until.UTC(), user)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"setlists": setlists})
+}
+
+// bindOccurrence reads the principal, the series id and the date of the occurrence.
+func bindOccurrence(ctx *gin.Context) (*domain.User, int64, time.Time, error) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	var req occurrenceReq
+	if err := util.BindModel(ctx, &req); err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	return user, fields["id"], req.Date.UTC(), nil
+}
+
+func (sh seriesHandler) Skip(ctx *gin.Context) {
+	user, sid, date, err := bindOccurrence(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	if err := sh.sss.Skip(ctx.Request.Context(), sid, date, user); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
+
+func (sh seriesHandler) Unskip(ctx *gin.Context) {
+	user, sid, date, err := bindOccurrence(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	if err := sh.sss.Unskip(ctx.Request.Context(), sid, date, user); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
@@ -0,0 +1,27 @@
+package serieshandler
+
+import (
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+type seriesHandler struct {
+	sss domain.SetlistSeriesService
+}
+
+func Initialize(group *gin.RouterGroup, sss domain.SetlistSeriesService, mwh domain.MiddlewareHandler) {
+	serieshandler := &seriesHandler{
+		sss: sss,
+	}
+
+	series := group.Group("series")
+	series.GET("", serieshandler.GetAll)
+	series.GET(":id", serieshandler.GetByID)
+	series.GET(":id/preview", serieshandler.Preview)
+	series.POST("create", mwh.AuthenticateUser(), serieshandler.Create)
+	series.PUT(":id/update", mwh.AuthenticateUser(), serieshandler.UpdateByID)
+	series.DELETE(":id/delete", mwh.AuthenticateUser(), serieshandler.Delete)
+	series.POST(":id/materialize", mwh.AuthenticateUser(), serieshandler.Materialize)
+	series.POST(":id/skip", mwh.AuthenticateUser(), serieshandler.Skip)
+	series.POST(":id/unskip", mwh.AuthenticateUser(), serieshandler.Unskip)
+}
@@ -0,0 +1,292 @@
+package serieshandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/serieshandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func prepareAndServe(
+	t *testing.T,
+	mockSSS domain.SetlistSeriesService,
+	mockUser *domain.User,
+	method string,
+	path string,
+	body []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	serieshandler.Initialize(&router.RouterGroup, mockSSS, mockMWH)
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(context.TODO(), method, path, reader)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestGetByID(t *testing.T) {
+	t.Parallel()
+
+	mockSeries := &domain.SetlistSeries{ID: 1, Name: "Sunday", Rule: "FREQ=WEEKLY;BYDAY=SU", Timezone: "UTC"}
+	mockSSS := &mocks.MockSetlistSeriesService{}
+
+	mockSSS.
+		On("FetchByID", context.TODO(), int64(1)).
+		Return(mockSeries, nil)
+
+	writer := prepareAndServe(t, mockSSS, nil, http.MethodGet, "/series/1", nil)
+
+	expectedBytes, err := json.Marshal(gin.H{"series": mockSeries})
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, writer.Code)
+	assert.Equal(t, expectedBytes, writer.Body.Bytes())
+	mockSSS.AssertExpectations(t)
+}
+
+func TestPreview(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		from := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+		mockOccurrences := &[]domain.SeriesOccurrence{
+			{SetlistSeriesID: 1, Date: time.Date(2024, time.March, 3, 9, 0, 0, 0, time.UTC)},
+		}
+		mockSSS := &mocks.MockSetlistSeriesService{}
+
+		mockSSS.
+			On("Preview", context.TODO(), int64(1), from, to).
+			Return(mockOccurrences, nil)
+
+		writer := prepareAndServe(
+			t, mockSSS, nil, http.MethodGet, "/series/1/preview?from=2024-03-01T00:00:00Z&to=2024-04-01T00:00:00Z", nil,
+		)
+
+		expectedBytes, err := json.Marshal(gin.H{"occurrences": mockOccurrences})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid time", func(t *testing.T) {
+		t.Parallel()
+
+		mockSSS := &mocks.MockSetlistSeriesService{}
+
+		writer := prepareAndServe(t, mockSSS, nil, http.MethodGet, "/series/1/preview?from=yesterday", nil)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSSS.AssertExpectations(t)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+	start := time.Date(2024, time.January, 7, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSeries := &domain.SetlistSeries{
+			Name:     "Sunday",
+			Rule:     "FREQ=WEEKLY;BYDAY=SU",
+			Timezone: "Europe/Amsterdam",
+			Start:    start,
+		}
+		mockSSS := &mocks.MockSetlistSeriesService{}
+
+		mockSSS.
+			On("Store", context.TODO(), mockSeries, mockUser).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				arg, ok := args.Get(1).(*domain.SetlistSeries)
+				assert.True(t, ok)
+				arg.ID = 1
+			})
+
+		body, err := json.Marshal(gin.H{
+			"name":     "Sunday",
+			"rule":     "FREQ=WEEKLY;BYDAY=SU",
+			"timezone": "Europe/Amsterdam",
+			"start":    "2024-01-07T10:00:00+01:00",
+		})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSSS, mockUser, http.MethodPost, "/series/create", body)
+		mockSeries.ID = 1
+
+		expectedBytes, err := json.Marshal(gin.H{"series": mockSeries})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail missing rule", func(t *testing.T) {
+		t.Parallel()
+
+		mockSSS := &mocks.MockSetlistSeriesService{}
+
+		body, err := json.Marshal(gin.H{"name": "Sunday", "timezone": "UTC", "start": start})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSSS, mockUser, http.MethodPost, "/series/create", body)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSSS.AssertExpectations(t)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+	mockSSS := &mocks.MockSetlistSeriesService{}
+
+	mockSSS.
+		On("Remove", context.TODO(), int64(1), mockUser).
+		Return(nil)
+
+	writer := prepareAndServe(t, mockSSS, mockUser, http.MethodDelete, "/series/1/delete", nil)
+
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+	mockSSS.AssertExpectations(t)
+}
+
+func TestMaterialize(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+	until := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSetlists := &[]domain.Setlist{{ID: 2, Name: "Sunday 2024-05-05", CreatorID: 1}}
+		mockSSS := &mocks.MockSetlistSeriesService{}
+
+		mockSSS.
+			On("Materialize", context.TODO(), int64(1), until, mockUser).
+			Return(mockSetlists, nil)
+
+		body, err := json.Marshal(gin.H{"until": until})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSSS, mockUser, http.MethodPost, "/series/1/materialize", body)
+
+		expectedBytes, err := json.Marshal(gin.H{"setlists": mockSetlists})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail Materialize error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("not authorized to materialize setlist series")
+		mockSSS := &mocks.MockSetlistSeriesService{}
+
+		mockSSS.
+			On("Materialize", context.TODO(), int64(1), until, mockUser).
+			Return(nil, expErr)
+
+		body, err := json.Marshal(gin.H{"until": until})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSSS, mockUser, http.MethodPost, "/series/1/materialize", body)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		mockSSS.AssertExpectations(t)
+	})
+}
+
+func TestSkip(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+	date := time.Date(2024, time.May, 5, 8, 0, 0, 0, time.UTC)
+
+	t.Run("Correct skip", func(t *testing.T) {
+		t.Parallel()
+
+		mockSSS := &mocks.MockSetlistSeriesService{}
+
+		mockSSS.
+			On("Skip", context.TODO(), int64(1), date, mockUser).
+			Return(nil)
+
+		body, err := json.Marshal(gin.H{"date": "2024-05-05T10:00:00+02:00"})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSSS, mockUser, http.MethodPost, "/series/1/skip", body)
+
+		assert.Equal(t, http.StatusAccepted, writer.Code)
+		mockSSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail Unskip error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("occurrence is not skipped")
+		mockSSS := &mocks.MockSetlistSeriesService{}
+
+		mockSSS.
+			On("Unskip", context.TODO(), int64(1), date, mockUser).
+			Return(expErr)
+
+		body, err := json.Marshal(gin.H{"date": date})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSSS, mockUser, http.MethodPost, "/series/1/unskip", body)
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSSS.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,36 @@
+package setlisthandler
+
+import (
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+func (slh setlistHandler) GetArrangement(ctx *gin.Context) {
+	fields, err := util.BindNamedParams(ctx, "id", "eid")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	if _, err := slh.sls.FetchByID(context, fields["id"], util.Identified(ctx)); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	arrangement, err := slh.sles.FetchArrangement(context, fields["id"], fields["eid"], util.BindSheetOptions(ctx))
+
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"arrangement": arrangement})
+}
@@ -1,6 +1,7 @@
 package setlisthandler
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"time"
@@ -19,10 +20,16 @@ type setlistCreateReq struct {
 }
 
 type setlistRoleCreateReq struct {
-	SongID      int64    `json:"song_id" binding:"required"`
+	Type        string   `json:"type"`
+	SongID      int64    `json:"song_id"`
+	Title       string   `json:"title" binding:"lte=255"`
+	Content     string   `json:"content"`
+	Reference   string   `json:"reference" binding:"lte=255"`
 	Transpose   int16    `json:"transpose"`
 	Notes       string   `json:"notes"`
 	Arrangement []string `json:"arrangement"`
+	Languages   string   `json:"languages"`
+	Duration    uint     `json:"duration"`
 	Rank        int64    `json:"rank" binding:"required"`
 }
 
@@ -56,13 +63,6 @@ func (slh setlistHandler) Create(ctx *gin.Context) {
 		Deadline:  slReq.Deadline.Local().Truncate(time.Minute),
 	}
 
-	context := ctx.Request.Context()
-	if err := slh.sls.Store(context, setlist, user); err != nil {
-		ctx.JSON(domain.Status(err), gin.H{"error": err})
-
-		return
-	}
-
 	setlistEntries := make([]domain.SetlistEntry, len(slReq.CreatedEntries))
 
 	for idx, entry := range slReq.CreatedEntries {
@@ -75,25 +75,55 @@ func (slh setlistHandler) Create(ctx *gin.Context) {
 		}
 
 		setlistEntries[idx] = domain.SetlistEntry{
+			Type:        domain.SetlistItemType(entry.Type),
 			SongID:      entry.SongID,
-			SetlistID:   setlist.ID,
 			Transpose:   entry.Transpose,
+			Title:       entry.Title,
+			Content:     entry.Content,
+			Reference:   entry.Reference,
 			Notes:       entry.Notes,
 			Arrangement: datatypes.JSON(jsonArray),
+			Languages:   entry.Languages,
+			Duration:    entry.Duration,
 			Rank:        entry.Rank,
 		}
 	}
 
-	if err := slh.sles.StoreBatch(context, &setlistEntries, user); err != nil {
-		ctx.JSON(domain.Status(err), gin.H{"error": err})
+	context := ctx.Request.Context()
+	if err := slh.storeWithEntries(context, setlist, setlistEntries, user); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
 
 		return
 	}
 
-	response := setlistResponse{
-		*setlist,
-		setlistEntries,
+	timings, err := slh.sles.FetchTimings(context, &setlistEntries)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
 	}
 
+	response := newSetlistResponse(*setlist, setlistEntries, timings[setlist.ID])
+
 	ctx.JSON(http.StatusCreated, gin.H{"setlist": response})
 }
+
+// storeWithEntries stores the setlist and its entries in a single unit of work.
+func (slh setlistHandler) storeWithEntries(
+	ctx context.Context,
+	setlist *domain.Setlist,
+	setlistEntries []domain.SetlistEntry,
+	principal *domain.User,
+) error {
+	return slh.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := slh.sls.Store(ctx, setlist, principal); err != nil {
+			return err
+		}
+
+		for idx := range setlistEntries {
+			setlistEntries[idx].SetlistID = setlist.ID
+		}
+
+		return slh.sles.StoreBatch(ctx, &setlistEntries, principal)
+	})
+}
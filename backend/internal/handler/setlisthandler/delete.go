@@ -1,8 +1,10 @@
 package setlisthandler
 
 import (
+	"context"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/gin-gonic/gin"
@@ -37,14 +39,12 @@ func (slh setlistHandler) DeleteByID(ctx *gin.Context) {
 		return
 	}
 
-	err = slh.sls.Remove(context, int64(setlistID), user)
-	if err != nil {
-		ctx.JSON(domain.Status(err), gin.H{"error": err})
-
+	version, ok := slh.matchesVersion(ctx, int64(setlistID), user)
+	if !ok {
 		return
 	}
 
-	if err := slh.sles.RemoveBySetlist(context, &domain.Setlist{ID: int64(setlistID)}, user); err != nil {
+	if err := slh.removeWithEntries(context, int64(setlistID), version, user); err != nil {
 		ctx.JSON(domain.Status(err), gin.H{"error": err})
 
 		return
@@ -52,3 +52,14 @@ func (slh setlistHandler) DeleteByID(ctx *gin.Context) {
 
 	ctx.Status(http.StatusAccepted)
 }
+
+// removeWithEntries removes the entries of the setlist and then the setlist itself in a single unit of work.
+func (slh setlistHandler) removeWithEntries(ctx context.Context, setlistID int64, version time.Time, principal *domain.User) error {
+	return slh.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := slh.sles.RemoveBySetlist(ctx, &domain.Setlist{ID: setlistID}, principal); err != nil {
+			return err
+		}
+
+		return slh.sls.Remove(ctx, setlistID, version, principal)
+	})
+}
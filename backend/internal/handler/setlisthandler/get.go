@@ -3,15 +3,47 @@ package setlisthandler
 import (
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/gin-gonic/gin"
 )
 
+type setlistEntryResponse struct {
+	domain.SetlistEntry
+	Start             uint `json:"start"`
+	EstimatedDuration uint `json:"estimated_duration"`
+}
+
 type setlistResponse struct {
 	domain.Setlist
-	Entries []domain.SetlistEntry `json:"entries"`
+	Entries []setlistEntryResponse `json:"entries"`
+	RunTime uint                   `json:"run_time"`
+}
+
+func newSetlistResponse(setlist domain.Setlist, entries []domain.SetlistEntry, timing domain.SetlistTiming) setlistResponse {
+	starts := make(map[int64]domain.EntryTiming, len(timing.Entries))
+
+	for _, entryTiming := range timing.Entries {
+		starts[entryTiming.EntryID] = entryTiming
+	}
+
+	response := setlistResponse{
+		Setlist: setlist,
+		Entries: make([]setlistEntryResponse, len(entries)),
+		RunTime: timing.RunTime,
+	}
+
+	for idx, entry := range entries {
+		response.Entries[idx] = setlistEntryResponse{
+			SetlistEntry:      entry,
+			Start:             starts[entry.ID].Start,
+			EstimatedDuration: starts[entry.ID].Duration,
+		}
+	}
+
+	return response
 }
 
 func (slh setlistHandler) GetAll(ctx *gin.Context) {
@@ -31,7 +63,7 @@ func (slh setlistHandler) GetAll(ctx *gin.Context) {
 	}
 
 	context := ctx.Request.Context()
-	retrievedSetlists, err := slh.sls.Fetch(context, fromTime, toTime)
+	retrievedSetlists, err := slh.sls.Fetch(context, fromTime, toTime, util.Identified(ctx))
 
 	if err != nil {
 		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
@@ -47,15 +79,20 @@ func (slh setlistHandler) GetAll(ctx *gin.Context) {
 		return
 	}
 
+	timings, err := slh.sles.FetchTimings(context, retrievedSetlistEntries)
+
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
 	response := make([]setlistResponse, len(*retrievedSetlists))
 	sortedEntries := sortBySetlist(retrievedSetlistEntries)
 
 	for idx, setlist := range *retrievedSetlists {
 		log.Printf("sid: %d", setlist.ID)
-		response[idx] = setlistResponse{
-			setlist,
-			sortedEntries[setlist.ID],
-		}
+		response[idx] = newSetlistResponse(setlist, sortedEntries[setlist.ID], timings[setlist.ID])
 		log.Println(response[idx])
 	}
 
@@ -74,7 +111,7 @@ func (slh setlistHandler) GetByID(ctx *gin.Context) {
 	}
 
 	context := ctx.Request.Context()
-	setlist, err := slh.sls.FetchByID(context, fields["id"])
+	setlist, err := slh.sls.FetchByID(context, fields["id"], util.Identified(ctx))
 
 	if err != nil {
 		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
@@ -82,6 +119,10 @@ func (slh setlistHandler) GetByID(ctx *gin.Context) {
 		return
 	}
 
+	if util.NotModified(ctx, util.SetlistETag(setlist, time.Now())) {
+		return
+	}
+
 	setlistEntries, err := slh.sles.FetchBySetlist(context, &[]domain.Setlist{*setlist})
 
 	if err != nil {
@@ -90,11 +131,16 @@ func (slh setlistHandler) GetByID(ctx *gin.Context) {
 		return
 	}
 
-	response := setlistResponse{
-		*setlist,
-		*setlistEntries,
+	timings, err := slh.sles.FetchTimings(context, setlistEntries)
+
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
 	}
 
+	response := newSetlistResponse(*setlist, *setlistEntries, timings[setlist.ID])
+
 	ctx.JSON(http.StatusOK, gin.H{
 		"setlist": response,
 	})
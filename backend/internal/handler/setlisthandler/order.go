@@ -0,0 +1,54 @@
+package setlisthandler
+
+import (
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type setlistOrderReq struct {
+	Order []int64 `json:"order" binding:"required"`
+}
+
+func (slh setlistHandler) Reorder(ctx *gin.Context) {
+	val, exists := ctx.Get("user")
+	if !exists {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	user, ok := val.(*domain.User)
+	if !ok {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	var orderReq setlistOrderReq
+	if err := util.BindModel(ctx, &orderReq); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	entries, err := slh.sles.Reorder(ctx.Request.Context(), fields["id"], orderReq.Order, user)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"entries": entries})
+}
@@ -1,7 +1,10 @@
 package setlisthandler
 
 import (
+	"time"
+
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -9,19 +12,58 @@ type setlistHandler struct {
 	sls  domain.SetlistService
 	sles domain.SetlistEntryService
 	ss   domain.SongService
+	tx   domain.Transactor
 }
 
-func Initialize(group *gin.RouterGroup, sls domain.SetlistService, sles domain.SetlistEntryService, ss domain.SongService, mwh domain.MiddlewareHandler) {
+func Initialize(
+	group *gin.RouterGroup,
+	sls domain.SetlistService,
+	sles domain.SetlistEntryService,
+	ss domain.SongService,
+	tx domain.Transactor,
+	mwh domain.MiddlewareHandler,
+) {
 	setlisthandler := &setlistHandler{
 		sls:  sls,
 		sles: sles,
 		ss:   ss,
+		tx:   tx,
 	}
 
 	setlists := group.Group("setlists")
 	setlists.POST("", mwh.AuthenticateUser(), setlisthandler.Create)
-	setlists.GET("", setlisthandler.GetAll)
-	setlists.GET(":id", setlisthandler.GetByID)
+	setlists.GET("", mwh.IdentifyUser(), setlisthandler.GetAll)
+	setlists.GET(":id", mwh.IdentifyUser(), setlisthandler.GetByID)
+	setlists.GET(":id/analysis", mwh.IdentifyUser(), setlisthandler.GetAnalysis)
+	setlists.GET(":id/entries/:eid/sheet", mwh.IdentifyUser(), setlisthandler.GetSheet)
+	setlists.GET(":id/entries/:eid/arrangement", mwh.IdentifyUser(), setlisthandler.GetArrangement)
 	setlists.DELETE(":id/delete", mwh.AuthenticateUser(), setlisthandler.DeleteByID)
 	setlists.PUT(":id", mwh.AuthenticateUser(), setlisthandler.UpdateByID)
+	setlists.PATCH(":id/entries/order", mwh.AuthenticateUser(), setlisthandler.Reorder)
+	setlists.POST(":id/publish", mwh.AuthenticateUser(), setlisthandler.Publish)
+	setlists.POST(":id/unpublish", mwh.AuthenticateUser(), setlisthandler.Unpublish)
+	setlists.POST(":id/archive", mwh.AuthenticateUser(), setlisthandler.Archive)
+}
+
+// matchesVersion writes a precondition error with the current setlist when the If-Match
+// header of the request is missing or does not match the version of the setlist. It returns the
+// matched version so the change can be restricted to it.
+func (slh setlistHandler) matchesVersion(ctx *gin.Context, setlistID int64, principal *domain.User) (time.Time, bool) {
+	setlist, err := slh.sls.FetchByID(ctx.Request.Context(), setlistID, principal)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return time.Time{}, false
+	}
+
+	etag := util.SetlistETag(setlist, time.Now())
+
+	if err := util.CheckIfMatch(ctx, etag); err != nil {
+		ctx.Header("ETag", etag)
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error(), "setlist": setlist})
+
+		return time.Time{}, false
+	}
+
+	return setlist.UpdatedAt, true
 }
@@ -0,0 +1,134 @@
+package setlisthandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAnalysis(t *testing.T) {
+	expAnalysis := &domain.SetlistAnalysis{
+		SetlistID: 1,
+		Transitions: []domain.EntryTransition{
+			{FromEntryID: 1, ToEntryID: 2, FromKey: "C", ToKey: "F#", KeyDistance: 6, BpmChange: 40},
+		},
+		Warnings: []domain.SetlistWarning{
+			{Type: domain.KeyJumpWarning, FromEntryID: 1, ToEntryID: 2, Message: "key jumps 6 semitones from C to F#"},
+			{Type: domain.TempoSwingWarning, FromEntryID: 1, ToEntryID: 2, Message: "tempo changes from 70 to 110 bpm"},
+		},
+		Suggestions: []domain.TransposeSuggestion{
+			{EntryID: 2, Transpose: -4, Key: "D"},
+		},
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSL := &mocks.MockSetlistService{}
+		mockSLES := &mocks.MockSetlistEntryService{}
+		mockSS := &mocks.MockSongService{}
+		mockMWH := &mocks.MockMiddlewareHandler{}
+
+		var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+		mockMWH.
+			On("AuthenticateUser").
+			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
+
+		mockSL.
+			On("FetchByID", context.TODO(), int64(1), (*domain.User)(nil)).
+			Return(&domain.Setlist{ID: 1}, nil)
+
+		mockSLES.
+			On("FetchAnalysis", context.TODO(), int64(1)).
+			Return(expAnalysis, nil)
+
+		writer := prepareAndServeGet(t, "/1/analysis", mockSL, mockSLES, mockSS, mockMWH)
+
+		expBody, err := json.Marshal(gin.H{
+			"analysis": expAnalysis,
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockSLES.AssertExpectations(t)
+	})
+
+	t.Run("Fail Invalid Param", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewBadRequestErr("Could not read a")
+		mockSL := &mocks.MockSetlistService{}
+		mockSLES := &mocks.MockSetlistEntryService{}
+		mockSS := &mocks.MockSongService{}
+		mockMWH := &mocks.MockMiddlewareHandler{}
+
+		var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+		mockMWH.
+			On("AuthenticateUser").
+			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
+
+		writer := prepareAndServeGet(t, "/a/analysis", mockSL, mockSLES, mockSS, mockMWH)
+
+		expBody, err := json.Marshal(gin.H{
+			"error": mockErr.Error(),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.Status(mockErr), writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockSLES.AssertExpectations(t)
+	})
+
+	t.Run("Fail Fetch Analysis", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewRecordNotFoundErr("id", "1")
+		mockSL := &mocks.MockSetlistService{}
+		mockSLES := &mocks.MockSetlistEntryService{}
+		mockSS := &mocks.MockSongService{}
+		mockMWH := &mocks.MockMiddlewareHandler{}
+
+		var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+		mockMWH.
+			On("AuthenticateUser").
+			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
+
+		mockSL.
+			On("FetchByID", context.TODO(), int64(1), (*domain.User)(nil)).
+			Return(&domain.Setlist{ID: 1}, nil)
+
+		mockSLES.
+			On("FetchAnalysis", context.TODO(), int64(1)).
+			Return(nil, mockErr)
+
+		writer := prepareAndServeGet(t, "/1/analysis", mockSL, mockSLES, mockSS, mockMWH)
+
+		expBody, err := json.Marshal(gin.H{
+			"error": mockErr.Error(),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.Status(mockErr), writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockSLES.AssertExpectations(t)
+	})
+}
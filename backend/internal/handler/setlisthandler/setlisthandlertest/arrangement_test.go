@@ -0,0 +1,134 @@
+package setlisthandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetArrangement(t *testing.T) {
+	expArrangement := &domain.ExpandedArrangement{
+		EntryID: 2,
+		SongID:  1,
+		Key:     "A",
+		Sections: []domain.ArrangedSection{
+			{Tag: "Verse", Text: "[A]Foo"},
+			{Tag: "Verse", Text: "[A]Foo"},
+		},
+		Text: "[A]Foo\n\n[A]Foo",
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSL := &mocks.MockSetlistService{}
+		mockSLES := &mocks.MockSetlistEntryService{}
+		mockSS := &mocks.MockSongService{}
+		mockMWH := &mocks.MockMiddlewareHandler{}
+
+		var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+		mockMWH.
+			On("AuthenticateUser").
+			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
+
+		mockSL.
+			On("FetchByID", context.TODO(), int64(1), (*domain.User)(nil)).
+			Return(&domain.Setlist{ID: 1}, nil)
+
+		mockSLES.
+			On("FetchArrangement", context.TODO(), int64(1), int64(2), &domain.SheetOptions{Notation: domain.LetterNotation}).
+			Return(expArrangement, nil)
+
+		writer := prepareAndServeGet(t, "/1/entries/2/arrangement", mockSL, mockSLES, mockSS, mockMWH)
+
+		expBody, err := json.Marshal(gin.H{
+			"arrangement": expArrangement,
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockSL.AssertExpectations(t)
+		mockSLES.AssertExpectations(t)
+		mockSS.AssertExpectations(t)
+		mockMWH.AssertExpectations(t)
+	})
+
+	t.Run("Fail Invalid Param", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewBadRequestErr("Could not read a")
+		mockSL := &mocks.MockSetlistService{}
+		mockSLES := &mocks.MockSetlistEntryService{}
+		mockSS := &mocks.MockSongService{}
+		mockMWH := &mocks.MockMiddlewareHandler{}
+
+		var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+		mockMWH.
+			On("AuthenticateUser").
+			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
+
+		writer := prepareAndServeGet(t, "/1/entries/a/arrangement", mockSL, mockSLES, mockSS, mockMWH)
+
+		expBody, err := json.Marshal(gin.H{
+			"error": mockErr.Error(),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.Status(mockErr), writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockSLES.AssertExpectations(t)
+	})
+
+	t.Run("Fail Fetch Arrangement", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewRecordNotFoundErr("setlist_id", "1")
+		mockSL := &mocks.MockSetlistService{}
+		mockSLES := &mocks.MockSetlistEntryService{}
+		mockSS := &mocks.MockSongService{}
+		mockMWH := &mocks.MockMiddlewareHandler{}
+
+		var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+		mockMWH.
+			On("AuthenticateUser").
+			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
+
+		mockSL.
+			On("FetchByID", context.TODO(), int64(1), (*domain.User)(nil)).
+			Return(&domain.Setlist{ID: 1}, nil)
+
+		mockSLES.
+			On("FetchArrangement", context.TODO(), int64(1), int64(2), &domain.SheetOptions{Notation: domain.LetterNotation}).
+			Return(nil, mockErr)
+
+		writer := prepareAndServeGet(t, "/1/entries/2/arrangement", mockSL, mockSLES, mockSS, mockMWH)
+
+		expBody, err := json.Marshal(gin.H{
+			"error": mockErr.Error(),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.Status(mockErr), writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockSLES.AssertExpectations(t)
+	})
+}
@@ -32,7 +32,12 @@ func prepareAndServeCreate(
 	router := gin.New()
 	writer := httptest.NewRecorder()
 
-	setlisthandler.Initialize(&router.RouterGroup, mockSL, mockSLES, mockSS, mockMWH)
+	mockTX := &mocks.MockTransactor{}
+	mockTX.
+		On("WithinTransaction", mock.Anything).
+		Return(nil)
+
+	setlisthandler.Initialize(&router.RouterGroup, mockSL, mockSLES, mockSS, mockTX, mockMWH)
 
 	requestBody := bytes.NewReader(*body)
 
@@ -125,6 +130,9 @@ func TestCreateCorrect(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	mockSL.
 		On("Store", context.TODO(), mockSetlist, mockUser).
@@ -148,6 +156,16 @@ func TestCreateCorrect(t *testing.T) {
 			}
 		})
 
+	mockSLES.
+		On("FetchTimings", context.TODO(), expSetlistEntries).
+		Return(map[int64]domain.SetlistTiming{
+			expSetlist.ID: {
+				SetlistID: expSetlist.ID,
+				RunTime:   350,
+				Entries:   []domain.EntryTiming{{EntryID: 1, Start: 0, Duration: 200}, {EntryID: 2, Start: 200, Duration: 150}},
+			},
+		}, nil)
+
 	byteBody, err := json.Marshal(gin.H{
 		"name":       mockSetlist.Name,
 		"creator_id": mockSetlist.CreatorID,
@@ -175,13 +193,15 @@ func TestCreateCorrect(t *testing.T) {
 
 	assert.Equal(t, http.StatusCreated, writer.Code)
 
-	type setlistResponse struct {
-		*domain.Setlist
-		Entries *[]domain.SetlistEntry `json:"entries"`
-	}
-
 	expBody, err := json.Marshal(gin.H{
-		"setlist": setlistResponse{expSetlist, expSetlistEntries},
+		"setlist": setlistResponse{
+			*expSetlist,
+			[]setlistEntryResponse{
+				{(*expSetlistEntries)[0], 0, 200},
+				{(*expSetlistEntries)[1], 200, 150},
+			},
+			350,
+		},
 	})
 	assert.NoError(t, err)
 
@@ -236,6 +256,9 @@ func TestCreateBindErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	byteBody, err := json.Marshal(gin.H{
 		"creator_id":      mockSetlist.CreatorID,
@@ -295,6 +318,9 @@ func TestCreateNoContext(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	byteBody, err := json.Marshal(gin.H{
 		"name":            mockSetlist.Name,
@@ -361,6 +387,9 @@ func TestCreateSetlistStoreErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	mockSL.
 		On("Store", context.TODO(), mockSetlist, mockUser).
@@ -430,6 +459,9 @@ func TestCreateSetlistEntryStoreBatchErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	mockSL.
 		On("Store", context.TODO(), mockSetlist, mockUser).
@@ -6,12 +6,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/setlisthandler"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func prepareAndServeDelete(
@@ -28,7 +30,12 @@ func prepareAndServeDelete(
 	router := gin.New()
 	writer := httptest.NewRecorder()
 
-	setlisthandler.Initialize(&router.RouterGroup, mockSL, mockSLES, mockSS, mockMWH)
+	mockTX := &mocks.MockTransactor{}
+	mockTX.
+		On("WithinTransaction", mock.Anything).
+		Return(nil)
+
+	setlisthandler.Initialize(&router.RouterGroup, mockSL, mockSLES, mockSS, mockTX, mockMWH)
 
 	req, err := http.NewRequestWithContext(
 		context.TODO(),
@@ -37,6 +44,7 @@ func prepareAndServeDelete(
 		nil,
 	)
 	assert.NoError(t, err)
+	req.Header.Set("If-Match", mockSetlistETag)
 
 	router.ServeHTTP(writer, req)
 
@@ -68,9 +76,15 @@ func TestDeleteByIDCorrect(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	mockSL.
-		On("Remove", context.TODO(), int64(mockSetlistID), mockUser).
+		On("FetchByID", context.TODO(), int64(1), mockUser).
+		Return(&domain.Setlist{ID: 1}, nil)
+	mockSL.
+		On("Remove", context.TODO(), int64(mockSetlistID), time.Time{}, mockUser).
 		Return(nil)
 
 	mockSLES.
@@ -101,6 +115,9 @@ func TestDeleteByIDNoContext(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	writer := prepareAndServeDelete(t, fmt.Sprint(mockSetlistID), mockSL, mockSLES, mockSS, mockMWH)
 
@@ -130,6 +147,9 @@ func TestDeleteByIDInvalidParam(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	writer := prepareAndServeDelete(t, "a", mockSL, mockSLES, mockSS, mockMWH)
 	assert.Equal(t, http.StatusBadRequest, writer.Code)
@@ -164,11 +184,21 @@ func TestDeleteByIDRemoveErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	mockSLS.
-		On("Remove", context.TODO(), mockSetlistID, mockUser).
+		On("FetchByID", context.TODO(), int64(1), mockUser).
+		Return(&domain.Setlist{ID: 1}, nil)
+	mockSLS.
+		On("Remove", context.TODO(), mockSetlistID, time.Time{}, mockUser).
 		Return(mockErr)
 
+	mockSLES.
+		On("RemoveBySetlist", context.TODO(), &domain.Setlist{ID: mockSetlistID}, mockUser).
+		Return(nil)
+
 	writer := prepareAndServeDelete(t, fmt.Sprint(mockSetlistID), mockSLS, mockSLES, mockSS, mockMWH)
 
 	assert.Equal(t, mockErr.Status(), writer.Code)
@@ -203,10 +233,13 @@ func TestDeleteByIDRemoveBySetlistErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	mockSLS.
-		On("Remove", context.TODO(), mockSetlistID, mockUser).
-		Return(nil)
+		On("FetchByID", context.TODO(), int64(1), mockUser).
+		Return(&domain.Setlist{ID: 1}, nil)
 
 	mockSLES.
 		On("RemoveBySetlist", context.TODO(), &domain.Setlist{ID: mockSetlistID}, mockUser).
@@ -30,7 +30,7 @@ func prepareAndServeGet(
 	router := gin.New()
 	writer := httptest.NewRecorder()
 
-	setlisthandler.Initialize(&router.RouterGroup, mockSL, mockSLES, mockSS, mockMWH)
+	setlisthandler.Initialize(&router.RouterGroup, mockSL, mockSLES, mockSS, &mocks.MockTransactor{}, mockMWH)
 
 	req, err := http.NewRequestWithContext(
 		context.TODO(),
@@ -45,9 +45,16 @@ func prepareAndServeGet(
 	return writer
 }
 
+type setlistEntryResponse struct {
+	domain.SetlistEntry
+	Start             uint `json:"start"`
+	EstimatedDuration uint `json:"estimated_duration"`
+}
+
 type setlistResponse struct {
 	domain.Setlist
-	Entries []domain.SetlistEntry `json:"entries"`
+	Entries []setlistEntryResponse `json:"entries"`
+	RunTime uint                   `json:"run_time"`
 }
 
 func TestGetAll(t *testing.T) {
@@ -71,6 +78,11 @@ func TestGetAll(t *testing.T) {
 		},
 	}
 
+	expTimings := map[int64]domain.SetlistTiming{
+		1: {SetlistID: 1, RunTime: 420, Entries: []domain.EntryTiming{{EntryID: 1, Start: 0, Duration: 180}, {EntryID: 2, Start: 180, Duration: 240}}},
+		2: {SetlistID: 2, RunTime: 200, Entries: []domain.EntryTiming{{EntryID: 3, Start: 0, Duration: 200}}},
+	}
+
 	expSetlistEntries := &[]domain.SetlistEntry{
 		{
 			ID:        1,
@@ -102,30 +114,39 @@ func TestGetAll(t *testing.T) {
 		mockMWH.
 			On("AuthenticateUser").
 			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
 
 		mockSL.
-			On("Fetch", context.TODO(), time.Time{}, time.Time{}).
+			On("Fetch", context.TODO(), time.Time{}, time.Time{}, (*domain.User)(nil)).
 			Return(expSetlist, nil)
 
 		mockSLES.
 			On("FetchBySetlist", context.TODO(), expSetlist).
 			Return(expSetlistEntries, nil)
 
+		mockSLES.
+			On("FetchTimings", context.TODO(), expSetlistEntries).
+			Return(expTimings, nil)
+
 		writer := prepareAndServeGet(t, "", mockSL, mockSLES, mockSS, mockMWH)
 
 		response := []setlistResponse{
 			{
 				(*expSetlist)[0],
-				[]domain.SetlistEntry{
-					(*expSetlistEntries)[0],
-					(*expSetlistEntries)[1],
+				[]setlistEntryResponse{
+					{(*expSetlistEntries)[0], 0, 180},
+					{(*expSetlistEntries)[1], 180, 240},
 				},
+				420,
 			},
 			{
 				(*expSetlist)[1],
-				[]domain.SetlistEntry{
-					(*expSetlistEntries)[2],
+				[]setlistEntryResponse{
+					{(*expSetlistEntries)[2], 0, 200},
 				},
+				200,
 			},
 		}
 
@@ -155,18 +176,25 @@ func TestGetAll(t *testing.T) {
 		mockMWH.
 			On("AuthenticateUser").
 			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
 
 		fromTime := (*expSetlist)[0].Deadline.Add(-24 * time.Hour)
 		toTime := (*expSetlist)[0].Deadline.Add(-24 * time.Hour)
 
 		mockSL.
-			On("Fetch", context.TODO(), fromTime, toTime).
+			On("Fetch", context.TODO(), fromTime, toTime, (*domain.User)(nil)).
 			Return(expSetlist, nil)
 
 		mockSLES.
 			On("FetchBySetlist", context.TODO(), expSetlist).
 			Return(expSetlistEntries, nil)
 
+		mockSLES.
+			On("FetchTimings", context.TODO(), expSetlistEntries).
+			Return(expTimings, nil)
+
 		fmt.Println(fromTime.Format(time.RFC3339))
 		writer := prepareAndServeGet(t,
 			fmt.Sprintf("?from=%s&to=%s", fromTime.Format(time.RFC3339), toTime.Format(time.RFC3339)),
@@ -175,16 +203,18 @@ func TestGetAll(t *testing.T) {
 		response := []setlistResponse{
 			{
 				(*expSetlist)[0],
-				[]domain.SetlistEntry{
-					(*expSetlistEntries)[0],
-					(*expSetlistEntries)[1],
+				[]setlistEntryResponse{
+					{(*expSetlistEntries)[0], 0, 180},
+					{(*expSetlistEntries)[1], 180, 240},
 				},
+				420,
 			},
 			{
 				(*expSetlist)[1],
-				[]domain.SetlistEntry{
-					(*expSetlistEntries)[2],
+				[]setlistEntryResponse{
+					{(*expSetlistEntries)[2], 0, 200},
 				},
+				200,
 			},
 		}
 
@@ -214,6 +244,9 @@ func TestGetAll(t *testing.T) {
 		mockMWH.
 			On("AuthenticateUser").
 			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
 
 		fromTimeString := (*expSetlist)[0].Deadline.Add(-24 * time.Hour).Format(time.RFC1123)
 		toTimeString := (*expSetlist)[0].Deadline.Format(time.RFC3339)
@@ -245,6 +278,9 @@ func TestGetAll(t *testing.T) {
 		mockMWH.
 			On("AuthenticateUser").
 			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
 
 		fromTimeString := (*expSetlist)[0].Deadline.Add(-24 * time.Hour).Format(time.RFC3339)
 		toTimeString := (*expSetlist)[0].Deadline.Format(time.RFC1123)
@@ -277,9 +313,12 @@ func TestGetAll(t *testing.T) {
 		mockMWH.
 			On("AuthenticateUser").
 			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
 
 		mockSL.
-			On("Fetch", context.TODO(), time.Time{}, time.Time{}).
+			On("Fetch", context.TODO(), time.Time{}, time.Time{}, (*domain.User)(nil)).
 			Return(nil, mockErr)
 
 		writer := prepareAndServeGet(t, "", mockSL, mockSLES, mockSS, mockMWH)
@@ -311,9 +350,12 @@ func TestGetAll(t *testing.T) {
 		mockMWH.
 			On("AuthenticateUser").
 			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
 
 		mockSL.
-			On("Fetch", context.TODO(), time.Time{}, time.Time{}).
+			On("Fetch", context.TODO(), time.Time{}, time.Time{}, (*domain.User)(nil)).
 			Return(expSetlist, nil)
 
 		mockSLES.
@@ -349,6 +391,10 @@ func TestGetByID(t *testing.T) {
 		CreatorID: mockUser.ID,
 	}
 
+	expTimings := map[int64]domain.SetlistTiming{
+		1: {SetlistID: 1, RunTime: 300, Entries: []domain.EntryTiming{{EntryID: 2, Start: 0, Duration: 120}, {EntryID: 1, Start: 120, Duration: 180}}},
+	}
+
 	expSetlistEntries := &[]domain.SetlistEntry{
 		{
 			ID:        1,
@@ -373,23 +419,31 @@ func TestGetByID(t *testing.T) {
 		mockMWH.
 			On("AuthenticateUser").
 			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
 
 		mockSL.
-			On("FetchByID", context.TODO(), expSetlist.ID).
+			On("FetchByID", context.TODO(), expSetlist.ID, (*domain.User)(nil)).
 			Return(expSetlist, nil)
 
 		mockSLES.
 			On("FetchBySetlist", context.TODO(), &[]domain.Setlist{*expSetlist}).
 			Return(expSetlistEntries, nil)
 
+		mockSLES.
+			On("FetchTimings", context.TODO(), expSetlistEntries).
+			Return(expTimings, nil)
+
 		writer := prepareAndServeGet(t, fmt.Sprintf("/%d", expSetlist.ID), mockSL, mockSLES, mockSS, mockMWH)
 
 		response := setlistResponse{
 			*expSetlist,
-			[]domain.SetlistEntry{
-				(*expSetlistEntries)[0],
-				(*expSetlistEntries)[1],
+			[]setlistEntryResponse{
+				{(*expSetlistEntries)[0], 120, 180},
+				{(*expSetlistEntries)[1], 0, 120},
 			},
+			300,
 		}
 
 		expBody, err := json.Marshal(gin.H{
@@ -419,6 +473,9 @@ func TestGetByID(t *testing.T) {
 		mockMWH.
 			On("AuthenticateUser").
 			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
 
 		writer := prepareAndServeGet(t, fmt.Sprintf("/%s", "a"), mockSL, mockSLES, mockSS, mockMWH)
 
@@ -449,9 +506,12 @@ func TestGetByID(t *testing.T) {
 		mockMWH.
 			On("AuthenticateUser").
 			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
 
 		mockSL.
-			On("FetchByID", context.TODO(), expSetlist.ID).
+			On("FetchByID", context.TODO(), expSetlist.ID, (*domain.User)(nil)).
 			Return(nil, mockErr)
 
 		writer := prepareAndServeGet(t, fmt.Sprintf("/%d", expSetlist.ID), mockSL, mockSLES, mockSS, mockMWH)
@@ -483,9 +543,12 @@ func TestGetByID(t *testing.T) {
 		mockMWH.
 			On("AuthenticateUser").
 			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
 
 		mockSL.
-			On("FetchByID", context.TODO(), expSetlist.ID).
+			On("FetchByID", context.TODO(), expSetlist.ID, (*domain.User)(nil)).
 			Return(expSetlist, nil)
 
 		mockSLES.
@@ -0,0 +1,148 @@
+package setlisthandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/setlisthandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func prepareAndServeOrder(
+	t *testing.T,
+	param string,
+	mockSLES domain.SetlistEntryService,
+	mockUser *domain.User,
+	body []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		if mockUser != nil {
+			ctx.Set("user", mockUser)
+		}
+
+		ctx.Next()
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
+
+	setlisthandler.Initialize(
+		&router.RouterGroup, &mocks.MockSetlistService{}, mockSLES, &mocks.MockSongService{}, &mocks.MockTransactor{}, mockMWH,
+	)
+
+	req, err := http.NewRequestWithContext(
+		context.TODO(),
+		http.MethodPatch,
+		fmt.Sprintf("/setlists/%s/entries/order", param),
+		bytes.NewReader(body),
+	)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestReorder(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockEntries := &[]domain.SetlistEntry{
+			{ID: 2, SetlistID: 1, Rank: 512},
+			{ID: 1, SetlistID: 1, Rank: 1024},
+		}
+		mockSLES := &mocks.MockSetlistEntryService{}
+
+		mockSLES.
+			On("Reorder", context.TODO(), int64(1), []int64{2, 1}, mockUser).
+			Return(mockEntries, nil)
+
+		writer := prepareAndServeOrder(t, "1", mockSLES, mockUser, []byte(`{"order": [2, 1]}`))
+
+		expectedBytes, err := json.Marshal(gin.H{"entries": mockEntries})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSLES.AssertExpectations(t)
+	})
+
+	t.Run("Fail no user", func(t *testing.T) {
+		t.Parallel()
+
+		mockSLES := &mocks.MockSetlistEntryService{}
+
+		writer := prepareAndServeOrder(t, "1", mockSLES, nil, []byte(`{"order": [2, 1]}`))
+
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+		mockSLES.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		mockSLES := &mocks.MockSetlistEntryService{}
+
+		writer := prepareAndServeOrder(t, "foo", mockSLES, mockUser, []byte(`{"order": [2, 1]}`))
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSLES.AssertExpectations(t)
+	})
+
+	t.Run("Fail missing order", func(t *testing.T) {
+		t.Parallel()
+
+		mockSLES := &mocks.MockSetlistEntryService{}
+
+		writer := prepareAndServeOrder(t, "1", mockSLES, mockUser, []byte(`{}`))
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSLES.AssertExpectations(t)
+	})
+
+	t.Run("Fail Reorder", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("entry 3 is not part of the setlist or appears more than once")
+		mockSLES := &mocks.MockSetlistEntryService{}
+
+		mockSLES.
+			On("Reorder", context.TODO(), int64(1), []int64{3, 1}, mockUser).
+			Return(nil, expErr)
+
+		writer := prepareAndServeOrder(t, "1", mockSLES, mockUser, []byte(`{"order": [3, 1]}`))
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSLES.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,190 @@
+package setlisthandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/setlisthandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func prepareAndServeConditional(
+	t *testing.T,
+	mockSL domain.SetlistService,
+	mockUser *domain.User,
+	method string,
+	path string,
+	headers map[string]string,
+	body []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
+
+	mockTX := &mocks.MockTransactor{}
+	mockTX.
+		On("WithinTransaction", mock.Anything).
+		Return(nil)
+
+	setlisthandler.Initialize(
+		&router.RouterGroup, mockSL, &mocks.MockSetlistEntryService{}, &mocks.MockSongService{}, mockTX, mockMWH,
+	)
+
+	req, err := http.NewRequestWithContext(context.TODO(), method, path, bytes.NewReader(body))
+	assert.NoError(t, err)
+
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestSetlistPreconditions(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+	mockSetlist := &domain.Setlist{
+		ID:        1,
+		Name:      "Foo",
+		CreatorID: mockUser.ID,
+		Deadline:  time.Now().Add(time.Hour).Truncate(time.Minute),
+		UpdatedAt: time.Now().Truncate(time.Millisecond),
+	}
+	currentETag := util.SetlistETag(mockSetlist, time.Now())
+
+	t.Run("Correct not modified", func(t *testing.T) {
+		t.Parallel()
+
+		mockSL := &mocks.MockSetlistService{}
+		mockSL.
+			On("FetchByID", context.TODO(), mockSetlist.ID, mockUser).
+			Return(mockSetlist, nil)
+
+		writer := prepareAndServeConditional(
+			t, mockSL, mockUser, http.MethodGet, "/setlists/1", map[string]string{"If-None-Match": currentETag}, nil,
+		)
+
+		assert.Equal(t, http.StatusNotModified, writer.Code)
+		assert.Equal(t, currentETag, writer.Header().Get("ETag"))
+		mockSL.AssertExpectations(t)
+	})
+
+	t.Run("Fail delete without If-Match", func(t *testing.T) {
+		t.Parallel()
+
+		mockSL := &mocks.MockSetlistService{}
+		mockSL.
+			On("FetchByID", context.TODO(), mockSetlist.ID, mockUser).
+			Return(mockSetlist, nil)
+
+		writer := prepareAndServeConditional(t, mockSL, mockUser, http.MethodDelete, "/setlists/1/delete", nil, nil)
+
+		assert.Equal(t, http.StatusPreconditionRequired, writer.Code)
+		mockSL.AssertExpectations(t)
+	})
+
+	t.Run("Fail delete stale version", func(t *testing.T) {
+		t.Parallel()
+
+		mockSL := &mocks.MockSetlistService{}
+		mockSL.
+			On("FetchByID", context.TODO(), mockSetlist.ID, mockUser).
+			Return(mockSetlist, nil)
+
+		staleETag := util.ETag("setlist", mockSetlist.ID, mockSetlist.UpdatedAt.Add(-time.Minute))
+		writer := prepareAndServeConditional(
+			t, mockSL, mockUser, http.MethodDelete, "/setlists/1/delete", map[string]string{"If-Match": staleETag}, nil,
+		)
+
+		expErr := domain.NewPreconditionFailedErr("resource was changed by someone else, fetch it again before changing it")
+		expectedBody, err := json.Marshal(gin.H{"error": expErr.Error(), "setlist": mockSetlist})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusPreconditionFailed, writer.Code)
+		assert.Equal(t, currentETag, writer.Header().Get("ETag"))
+		assert.JSONEq(t, string(expectedBody), writer.Body.String())
+		mockSL.AssertExpectations(t)
+	})
+
+	t.Run("Fail update changed concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewPreconditionFailedErr("resource was changed by someone else, fetch it again before changing it")
+		mockSL := &mocks.MockSetlistService{}
+		mockSL.
+			On("FetchByID", context.TODO(), mockSetlist.ID, mockUser).
+			Return(mockSetlist, nil)
+		mockSL.
+			On("Update", context.TODO(), mock.MatchedBy(func(setlist *domain.Setlist) bool {
+				return setlist.ID == mockSetlist.ID && setlist.UpdatedAt.Equal(mockSetlist.UpdatedAt)
+			}), mockUser).
+			Return(nil, expErr)
+
+		byteBody, err := json.Marshal(gin.H{
+			"name":            "Bar",
+			"creator_id":      mockUser.ID,
+			"deadline":        mockSetlist.Deadline,
+			"created_entries": []gin.H{},
+			"updated_entries": []gin.H{},
+			"deleted_entries": []int64{},
+		})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeConditional(
+			t, mockSL, mockUser, http.MethodPut, "/setlists/1", map[string]string{"If-Match": currentETag}, byteBody,
+		)
+
+		assert.Equal(t, http.StatusPreconditionFailed, writer.Code)
+		mockSL.AssertExpectations(t)
+	})
+
+	t.Run("Fail delete locked since", func(t *testing.T) {
+		t.Parallel()
+
+		lockedSetlist := *mockSetlist
+		lockedSetlist.Status = domain.SetlistPublished
+		lockedSetlist.Deadline = time.Now().Add(-time.Minute)
+		publishedETag := util.SetlistETag(&lockedSetlist, lockedSetlist.Deadline.Add(-time.Hour))
+
+		mockSL := &mocks.MockSetlistService{}
+		mockSL.
+			On("FetchByID", context.TODO(), mockSetlist.ID, mockUser).
+			Return(&lockedSetlist, nil)
+
+		writer := prepareAndServeConditional(
+			t, mockSL, mockUser, http.MethodDelete, "/setlists/1/delete", map[string]string{"If-Match": publishedETag}, nil,
+		)
+
+		assert.Equal(t, http.StatusPreconditionFailed, writer.Code)
+		assert.Equal(t, util.SetlistETag(&lockedSetlist, time.Now()), writer.Header().Get("ETag"))
+		mockSL.AssertExpectations(t)
+	})
+}
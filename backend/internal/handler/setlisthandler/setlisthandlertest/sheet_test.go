@@ -0,0 +1,134 @@
+package setlisthandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestGetSheet(t *testing.T) {
+	expSheet := &domain.Sheet{
+		SongID:      1,
+		Title:       "Foobar",
+		OriginalKey: "G",
+		Key:         "A",
+		Transpose:   2,
+		Bpm:         120,
+		ChordSheet:  datatypes.JSON([]byte(`{"Verse":"[A]Foo [D/F#]bar"}`)),
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSL := &mocks.MockSetlistService{}
+		mockSLES := &mocks.MockSetlistEntryService{}
+		mockSS := &mocks.MockSongService{}
+		mockMWH := &mocks.MockMiddlewareHandler{}
+
+		var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+		mockMWH.
+			On("AuthenticateUser").
+			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
+
+		mockSL.
+			On("FetchByID", context.TODO(), int64(1), (*domain.User)(nil)).
+			Return(&domain.Setlist{ID: 1}, nil)
+
+		mockSLES.
+			On("FetchSheet", context.TODO(), int64(1), int64(2), &domain.SheetOptions{Notation: domain.LetterNotation}).
+			Return(expSheet, nil)
+
+		writer := prepareAndServeGet(t, "/1/entries/2/sheet", mockSL, mockSLES, mockSS, mockMWH)
+
+		expBody, err := json.Marshal(gin.H{
+			"sheet": expSheet,
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockSL.AssertExpectations(t)
+		mockSLES.AssertExpectations(t)
+		mockSS.AssertExpectations(t)
+		mockMWH.AssertExpectations(t)
+	})
+
+	t.Run("Fail Invalid Param", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewBadRequestErr("Could not read a")
+		mockSL := &mocks.MockSetlistService{}
+		mockSLES := &mocks.MockSetlistEntryService{}
+		mockSS := &mocks.MockSongService{}
+		mockMWH := &mocks.MockMiddlewareHandler{}
+
+		var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+		mockMWH.
+			On("AuthenticateUser").
+			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
+
+		writer := prepareAndServeGet(t, "/1/entries/a/sheet", mockSL, mockSLES, mockSS, mockMWH)
+
+		expBody, err := json.Marshal(gin.H{
+			"error": mockErr.Error(),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.Status(mockErr), writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockSLES.AssertExpectations(t)
+	})
+
+	t.Run("Fail Fetch Sheet", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewRecordNotFoundErr("setlist_id", "1")
+		mockSL := &mocks.MockSetlistService{}
+		mockSLES := &mocks.MockSetlistEntryService{}
+		mockSS := &mocks.MockSongService{}
+		mockMWH := &mocks.MockMiddlewareHandler{}
+
+		var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+		mockMWH.
+			On("AuthenticateUser").
+			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
+
+		mockSL.
+			On("FetchByID", context.TODO(), int64(1), (*domain.User)(nil)).
+			Return(&domain.Setlist{ID: 1}, nil)
+
+		mockSLES.
+			On("FetchSheet", context.TODO(), int64(1), int64(2), &domain.SheetOptions{Notation: domain.LetterNotation}).
+			Return(nil, mockErr)
+
+		writer := prepareAndServeGet(t, "/1/entries/2/sheet", mockSL, mockSLES, mockSS, mockMWH)
+
+		expBody, err := json.Marshal(gin.H{
+			"error": mockErr.Error(),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.Status(mockErr), writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockSLES.AssertExpectations(t)
+	})
+}
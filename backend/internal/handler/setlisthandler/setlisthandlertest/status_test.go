@@ -0,0 +1,153 @@
+package setlisthandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/setlisthandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func prepareAndServeStatus(
+	t *testing.T,
+	path string,
+	mockSL domain.SetlistService,
+	mockMWH domain.MiddlewareHandler,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	setlisthandler.Initialize(
+		&router.RouterGroup, mockSL, &mocks.MockSetlistEntryService{}, &mocks.MockSongService{}, &mocks.MockTransactor{}, mockMWH,
+	)
+
+	req, err := http.NewRequestWithContext(
+		context.TODO(),
+		http.MethodPost,
+		fmt.Sprintf("/setlists%s", path),
+		nil,
+	)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestChangeStatus(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.MEMBER,
+	}
+
+	newMWH := func(user *domain.User) *mocks.MockMiddlewareHandler {
+		mockMWH := &mocks.MockMiddlewareHandler{}
+
+		var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+			if user != nil {
+				ctx.Set("user", user)
+			}
+
+			ctx.Next()
+		}
+
+		mockMWH.
+			On("AuthenticateUser").
+			Return(mockAuthHF)
+		mockMWH.
+			On("IdentifyUser").
+			Return(mockAuthHF)
+
+		return mockMWH
+	}
+
+	for _, method := range []string{"Publish", "Unpublish", "Archive"} {
+		method := method
+
+		t.Run(fmt.Sprintf("Correct %s", method), func(t *testing.T) {
+			t.Parallel()
+
+			expSetlist := &domain.Setlist{
+				ID:        1,
+				CreatorID: mockUser.ID,
+				Deadline:  time.Now().Add(time.Hour).Truncate(time.Second),
+				Status:    domain.SetlistPublished,
+			}
+
+			mockSL := &mocks.MockSetlistService{}
+
+			mockSL.
+				On(method, context.TODO(), int64(1), mockUser).
+				Return(expSetlist, nil)
+
+			writer := prepareAndServeStatus(t, fmt.Sprintf("/1/%s", strings.ToLower(method)), mockSL, newMWH(mockUser))
+
+			expBody, err := json.Marshal(gin.H{
+				"setlist": expSetlist,
+			})
+			assert.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, expBody, writer.Body.Bytes())
+			mockSL.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Fail no user", func(t *testing.T) {
+		t.Parallel()
+
+		mockSL := &mocks.MockSetlistService{}
+
+		writer := prepareAndServeStatus(t, "/1/publish", mockSL, newMWH(nil))
+
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+		mockSL.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid param", func(t *testing.T) {
+		t.Parallel()
+
+		mockSL := &mocks.MockSetlistService{}
+
+		writer := prepareAndServeStatus(t, "/foo/publish", mockSL, newMWH(mockUser))
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSL.AssertExpectations(t)
+	})
+
+	t.Run("Fail transition", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewBadRequestErr("a published setlist cannot become published")
+		mockSL := &mocks.MockSetlistService{}
+
+		mockSL.
+			On("Publish", context.TODO(), int64(1), mockUser).
+			Return(nil, mockErr)
+
+		writer := prepareAndServeStatus(t, "/1/publish", mockSL, newMWH(mockUser))
+
+		expBody, err := json.Marshal(gin.H{
+			"error": mockErr.Error(),
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.Status(mockErr), writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockSL.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,144 @@
+package setlisthandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/setlisthandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func prepareAndServeTransaction(
+	t *testing.T,
+	method string,
+	path string,
+	mockSL domain.SetlistService,
+	mockSLES domain.SetlistEntryService,
+	mockTX domain.Transactor,
+	body []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
+
+	setlisthandler.Initialize(&router.RouterGroup, mockSL, mockSLES, &mocks.MockSongService{}, mockTX, mockMWH)
+
+	req, err := http.NewRequestWithContext(context.TODO(), method, path, bytes.NewReader(body))
+	assert.NoError(t, err)
+	req.Header.Set("If-Match", mockSetlistETag)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestSetlistUnitOfWork(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Now().AddDate(0, 0, 1).Truncate(time.Minute)
+
+	t.Run("Fail create transaction", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewInternalErr()
+		mockSL := &mocks.MockSetlistService{}
+		mockSLES := &mocks.MockSetlistEntryService{}
+		mockTX := &mocks.MockTransactor{}
+
+		mockTX.
+			On("WithinTransaction", mock.Anything).
+			Return(mockErr)
+
+		byteBody, err := json.Marshal(gin.H{
+			"name":            "Foo",
+			"creator_id":      1,
+			"deadline":        deadline,
+			"created_entries": []gin.H{{"song_id": 1, "rank": 1000}},
+		})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeTransaction(t, http.MethodPost, "/setlists", mockSL, mockSLES, mockTX, byteBody)
+
+		expectedBody, err := json.Marshal(gin.H{"error": mockErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, mockErr.Status(), writer.Code)
+		assert.Equal(t, expectedBody, writer.Body.Bytes())
+		mockSL.AssertExpectations(t)
+		mockSLES.AssertExpectations(t)
+		mockTX.AssertExpectations(t)
+	})
+
+	t.Run("Fail UpdateBatch", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewBadRequestErr("entry 3 does not exist")
+		mockSL := &mocks.MockSetlistService{}
+		mockSLES := &mocks.MockSetlistEntryService{}
+		mockTX := &mocks.MockTransactor{}
+
+		mockTX.
+			On("WithinTransaction", mock.Anything).
+			Return(nil)
+		mockSL.
+			On("FetchByID", mock.Anything, int64(1), mock.AnythingOfType("*domain.User")).
+			Return(&domain.Setlist{ID: 1}, nil)
+		mockSL.
+			On("Update", mock.Anything, mock.AnythingOfType("*domain.Setlist"), mock.AnythingOfType("*domain.User")).
+			Return(&domain.Setlist{ID: 1, Name: "Foo", CreatorID: 1, Deadline: deadline}, nil)
+		mockSLES.
+			On("StoreBatch", mock.Anything, mock.AnythingOfType("*[]domain.SetlistEntry"), mock.AnythingOfType("*domain.User")).
+			Return(nil)
+		mockSLES.
+			On("UpdateBatch", mock.Anything, mock.AnythingOfType("*[]domain.SetlistEntry"), mock.AnythingOfType("*domain.User")).
+			Return(mockErr)
+
+		byteBody, err := json.Marshal(gin.H{
+			"name":            "Foo",
+			"creator_id":      1,
+			"deadline":        deadline,
+			"created_entries": []gin.H{},
+			"updated_entries": []gin.H{{"id": 3, "song_id": 1, "rank": 1000}},
+			"deleted_entries": []int64{},
+		})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeTransaction(t, http.MethodPut, "/setlists/1", mockSL, mockSLES, mockTX, byteBody)
+
+		expectedBody, err := json.Marshal(gin.H{"error": mockErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, mockErr.Status(), writer.Code)
+		assert.Equal(t, expectedBody, writer.Body.Bytes())
+		mockSL.AssertExpectations(t)
+		mockSLES.AssertExpectations(t)
+		mockTX.AssertExpectations(t)
+		mockSLES.AssertNotCalled(t, "RemoveBatch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -13,12 +13,16 @@ import (
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/setlisthandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/datatypes"
 )
 
+// mockSetlistETag is the version of the setlist returned by the FetchByID expectations.
+var mockSetlistETag = util.SetlistETag(&domain.Setlist{ID: 1}, time.Now())
+
 func prepareAndServeUpdate(
 	t *testing.T,
 	param string,
@@ -34,7 +38,12 @@ func prepareAndServeUpdate(
 	router := gin.New()
 	writer := httptest.NewRecorder()
 
-	setlisthandler.Initialize(&router.RouterGroup, mockSL, mockSLES, mockSS, mockMWH)
+	mockTX := &mocks.MockTransactor{}
+	mockTX.
+		On("WithinTransaction", mock.Anything).
+		Return(nil)
+
+	setlisthandler.Initialize(&router.RouterGroup, mockSL, mockSLES, mockSS, mockTX, mockMWH)
 
 	requestBody := bytes.NewReader(*body)
 
@@ -45,6 +54,7 @@ func prepareAndServeUpdate(
 		requestBody,
 	)
 	assert.NoError(t, err)
+	req.Header.Set("If-Match", mockSetlistETag)
 
 	router.ServeHTTP(writer, req)
 
@@ -149,7 +159,13 @@ func TestUpdateByIDCorrect(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
+	mockSL.
+		On("FetchByID", context.TODO(), int64(1), mockUser).
+		Return(&domain.Setlist{ID: 1}, nil)
 	mockSL.
 		On("Update", context.TODO(), expMockSetlist, mockUser).
 		Return(expSetlist, nil)
@@ -178,6 +194,16 @@ func TestUpdateByIDCorrect(t *testing.T) {
 		On("FetchBySetlist", context.TODO(), &[]domain.Setlist{*expMockSetlist}).
 		Return(expFetchedEntries, nil)
 
+	mockSLES.
+		On("FetchTimings", context.TODO(), expFetchedEntries).
+		Return(map[int64]domain.SetlistTiming{}, nil)
+
+	expEntryResponses := make([]setlistEntryResponse, len(*expFetchedEntries))
+
+	for idx, entry := range *expFetchedEntries {
+		expEntryResponses[idx] = setlistEntryResponse{SetlistEntry: entry}
+	}
+
 	byteBody, err := json.Marshal(gin.H{
 		"name":       mockSetlist.Name,
 		"creator_id": mockSetlist.CreatorID,
@@ -211,13 +237,8 @@ func TestUpdateByIDCorrect(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, writer.Code)
 
-	type setlistResponse struct {
-		*domain.Setlist
-		Entries []domain.SetlistEntry `json:"entries"`
-	}
-
 	expBody, err := json.Marshal(gin.H{
-		"setlist": setlistResponse{expSetlist, *expFetchedEntries},
+		"setlist": setlistResponse{*expSetlist, expEntryResponses, 0},
 	})
 	assert.NoError(t, err)
 
@@ -325,7 +346,13 @@ func TestUpdateByIDStringedDeadlineCorrect(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
+	mockSL.
+		On("FetchByID", context.TODO(), int64(1), mockUser).
+		Return(&domain.Setlist{ID: 1}, nil)
 	mockSL.
 		On("Update", context.TODO(), expMockSetlist, mockUser).
 		Return(expSetlist, nil)
@@ -354,6 +381,16 @@ func TestUpdateByIDStringedDeadlineCorrect(t *testing.T) {
 		On("FetchBySetlist", context.TODO(), &[]domain.Setlist{*expMockSetlist}).
 		Return(expFetchedEntries, nil)
 
+	mockSLES.
+		On("FetchTimings", context.TODO(), expFetchedEntries).
+		Return(map[int64]domain.SetlistTiming{}, nil)
+
+	expEntryResponses := make([]setlistEntryResponse, len(*expFetchedEntries))
+
+	for idx, entry := range *expFetchedEntries {
+		expEntryResponses[idx] = setlistEntryResponse{SetlistEntry: entry}
+	}
+
 	byteBody, err := json.Marshal(gin.H{
 		"name":            mockSetlist.Name,
 		"creator_id":      mockSetlist.CreatorID,
@@ -369,13 +406,8 @@ func TestUpdateByIDStringedDeadlineCorrect(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, writer.Code)
 
-	type setlistResponse struct {
-		*domain.Setlist
-		Entries []domain.SetlistEntry `json:"entries"`
-	}
-
 	expBody, err := json.Marshal(gin.H{
-		"setlist": setlistResponse{expSetlist, *expFetchedEntries},
+		"setlist": setlistResponse{*expSetlist, expEntryResponses, 0},
 	})
 	assert.NoError(t, err)
 
@@ -421,6 +453,9 @@ func TestUpdateByIDInvalidParam(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	byteBody, err := json.Marshal(mockSetlist)
 	assert.NoError(t, err)
@@ -470,6 +505,9 @@ func TestUpdateByIDBindErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	byteBody, err := json.Marshal(mockSetlist)
 	assert.NoError(t, err)
@@ -518,6 +556,9 @@ func TestUpdateByIDNoContext(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
 	byteBody, err := json.Marshal(mockSetlist)
 	assert.NoError(t, err)
@@ -606,7 +647,13 @@ func TestUpdateByIDStoreErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
+	mockSL.
+		On("FetchByID", context.TODO(), int64(1), mockUser).
+		Return(&domain.Setlist{ID: 1}, nil)
 	mockSL.
 		On("Update", context.TODO(), expMockSetlist, mockUser).
 		Return(nil, mockErr)
@@ -705,7 +752,13 @@ func TestUpdateByIDSetlistEntryStoreBatchErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
+	mockSL.
+		On("FetchByID", context.TODO(), int64(1), mockUser).
+		Return(&domain.Setlist{ID: 1}, nil)
 	mockSL.
 		On("Update", context.TODO(), expMockSetlist, mockUser).
 		Return(expSetlist, nil)
@@ -809,7 +862,13 @@ func TestUpdateByIDSetlistEntryUpdateBatchErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
+	mockSL.
+		On("FetchByID", context.TODO(), int64(1), mockUser).
+		Return(&domain.Setlist{ID: 1}, nil)
 	mockSL.
 		On("Update", context.TODO(), expMockSetlist, mockUser).
 		Return(expSetlist, nil)
@@ -917,7 +976,13 @@ func TestUpdateByIDSetlistEntryRemoveBatchErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
+	mockSL.
+		On("FetchByID", context.TODO(), int64(1), mockUser).
+		Return(&domain.Setlist{ID: 1}, nil)
 	mockSL.
 		On("Update", context.TODO(), expMockSetlist, mockUser).
 		Return(expSetlist, nil)
@@ -1029,7 +1094,13 @@ func TestUpdateByIDSetlistEntryFetchBySetlistErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockMWH.
+		On("IdentifyUser").
+		Return(mockAuthHF)
 
+	mockSL.
+		On("FetchByID", context.TODO(), int64(1), mockUser).
+		Return(&domain.Setlist{ID: 1}, nil)
 	mockSL.
 		On("Update", context.TODO(), expMockSetlist, mockUser).
 		Return(expSetlist, nil)
@@ -0,0 +1,59 @@
+package setlisthandler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type statusTransition func(ctx context.Context, sid int64, principal *domain.User) (*domain.Setlist, error)
+
+// changeStatus applies the transition to the setlist in the id parameter for the authenticated user.
+func changeStatus(ctx *gin.Context, transition statusTransition) {
+	val, exists := ctx.Get("user")
+	if !exists {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	user, ok := val.(*domain.User)
+	if !ok {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	setlist, err := transition(ctx.Request.Context(), fields["id"], user)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"setlist": setlist})
+}
+
+func (slh setlistHandler) Publish(ctx *gin.Context) {
+	changeStatus(ctx, slh.sls.Publish)
+}
+
+func (slh setlistHandler) Unpublish(ctx *gin.Context) {
+	changeStatus(ctx, slh.sls.Unpublish)
+}
+
+func (slh setlistHandler) Archive(ctx *gin.Context) {
+	changeStatus(ctx, slh.sls.Archive)
+}
@@ -1,6 +1,7 @@
 package setlisthandler
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"strconv"
@@ -23,10 +24,16 @@ type setlistUpdateReq struct {
 
 type setlistRoleUpdateReq struct {
 	ID          int64    `json:"id" binding:"required"`
-	SongID      int64    `json:"song_id" binding:"required"`
+	Type        string   `json:"type"`
+	SongID      int64    `json:"song_id"`
+	Title       string   `json:"title" binding:"lte=255"`
+	Content     string   `json:"content"`
+	Reference   string   `json:"reference" binding:"lte=255"`
 	Transpose   int16    `json:"transpose"`
 	Notes       string   `json:"notes"`
 	Arrangement []string `json:"arrangement"`
+	Languages   string   `json:"languages"`
+	Duration    uint     `json:"duration"`
 	Rank        int64    `json:"rank" binding:"required"`
 }
 
@@ -71,15 +78,6 @@ func (slh setlistHandler) UpdateByID(ctx *gin.Context) {
 		Deadline:  slReq.Deadline.Local().Truncate((time.Minute)),
 	}
 
-	context := ctx.Request.Context()
-	updatedSetlist, err := slh.sls.Update(context, setlist, user)
-
-	if err != nil {
-		ctx.JSON(domain.Status(err), gin.H{"error": err})
-
-		return
-	}
-
 	createdEntries := make([]domain.SetlistEntry, len(slReq.CreatedEntries))
 	updatedEntries := make([]domain.SetlistEntry, len(slReq.UpdatedEntries))
 
@@ -87,11 +85,17 @@ func (slh setlistHandler) UpdateByID(ctx *gin.Context) {
 		jsonArray, _ := json.Marshal(entry.Arrangement)
 
 		createdEntries[idx] = domain.SetlistEntry{
+			Type:        domain.SetlistItemType(entry.Type),
 			SongID:      entry.SongID,
-			SetlistID:   updatedSetlist.ID,
+			SetlistID:   setlist.ID,
 			Transpose:   entry.Transpose,
+			Title:       entry.Title,
+			Content:     entry.Content,
+			Reference:   entry.Reference,
 			Notes:       entry.Notes,
 			Arrangement: datatypes.JSON(jsonArray),
+			Languages:   entry.Languages,
+			Duration:    entry.Duration,
 			Rank:        entry.Rank,
 		}
 	}
@@ -101,34 +105,46 @@ func (slh setlistHandler) UpdateByID(ctx *gin.Context) {
 
 		updatedEntries[idx] = domain.SetlistEntry{
 			ID:          entry.ID,
+			Type:        domain.SetlistItemType(entry.Type),
 			SongID:      entry.SongID,
-			SetlistID:   updatedSetlist.ID,
+			SetlistID:   setlist.ID,
 			Transpose:   entry.Transpose,
+			Title:       entry.Title,
+			Content:     entry.Content,
+			Reference:   entry.Reference,
 			Notes:       entry.Notes,
 			Arrangement: datatypes.JSON(jsonArray),
+			Languages:   entry.Languages,
+			Duration:    entry.Duration,
 			Rank:        entry.Rank,
 		}
 	}
 
-	if err := slh.sles.StoreBatch(context, &createdEntries, user); err != nil {
-		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
-
+	version, ok := slh.matchesVersion(ctx, setlist.ID, user)
+	if !ok {
 		return
 	}
 
-	if err := slh.sles.UpdateBatch(context, &updatedEntries, user); err != nil {
+	setlist.UpdatedAt = version
+
+	context := ctx.Request.Context()
+	updatedSetlist, err := slh.updateWithEntries(context, setlist, createdEntries, updatedEntries, slReq.DeletedEntries, user)
+
+	if err != nil {
 		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
 
 		return
 	}
 
-	if err := slh.sles.RemoveBatch(context, setlist, slReq.DeletedEntries, user); err != nil {
+	entries, err := slh.sles.FetchBySetlist(context, &[]domain.Setlist{*setlist})
+
+	if err != nil {
 		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
 
 		return
 	}
 
-	entries, err := slh.sles.FetchBySetlist(context, &[]domain.Setlist{*setlist})
+	timings, err := slh.sles.FetchTimings(context, entries)
 
 	if err != nil {
 		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
@@ -136,12 +152,46 @@ func (slh setlistHandler) UpdateByID(ctx *gin.Context) {
 		return
 	}
 
-	response := setlistResponse{
-		*updatedSetlist,
-		*entries,
-	}
+	response := newSetlistResponse(*updatedSetlist, *entries, timings[updatedSetlist.ID])
 
 	ctx.JSON(http.StatusOK, gin.H{
 		"setlist": response,
 	})
 }
+
+// updateWithEntries updates the setlist and creates, updates and removes its entries in a single unit of work,
+// nothing is changed when the setlist no longer has the version in its UpdatedAt.
+func (slh setlistHandler) updateWithEntries(
+	ctx context.Context,
+	setlist *domain.Setlist,
+	createdEntries []domain.SetlistEntry,
+	updatedEntries []domain.SetlistEntry,
+	deletedEntries []int64,
+	principal *domain.User,
+) (*domain.Setlist, error) {
+	var updatedSetlist *domain.Setlist
+
+	err := slh.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		updatedSetlist, err = slh.sls.Update(ctx, setlist, principal)
+		if err != nil {
+			return err
+		}
+
+		if err := slh.sles.StoreBatch(ctx, &createdEntries, principal); err != nil {
+			return err
+		}
+
+		if err := slh.sles.UpdateBatch(ctx, &updatedEntries, principal); err != nil {
+			return err
+		}
+
+		return slh.sles.RemoveBatch(ctx, setlist, deletedEntries, principal)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedSetlist, nil
+}
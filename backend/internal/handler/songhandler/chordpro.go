@@ -0,0 +1,92 @@
+package songhandler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type songImportReq struct {
+	BundleID int64  `json:"bundle_id" binding:"required"`
+	ChordPro string `json:"chordpro" binding:"required"`
+}
+
+func (sh songHandler) Import(ctx *gin.Context) {
+	val, exists := ctx.Get("user")
+	if !exists {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr})
+
+		return
+	}
+
+	var sReq songImportReq
+	if err := util.BindModel(ctx, &sReq); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	user, ok := val.(*domain.User)
+	if !ok {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr})
+
+		return
+	}
+
+	song, err := util.ParseChordPro(sReq.ChordPro)
+	if err != nil {
+		newErr := domain.NewBadRequestErr(err.Error())
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr})
+
+		return
+	}
+
+	song.BundleID = sReq.BundleID
+	song.CreatorID = user.ID
+
+	context := ctx.Request.Context()
+
+	if err := sh.ss.Store(context, song, user); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"song": song})
+}
+
+func (sh songHandler) exportChordPro(ctx *gin.Context, idField string) {
+	songID, err := strconv.Atoi(idField)
+	if err != nil {
+		newErr := domain.NewBadRequestErr(err.Error())
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	song, err := sh.ss.FetchByID(context, int64(songID))
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	chordpro, err := util.WriteChordPro(song)
+	if err != nil {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%d%s\"", song.ID, util.ChordProExtension))
+	ctx.Data(http.StatusOK, "application/vnd.chordpro; charset=utf-8", []byte(chordpro))
+}
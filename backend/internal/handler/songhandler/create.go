@@ -14,7 +14,13 @@ type songCreateReq struct {
 	Subtitle   string `json:"subtitle" binding:"lte=255"`
 	Key        string `json:"key" binding:"required"`
 	Bpm        uint   `json:"bpm" binding:"required"`
+	Duration   uint   `json:"duration"`
 	ChordSheet string `json:"chord_sheet" binding:"required"`
+	CCLINumber string `json:"ccli_number" binding:"lte=10"`
+	Authors    string `json:"authors" binding:"lte=255"`
+	Copyright  string `json:"copyright" binding:"lte=255"`
+	Publisher  string `json:"publisher" binding:"lte=255"`
+	Language   string `json:"language"`
 }
 
 func (sh songHandler) Create(ctx *gin.Context) {
@@ -50,7 +56,13 @@ func (sh songHandler) Create(ctx *gin.Context) {
 		Subtitle:   sReq.Subtitle,
 		Key:        sReq.Key,
 		Bpm:        sReq.Bpm,
+		Duration:   sReq.Duration,
 		ChordSheet: datatypes.JSON([]byte(sReq.ChordSheet)),
+		CCLINumber: sReq.CCLINumber,
+		Authors:    sReq.Authors,
+		Copyright:  sReq.Copyright,
+		Publisher:  sReq.Publisher,
+		Language:   sReq.Language,
 	}
 
 	err := sh.ss.Store(context, song, user)
@@ -37,7 +37,12 @@ func (sh songHandler) DeleteByID(ctx *gin.Context) {
 		return
 	}
 
-	err = sh.ss.Remove(context, int64(songID), user)
+	version, ok := sh.matchesVersion(ctx, int64(songID))
+	if !ok {
+		return
+	}
+
+	err = sh.ss.Remove(context, int64(songID), version, user)
 	if err != nil {
 		ctx.JSON(domain.Status(err), gin.H{"error": err})
 		return
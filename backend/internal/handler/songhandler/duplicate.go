@@ -0,0 +1,86 @@
+package songhandler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultDuplicateThreshold = 0.8
+
+type mergeReq struct {
+	SongIDs []int64 `json:"song_ids" binding:"required"`
+}
+
+func (sh songHandler) GetDuplicates(ctx *gin.Context) {
+	threshold := defaultDuplicateThreshold
+
+	if thresholdQuery := ctx.Query("threshold"); thresholdQuery != "" {
+		parsed, err := strconv.ParseFloat(thresholdQuery, 64)
+		if err != nil {
+			newErr := domain.NewBadRequestErr(err.Error())
+			ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+			return
+		}
+
+		threshold = parsed
+	}
+
+	context := ctx.Request.Context()
+
+	duplicates, err := sh.ss.FetchDuplicates(context, threshold)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"duplicates": duplicates})
+}
+
+func (sh songHandler) Merge(ctx *gin.Context) {
+	val, exists := ctx.Get("user")
+	if !exists {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	user, ok := val.(*domain.User)
+	if !ok {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	var req mergeReq
+	if err := util.BindModel(ctx, &req); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	song, err := sh.ss.Merge(context, fields["id"], req.SongIDs, user)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"song": song})
+}
@@ -1,6 +1,7 @@
 package songhandler
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -13,6 +14,12 @@ import (
 func (sh songHandler) GetByID(ctx *gin.Context) {
 	idField := ctx.Params.ByName("id")
 
+	if strings.HasSuffix(idField, util.ChordProExtension) {
+		sh.exportChordPro(ctx, strings.TrimSuffix(idField, util.ChordProExtension))
+
+		return
+	}
+
 	songID, err := strconv.Atoi(idField)
 	if err != nil {
 		newErr := domain.NewBadRequestErr(err.Error())
@@ -30,67 +37,141 @@ func (sh songHandler) GetByID(ctx *gin.Context) {
 		return
 	}
 
+	if util.NotModified(ctx, util.ETag("song", song.ID, song.UpdatedAt)) {
+		return
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{"song": song})
 }
 
-func (sh songHandler) Get(ctx *gin.Context) {
-	idsQuery := ctx.Query("ids")
-	bundlesQuery := ctx.Query("bids")
-	creatorsQuery := ctx.Query("cids")
-	titleSearchQuery := ctx.Query("title")
-	keysQuery := ctx.Query("keys")
-	bpmsQuery := ctx.Query("bpms")
-
-	ids, err := util.StringToInt64Slice(idsQuery)
-	if err != nil {
-		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+)
 
-		return
+func parseUintQuery(ctx *gin.Context, name string) (uint, error) {
+	query := ctx.Query(name)
+	if query == "" {
+		return 0, nil
 	}
 
-	bids, err := util.StringToInt64Slice(bundlesQuery)
+	number, err := strconv.ParseUint(query, 10, 32)
 	if err != nil {
-		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+		return 0, domain.NewBadRequestErr(fmt.Sprintf("Could not read %s", name))
+	}
 
-		return
+	return uint(number), nil
+}
+
+func bindSongFilterOptions(ctx *gin.Context) (*domain.SongFilterOptions, error) {
+	ids, err := util.StringToInt64Slice(ctx.Query("ids"))
+	if err != nil {
+		return nil, err
 	}
 
-	cids, err := util.StringToInt64Slice(creatorsQuery)
+	bids, err := util.StringToInt64Slice(ctx.Query("bids"))
 	if err != nil {
-		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+		return nil, err
+	}
 
-		return
+	cids, err := util.StringToInt64Slice(ctx.Query("cids"))
+	if err != nil {
+		return nil, err
+	}
+
+	tids, err := util.StringToInt64Slice(ctx.Query("tids"))
+	if err != nil {
+		return nil, err
 	}
 
-	keys := strings.Split(keysQuery, ",")
+	keys := strings.Split(ctx.Query("keys"), ",")
 	if keys[0] == "" {
 		keys = nil
 	}
 
-	bpms, err := util.StringToUintSlice(bpmsQuery)
+	bpms, err := util.StringToUintSlice(ctx.Query("bpms"))
 	if err != nil {
-		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+		return nil, err
+	}
 
-		return
+	minBpm, err := parseUintQuery(ctx, "bpm_min")
+	if err != nil {
+		return nil, err
+	}
+
+	maxBpm, err := parseUintQuery(ctx, "bpm_max")
+	if err != nil {
+		return nil, err
+	}
+
+	updatedSince, err := util.StringToTime(ctx.Query("updated_since"))
+	if err != nil {
+		return nil, err
+	}
+
+	descending := false
+
+	switch ctx.Query("order") {
+	case "", "asc":
+	case "desc":
+		descending = true
+	default:
+		return nil, domain.NewBadRequestErr("order must be asc or desc")
 	}
 
-	filterOptions := &domain.SongFilterOptions{
-		IDs:   ids,
-		BIDs:  bids,
-		CIDs:  cids,
-		Keys:  keys,
-		Title: titleSearchQuery,
-		Bpms:  bpms,
+	cursor, err := util.DecodeSongCursor(ctx.Query("cursor"))
+	if err != nil {
+		return nil, err
+	}
+
+	limit := uint(defaultPageLimit)
+	if ctx.Query("limit") != "" {
+		limit, err = parseUintQuery(ctx, "limit")
+		if err != nil || limit == 0 || limit > maxPageLimit {
+			return nil, domain.NewBadRequestErr(fmt.Sprintf("limit must be between 1 and %d", maxPageLimit))
+		}
+	}
+
+	return &domain.SongFilterOptions{
+		IDs:           ids,
+		BIDs:          bids,
+		CIDs:          cids,
+		TIDs:          tids,
+		Keys:          keys,
+		Title:         ctx.Query("title"),
+		Bpms:          bpms,
+		MinBpm:        minBpm,
+		MaxBpm:        maxBpm,
+		CompatibleKey: ctx.Query("compatible_key"),
+		UpdatedSince:  updatedSince,
+		Sort:          domain.SongSort(ctx.Query("sort")),
+		Descending:    descending,
+		After:         cursor,
+		Limit:         int(limit),
+	}, nil
+}
+
+func (sh songHandler) Get(ctx *gin.Context) {
+	filterOptions, err := bindSongFilterOptions(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
 	}
 
 	context := ctx.Request.Context()
 
-	songs, err := sh.ss.Fetch(context, filterOptions)
+	page, err := sh.ss.Fetch(context, filterOptions)
 	if err != nil {
 		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
 
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{"songs": songs})
+	ctx.JSON(http.StatusOK, gin.H{
+		"songs":       page.Songs,
+		"total":       page.Total,
+		"next_cursor": page.NextCursor,
+		"tag_counts":  page.TagCounts,
+	})
 }
@@ -0,0 +1,43 @@
+package songhandler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+func (sh songHandler) Search(ctx *gin.Context) {
+	query := ctx.Query("q")
+	limit := defaultSearchLimit
+
+	if limitQuery := ctx.Query("limit"); limitQuery != "" {
+		parsed, err := strconv.Atoi(limitQuery)
+		if err != nil || parsed <= 0 || parsed > maxSearchLimit {
+			newErr := domain.NewBadRequestErr(fmt.Sprintf("limit must be between 1 and %d", maxSearchLimit))
+			ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+			return
+		}
+
+		limit = parsed
+	}
+
+	context := ctx.Request.Context()
+
+	results, err := sh.ss.Search(context, query, limit)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"results": results})
+}
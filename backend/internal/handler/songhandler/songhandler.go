@@ -1,7 +1,10 @@
 package songhandler
 
 import (
+	"time"
+
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -16,8 +19,42 @@ func Initialize(group *gin.RouterGroup, ss domain.SongService, mwh domain.Middle
 
 	songs := group.Group("songs")
 	songs.POST("/", mwh.AuthenticateUser(), songhandler.Create)
+	songs.POST("/import", mwh.AuthenticateUser(), songhandler.Import)
 	songs.GET("/", songhandler.Get)
+	songs.GET("/search", songhandler.Search)
+	songs.GET("/duplicates", songhandler.GetDuplicates)
 	songs.GET("/:id", songhandler.GetByID)
+	songs.GET("/:id/sheet", songhandler.GetSheet)
+	songs.GET("/:id/revisions", songhandler.GetRevisions)
+	songs.GET("/:id/revisions/diff", songhandler.GetRevisionDiff)
+	songs.POST("/:id/revisions/:rid/revert", mwh.AuthenticateUser(), songhandler.Revert)
+	songs.POST("/:id/merge", mwh.AuthenticateUser(), songhandler.Merge)
+	songs.GET("/:id/translations", songhandler.GetTranslations)
+	songs.PUT("/:id/translations/:lang", mwh.AuthenticateUser(), songhandler.PutTranslation)
+	songs.DELETE("/:id/translations/:lang", mwh.AuthenticateUser(), songhandler.DeleteTranslation)
 	songs.DELETE("/:id", mwh.AuthenticateUser(), songhandler.DeleteByID)
 	songs.PUT("/:id", mwh.AuthenticateUser(), songhandler.UpdateByID)
 }
+
+// matchesVersion writes a precondition error with the current song when the If-Match
+// header of the request is missing or does not match the version of the song. It returns the
+// matched version so the change can be restricted to it.
+func (sh songHandler) matchesVersion(ctx *gin.Context, songID int64) (time.Time, bool) {
+	song, err := sh.ss.FetchByID(ctx.Request.Context(), songID)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return time.Time{}, false
+	}
+
+	etag := util.ETag("song", song.ID, song.UpdatedAt)
+
+	if err := util.CheckIfMatch(ctx, etag); err != nil {
+		ctx.Header("ETag", etag)
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error(), "song": song})
+
+		return time.Time{}, false
+	}
+
+	return song.UpdatedAt, true
+}
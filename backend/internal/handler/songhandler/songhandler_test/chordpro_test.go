@@ -0,0 +1,210 @@
+package songhandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/songhandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/datatypes"
+)
+
+func prepareAndServeImport(
+	t *testing.T,
+	mockSS domain.SongService,
+	mockMWH domain.MiddlewareHandler,
+	body *[]byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	songhandler.Initialize(&router.RouterGroup, mockSS, mockMWH)
+
+	req, err := http.NewRequestWithContext(
+		context.TODO(),
+		http.MethodPost,
+		"/songs/import",
+		bytes.NewReader(*body),
+	)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestImport(t *testing.T) {
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.MEMBER,
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+		mockSong := &domain.Song{
+			BundleID:   2,
+			CreatorID:  mockUser.ID,
+			Title:      "Foo",
+			Key:        "G",
+			Bpm:        90,
+			ChordSheet: datatypes.JSON([]byte(`[{"tag":"Chorus","text":"[G]Bar"}]`)),
+		}
+
+		mockSS.
+			On("Store", context.TODO(), mockSong, mockUser).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				arg, ok := args.Get(1).(*domain.Song)
+				assert.True(t, ok)
+				arg.ID = 1
+			})
+
+		byteBody, err := json.Marshal(gin.H{
+			"bundle_id": 2,
+			"chordpro":  "{title: Foo}\n{key: G}\n{tempo: 90}\n{soc}\n[G]Bar\n{eoc}\n",
+		})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeImport(t, mockSS, mockMWH, &byteBody)
+		mockSong.ID = 1
+
+		expectedBytes, err := json.Marshal(gin.H{"song": mockSong})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid chordpro", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		byteBody, err := json.Marshal(gin.H{
+			"bundle_id": 2,
+			"chordpro":  "{key: G}\n[G]Bar\n",
+		})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeImport(t, mockSS, mockMWH, &byteBody)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail Store error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("invalid key")
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("Store", context.TODO(), mock.AnythingOfType("*domain.Song"), mockUser).
+			Return(expErr)
+
+		byteBody, err := json.Marshal(gin.H{
+			"bundle_id": 2,
+			"chordpro":  "{title: Foo}\n{key: H}\n[G]Bar\n",
+		})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeImport(t, mockSS, mockMWH, &byteBody)
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+}
+
+func TestExportChordPro(t *testing.T) {
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+		mockSong := &domain.Song{
+			ID:         1,
+			Title:      "Foo",
+			Key:        "G",
+			Bpm:        90,
+			ChordSheet: datatypes.JSON([]byte(`{"Chorus":"[G]Bar"}`)),
+		}
+
+		mockSS.
+			On("FetchByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/1.cho")
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "{title: Foo}\n{key: G}\n{tempo: 90}\n\n{start_of_chorus: Chorus}\n[G]Bar\n{end_of_chorus}\n", writer.Body.String())
+		assert.Contains(t, writer.Header().Get("Content-Type"), "application/vnd.chordpro")
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/a.cho")
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchByID error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("FetchByID", context.TODO(), int64(1)).
+			Return(nil, expErr)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/1.cho")
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
@@ -35,6 +36,7 @@ func prepareAndServeDelete(
 		nil,
 	)
 	assert.NoError(t, err)
+	req.Header.Set("If-Match", mockSongETag)
 
 	router.ServeHTTP(writer, req)
 
@@ -62,7 +64,10 @@ func TestDeleteByIDCorrect(t *testing.T) {
 		On("AuthenticateUser").
 		Return(mockAuthHF)
 	mockSS.
-		On("Remove", context.TODO(), sid, mockUser).
+		On("FetchByID", context.TODO(), int64(1)).
+		Return(&domain.Song{ID: 1}, nil)
+	mockSS.
+		On("Remove", context.TODO(), sid, time.Time{}, mockUser).
 		Return(nil)
 
 	writer := prepareAndServeDelete(t, mockSS, mockMWH, fmt.Sprint(sid))
@@ -142,7 +147,10 @@ func TestDeleteByIDRemoveErr(t *testing.T) {
 		On("AuthenticateUser").
 		Return(mockAuthHF)
 	mockSS.
-		On("Remove", context.TODO(), sid, mockUser).
+		On("FetchByID", context.TODO(), int64(1)).
+		Return(&domain.Song{ID: 1}, nil)
+	mockSS.
+		On("Remove", context.TODO(), sid, time.Time{}, mockUser).
 		Return(mockErr)
 
 	writer := prepareAndServeDelete(t, mockSS, mockMWH, fmt.Sprint(sid))
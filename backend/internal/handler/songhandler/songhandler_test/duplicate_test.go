@@ -0,0 +1,178 @@
+package songhandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/songhandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func prepareAndServeMerge(
+	t *testing.T,
+	mockSS domain.SongService,
+	mockMWH domain.MiddlewareHandler,
+	param string,
+	body []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	songhandler.Initialize(&router.RouterGroup, mockSS, mockMWH)
+
+	req, err := http.NewRequestWithContext(
+		context.TODO(),
+		http.MethodPost,
+		fmt.Sprintf("/songs%s", param),
+		bytes.NewReader(body),
+	)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestGetDuplicates(t *testing.T) {
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	mockDuplicates := []domain.DuplicateGroup{
+		{
+			Songs:      []domain.Song{{ID: 1, Title: "Amazing Grace"}, {ID: 2, Title: "Amazing grace "}},
+			Similarity: 1,
+		},
+	}
+
+	t.Run("Correct default threshold", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("FetchDuplicates", context.TODO(), 0.8).
+			Return(mockDuplicates, nil)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/duplicates")
+
+		expectedBytes, err := json.Marshal(gin.H{"duplicates": mockDuplicates})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Correct threshold", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("FetchDuplicates", context.TODO(), 0.95).
+			Return(mockDuplicates, nil)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/duplicates?threshold=0.95")
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid threshold", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/duplicates?threshold=high")
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+}
+
+func TestMerge(t *testing.T) {
+	mockUser := &domain.User{ID: 1, Permission: domain.ADMIN}
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSong := &domain.Song{ID: 1, Title: "Amazing Grace"}
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("Merge", context.TODO(), int64(1), []int64{2, 3}, mockUser).
+			Return(mockSong, nil)
+
+		body, err := json.Marshal(gin.H{"song_ids": []int64{2, 3}})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeMerge(t, mockSS, mockMWH, "/1/merge", body)
+
+		expectedBytes, err := json.Marshal(gin.H{"song": mockSong})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail missing song ids", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		writer := prepareAndServeMerge(t, mockSS, mockMWH, "/1/merge", []byte(`{}`))
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail Merge error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("only admins can merge songs")
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("Merge", context.TODO(), int64(1), []int64{2}, mockUser).
+			Return(nil, expErr)
+
+		body, err := json.Marshal(gin.H{"song_ids": []int64{2}})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeMerge(t, mockSS, mockMWH, "/1/merge", body)
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+}
@@ -7,10 +7,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/songhandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/datatypes"
@@ -55,6 +57,10 @@ func TestGet(t *testing.T) {
 		},
 	}
 
+	mockTagCounts := []domain.TagCount{
+		{TagID: 3, Name: "grace", Count: 2},
+	}
+
 	mockMWH := &mocks.MockMiddlewareHandler{}
 
 	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
@@ -66,15 +72,18 @@ func TestGet(t *testing.T) {
 	t.Run("Correct", func(t *testing.T) {
 		t.Parallel()
 
-		mockFilterOptions := &domain.SongFilterOptions{}
+		mockFilterOptions := &domain.SongFilterOptions{Limit: 50}
 		mockSS := &mocks.MockSongService{}
 
 		mockSS.
 			On("Fetch", context.TODO(), mockFilterOptions).
-			Return(mockSongs, nil)
+			Return(&domain.SongPage{Songs: mockSongs, Total: 2, TagCounts: mockTagCounts}, nil)
 
 		expBody, err := json.Marshal(gin.H{
-			"songs": mockSongs,
+			"next_cursor": "",
+			"songs":       mockSongs,
+			"tag_counts":  mockTagCounts,
+			"total":       2,
 		})
 		assert.NoError(t, err)
 
@@ -86,11 +95,66 @@ func TestGet(t *testing.T) {
 		mockMWH.AssertExpectations(t)
 	})
 
+	t.Run("Correct with filters", func(t *testing.T) {
+		t.Parallel()
+
+		cursor := domain.SongCursor{ID: 2, Value: "Barfoo"}
+		mockFilterOptions := &domain.SongFilterOptions{
+			TIDs:          []int64{3, 4},
+			Keys:          []string{"G", "Em"},
+			MinBpm:        60,
+			MaxBpm:        90,
+			CompatibleKey: "G",
+			UpdatedSince:  time.Date(2023, time.May, 1, 10, 0, 0, 0, time.UTC),
+			Sort:          domain.SongSortTitle,
+			Descending:    true,
+			After:         &cursor,
+			Limit:         1,
+		}
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("Fetch", context.TODO(), mockFilterOptions).
+			Return(&domain.SongPage{Songs: mockSongs[:1], Total: 2, NextCursor: "foo"}, nil)
+
+		expBody, err := json.Marshal(gin.H{
+			"next_cursor": "foo",
+			"songs":       mockSongs[:1],
+			"tag_counts":  nil,
+			"total":       2,
+		})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, fmt.Sprintf(
+			"/?tids=3,4&keys=G,Em&bpm_min=60&bpm_max=90&compatible_key=G&updated_since=2023-05-01T10:00:00Z"+
+				"&sort=title&order=desc&limit=1&cursor=%s",
+			util.EncodeSongCursor(cursor),
+		))
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expBody, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid query", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		for _, query := range []string{"bpm_min=fast", "tids=a", "order=up", "limit=0", "limit=1000", "cursor=foo", "updated_since=yesterday"} {
+			writer := prepareAndServeGet(t, mockSS, mockMWH, "/?"+query)
+
+			assert.Equal(t, http.StatusBadRequest, writer.Code, query)
+		}
+
+		mockSS.AssertExpectations(t)
+	})
+
 	t.Run("Fail Song Fetch error", func(t *testing.T) {
 		t.Parallel()
 
 		expErr := domain.NewInternalErr()
-		mockFilterOptions := &domain.SongFilterOptions{}
+		mockFilterOptions := &domain.SongFilterOptions{Limit: 50}
 		mockSS := &mocks.MockSongService{}
 
 		mockSS.
@@ -110,6 +174,7 @@ func TestGet(t *testing.T) {
 		mockMWH.AssertExpectations(t)
 	})
 }
+
 func TestGetByIDCorrect(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,170 @@
+package songhandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/songhandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func prepareAndServeConditional(
+	t *testing.T,
+	mockSS domain.SongService,
+	method string,
+	headers map[string]string,
+	body []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", &domain.User{ID: 1, Permission: domain.EDITOR})
+		ctx.Next()
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	songhandler.Initialize(&router.RouterGroup, mockSS, mockMWH)
+
+	req, err := http.NewRequestWithContext(context.TODO(), method, "/songs/1", bytes.NewReader(body))
+	assert.NoError(t, err)
+
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestSongPreconditions(t *testing.T) {
+	t.Parallel()
+
+	mockSong := &domain.Song{ID: 1, Title: "Foo", UpdatedAt: time.Now().Truncate(time.Millisecond)}
+	currentETag := util.ETag("song", mockSong.ID, mockSong.UpdatedAt)
+	staleETag := util.ETag("song", mockSong.ID, mockSong.UpdatedAt.Add(-time.Minute))
+
+	t.Run("Correct ETag on read", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+		mockSS.
+			On("FetchByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		writer := prepareAndServeConditional(t, mockSS, http.MethodGet, nil, nil)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, currentETag, writer.Header().Get("ETag"))
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Correct not modified", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+		mockSS.
+			On("FetchByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		writer := prepareAndServeConditional(t, mockSS, http.MethodGet, map[string]string{"If-None-Match": currentETag}, nil)
+
+		assert.Equal(t, http.StatusNotModified, writer.Code)
+		assert.Empty(t, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail delete without If-Match", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+		mockSS.
+			On("FetchByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		writer := prepareAndServeConditional(t, mockSS, http.MethodDelete, nil, nil)
+
+		assert.Equal(t, http.StatusPreconditionRequired, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail update stale version", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+		mockSS.
+			On("FetchByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		byteBody, err := json.Marshal(gin.H{
+			"bundle_id":   1,
+			"creator_id":  1,
+			"title":       "Bar",
+			"subtitle":    "Bar",
+			"key":         "G",
+			"bpm":         120,
+			"chord_sheet": `{"Verse 1": "[G]Bar"}`,
+		})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeConditional(t, mockSS, http.MethodPut, map[string]string{"If-Match": staleETag}, byteBody)
+
+		expErr := domain.NewPreconditionFailedErr("resource was changed by someone else, fetch it again before changing it")
+		expectedBody, err := json.Marshal(gin.H{"error": expErr.Error(), "song": mockSong})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusPreconditionFailed, writer.Code)
+		assert.Equal(t, currentETag, writer.Header().Get("ETag"))
+		assert.JSONEq(t, string(expectedBody), writer.Body.String())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail update changed concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewPreconditionFailedErr("resource was changed by someone else, fetch it again before changing it")
+		mockSS := &mocks.MockSongService{}
+		mockSS.
+			On("FetchByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockSS.
+			On("Update", context.TODO(), mock.MatchedBy(func(song *domain.Song) bool {
+				return song.ID == mockSong.ID && song.UpdatedAt.Equal(mockSong.UpdatedAt)
+			}), mock.AnythingOfType("*domain.User")).
+			Return(expErr)
+
+		byteBody, err := json.Marshal(gin.H{
+			"bundle_id":   1,
+			"creator_id":  1,
+			"title":       "Bar",
+			"subtitle":    "Bar",
+			"key":         "G",
+			"bpm":         120,
+			"chord_sheet": `{"Verse 1": "[G]Bar"}`,
+		})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeConditional(t, mockSS, http.MethodPut, map[string]string{"If-Match": currentETag}, byteBody)
+
+		assert.Equal(t, http.StatusPreconditionFailed, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+}
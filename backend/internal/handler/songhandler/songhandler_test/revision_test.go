@@ -0,0 +1,213 @@
+package songhandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/songhandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func prepareAndServeRevert(
+	t *testing.T,
+	mockSS domain.SongService,
+	mockMWH domain.MiddlewareHandler,
+	param string,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	songhandler.Initialize(&router.RouterGroup, mockSS, mockMWH)
+
+	req, err := http.NewRequestWithContext(
+		context.TODO(),
+		http.MethodPost,
+		fmt.Sprintf("/songs%s", param),
+		nil,
+	)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestGetRevisions(t *testing.T) {
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+		expRevisions := []domain.SongRevision{
+			{ID: 1, SongID: 1, EditorID: 1, Title: "Foo"},
+		}
+
+		mockSS.
+			On("FetchRevisions", context.TODO(), int64(1)).
+			Return(expRevisions, nil)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/1/revisions")
+
+		expectedBytes, err := json.Marshal(gin.H{"revisions": expRevisions})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchRevisions error", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewRecordNotFoundErr("id", "1")
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("FetchRevisions", context.TODO(), int64(1)).
+			Return(nil, mockErr)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/1/revisions")
+
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+}
+
+func TestGetRevisionDiff(t *testing.T) {
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+		expDiff := &domain.SongRevisionDiff{
+			SongID: 1,
+			FromID: 2,
+			ToID:   3,
+			Fields: []domain.FieldDiff{},
+			Sections: []domain.SectionDiff{
+				{Tag: "Verse", Status: domain.DiffChanged, From: "Foo", To: "Bar"},
+			},
+		}
+
+		mockSS.
+			On("FetchRevisionDiff", context.TODO(), int64(1), int64(2), int64(3)).
+			Return(expDiff, nil)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/1/revisions/diff?from=2&to=3")
+
+		expectedBytes, err := json.Marshal(gin.H{"diff": expDiff})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail missing query", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/1/revisions/diff?from=2")
+
+		expectedBytes, err := json.Marshal(gin.H{"error": domain.NewBadRequestErr("Could not read to").Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+}
+
+func TestRevert(t *testing.T) {
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.MEMBER,
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+		expSong := &domain.Song{ID: 1, Title: "Foo", Key: "G"}
+
+		mockSS.
+			On("Revert", context.TODO(), int64(1), int64(2), mockUser).
+			Return(expSong, nil)
+
+		writer := prepareAndServeRevert(t, mockSS, mockMWH, "/1/revisions/2/revert")
+
+		expectedBytes, err := json.Marshal(gin.H{"song": expSong})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail not authorized", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewNotAuthorizedErr("user is neither an editor nor creator of the song")
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("Revert", context.TODO(), int64(1), int64(2), mockUser).
+			Return(nil, mockErr)
+
+		writer := prepareAndServeRevert(t, mockSS, mockMWH, "/1/revisions/2/revert")
+
+		expectedBytes, err := json.Marshal(gin.H{"error": mockErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.Status(mockErr), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid revision", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		writer := prepareAndServeRevert(t, mockSS, mockMWH, "/1/revisions/a/revert")
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+}
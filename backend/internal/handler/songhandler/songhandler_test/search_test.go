@@ -0,0 +1,98 @@
+package songhandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	mockResults := []domain.SongSearchResult{
+		{
+			Song:       domain.Song{ID: 1, Title: "Amazing Grace"},
+			Score:      1.5,
+			Section:    "Verse 1",
+			Snippet:    "Amazing grace, how sweet the sound",
+			Highlights: []domain.TextRange{{Start: 8, End: 13}},
+		},
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("Search", context.TODO(), "grace", 20).
+			Return(mockResults, nil)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/search?q=grace")
+
+		expectedBytes, err := json.Marshal(gin.H{"results": mockResults})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Correct with limit", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("Search", context.TODO(), "sweet sound", 5).
+			Return(mockResults, nil)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/search?q=sweet+sound&limit=5")
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid limit", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/search?q=grace&limit=500")
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail Search error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("search query cannot be empty")
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("Search", context.TODO(), "", 20).
+			Return(nil, expErr)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/search")
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+}
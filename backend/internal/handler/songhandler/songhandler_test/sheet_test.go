@@ -0,0 +1,99 @@
+package songhandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestGetSheet(t *testing.T) {
+	expSheet := &domain.Sheet{
+		SongID:      1,
+		Title:       "Foo",
+		OriginalKey: "G",
+		Key:         "G",
+		Notation:    domain.NashvilleNotation,
+		ChordSheet:  datatypes.JSON([]byte(`{"Verse":"[1]Foo [4]bar"}`)),
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("FetchSheet", context.TODO(), expSheet.SongID, &domain.SheetOptions{Notation: domain.NashvilleNotation}).
+			Return(expSheet, nil)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/1/sheet?notation=nashville")
+
+		expectedBytes, err := json.Marshal(gin.H{"sheet": expSheet})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Correct languages", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+		options := &domain.SheetOptions{Notation: domain.LetterNotation, Languages: []string{"nl", "en"}}
+
+		mockSS.
+			On("FetchSheet", context.TODO(), expSheet.SongID, options).
+			Return(expSheet, nil)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/1/sheet?lang=nl,%20en")
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/a/sheet")
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchSheet error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("roman is not a valid notation")
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("FetchSheet", context.TODO(), expSheet.SongID, &domain.SheetOptions{Notation: "roman"}).
+			Return(nil, expErr)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/1/sheet?notation=roman")
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,181 @@
+package songhandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/songhandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func prepareAndServeTranslation(
+	t *testing.T,
+	mockSS domain.SongService,
+	mockMWH domain.MiddlewareHandler,
+	method string,
+	param string,
+	body []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	songhandler.Initialize(&router.RouterGroup, mockSS, mockMWH)
+
+	req, err := http.NewRequestWithContext(
+		context.TODO(),
+		method,
+		fmt.Sprintf("/songs%s", param),
+		bytes.NewReader(body),
+	)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestGetTranslations(t *testing.T) {
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTranslations := []domain.SongTranslation{
+			{ID: 1, SongID: 1, Language: "nl", Lyrics: datatypes.JSON([]byte(`{"Chorus":"Vrij"}`))},
+		}
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("FetchTranslations", context.TODO(), int64(1)).
+			Return(mockTranslations, nil)
+
+		writer := prepareAndServeGet(t, mockSS, mockMWH, "/1/translations")
+
+		expectedBytes, err := json.Marshal(gin.H{"translations": mockTranslations})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+}
+
+func TestPutTranslation(t *testing.T) {
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTranslation := &domain.SongTranslation{
+			SongID:   1,
+			Language: "nl",
+			Title:    "Genade",
+			Lyrics:   datatypes.JSON([]byte(`{"Chorus":"Vrij"}`)),
+		}
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("StoreTranslation", context.TODO(), mockTranslation, mockUser).
+			Return(nil)
+
+		body, err := json.Marshal(gin.H{"title": "Genade", "lyrics": gin.H{"Chorus": "Vrij"}})
+		assert.NoError(t, err)
+
+		writer := prepareAndServeTranslation(t, mockSS, mockMWH, http.MethodPut, "/1/translations/nl", body)
+
+		expectedBytes, err := json.Marshal(gin.H{"translation": mockTranslation})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail missing lyrics", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		writer := prepareAndServeTranslation(t, mockSS, mockMWH, http.MethodPut, "/1/translations/nl", []byte(`{"title":"Genade"}`))
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+}
+
+func TestDeleteTranslation(t *testing.T) {
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("RemoveTranslation", context.TODO(), int64(1), "nl", mockUser).
+			Return(nil)
+
+		writer := prepareAndServeTranslation(t, mockSS, mockMWH, http.MethodDelete, "/1/translations/nl", nil)
+
+		assert.Equal(t, http.StatusAccepted, writer.Code)
+		mockSS.AssertExpectations(t)
+	})
+
+	t.Run("Fail RemoveTranslation error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("language", "de")
+		mockSS := &mocks.MockSongService{}
+
+		mockSS.
+			On("RemoveTranslation", context.TODO(), int64(1), "de", mockUser).
+			Return(expErr)
+
+		writer := prepareAndServeTranslation(t, mockSS, mockMWH, http.MethodDelete, "/1/translations/de", nil)
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSS.AssertExpectations(t)
+	})
+}
@@ -8,15 +8,20 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler/songhandler"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/datatypes"
 )
 
+// mockSongETag is the version of the song returned by the FetchByID expectations.
+var mockSongETag = util.ETag("song", 1, time.Time{})
+
 func prepareAndServeUpdate(
 	t *testing.T,
 	mockSS domain.SongService,
@@ -41,6 +46,7 @@ func prepareAndServeUpdate(
 		requestBody,
 	)
 	assert.NoError(t, err)
+	req.Header.Set("If-Match", mockSongETag)
 
 	router.ServeHTTP(writer, req)
 
@@ -78,6 +84,9 @@ func TestUpdateByIDCorrect(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockSS.
+		On("FetchByID", context.TODO(), int64(1)).
+		Return(&domain.Song{ID: 1}, nil)
 	mockSS.
 		On("Update", context.TODO(), mockSong, mockUser).
 		Return(nil)
@@ -247,6 +256,9 @@ func TestUpdateByIDUpdateErr(t *testing.T) {
 	mockMWH.
 		On("AuthenticateUser").
 		Return(mockAuthHF)
+	mockSS.
+		On("FetchByID", context.TODO(), int64(1)).
+		Return(&domain.Song{ID: 1}, nil)
 	mockSS.
 		On("Update", context.TODO(), mockSong, mockUser).
 		Return(mockErr)
@@ -0,0 +1,128 @@
+package songhandler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+	"gorm.io/datatypes"
+)
+
+type translationReq struct {
+	Title  string            `json:"title" binding:"lte=255"`
+	Lyrics map[string]string `json:"lyrics" binding:"required"`
+}
+
+func (sh songHandler) GetTranslations(ctx *gin.Context) {
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	translations, err := sh.ss.FetchTranslations(context, fields["id"])
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"translations": translations})
+}
+
+func (sh songHandler) PutTranslation(ctx *gin.Context) {
+	val, exists := ctx.Get("user")
+	if !exists {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	user, ok := val.(*domain.User)
+	if !ok {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	var req translationReq
+	if err := util.BindModel(ctx, &req); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	lyrics, err := json.Marshal(req.Lyrics)
+	if err != nil {
+		newErr := domain.NewBadRequestErr(err.Error())
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	translation := &domain.SongTranslation{
+		SongID:   fields["id"],
+		Language: ctx.Param("lang"),
+		Title:    req.Title,
+		Lyrics:   datatypes.JSON(lyrics),
+	}
+
+	context := ctx.Request.Context()
+
+	if err := sh.ss.StoreTranslation(context, translation, user); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"translation": translation})
+}
+
+func (sh songHandler) DeleteTranslation(ctx *gin.Context) {
+	val, exists := ctx.Get("user")
+	if !exists {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	user, ok := val.(*domain.User)
+	if !ok {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	if err := sh.ss.RemoveTranslation(context, fields["id"], ctx.Param("lang"), user); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
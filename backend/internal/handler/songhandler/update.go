@@ -16,7 +16,13 @@ type songUpdateReq struct {
 	Subtitle   string `json:"subtitle" binding:"required,lte=255"`
 	Key        string `json:"key" binding:"required"`
 	Bpm        uint   `json:"bpm" binding:"required"`
+	Duration   uint   `json:"duration"`
 	ChordSheet string `json:"chord_sheet" binding:"required"`
+	CCLINumber string `json:"ccli_number" binding:"lte=10"`
+	Authors    string `json:"authors" binding:"lte=255"`
+	Copyright  string `json:"copyright" binding:"lte=255"`
+	Publisher  string `json:"publisher" binding:"lte=255"`
+	Language   string `json:"language"`
 }
 
 func (sh songHandler) UpdateByID(ctx *gin.Context) {
@@ -63,9 +69,22 @@ func (sh songHandler) UpdateByID(ctx *gin.Context) {
 		Subtitle:   sReq.Subtitle,
 		Key:        sReq.Key,
 		Bpm:        sReq.Bpm,
+		Duration:   sReq.Duration,
 		ChordSheet: datatypes.JSON([]byte(sReq.ChordSheet)),
+		CCLINumber: sReq.CCLINumber,
+		Authors:    sReq.Authors,
+		Copyright:  sReq.Copyright,
+		Publisher:  sReq.Publisher,
+		Language:   sReq.Language,
 	}
 
+	version, ok := sh.matchesVersion(ctx, int64(songID))
+	if !ok {
+		return
+	}
+
+	song.UpdatedAt = version
+
 	err = sh.ss.Update(context, song, user)
 	if err != nil {
 		ctx.JSON(domain.Status(err), gin.H{"error": err})
@@ -0,0 +1,76 @@
+package statshandler
+
+import (
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+func (sth statsHandler) GetCCLIReport(ctx *gin.Context) {
+	val, exists := ctx.Get("user")
+	if !exists {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	user, ok := val.(*domain.User)
+	if !ok {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	fromTime, fromErr := util.StringToTime(ctx.Query("from"))
+	toTime, toErr := util.StringToTime(ctx.Query("to"))
+
+	if fromErr != nil {
+		ctx.JSON(domain.Status(fromErr), gin.H{"error": fromErr.Error()})
+
+		return
+	}
+
+	if toErr != nil {
+		ctx.JSON(domain.Status(toErr), gin.H{"error": toErr.Error()})
+
+		return
+	}
+
+	format := ctx.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" {
+		newErr := domain.NewBadRequestErr("format must be json or csv")
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	report, err := sth.sts.FetchCCLIReport(context, fromTime, toTime, user)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	if format == "json" {
+		ctx.JSON(http.StatusOK, gin.H{"report": report})
+
+		return
+	}
+
+	csv, err := util.WriteCCLIReport(report)
+	if err != nil {
+		newErr := domain.NewInternalErr()
+		ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+		return
+	}
+
+	ctx.Header("Content-Disposition", "attachment; filename=\"ccli-report.csv\"")
+	ctx.Data(http.StatusOK, "text/csv; charset=utf-8", csv)
+}
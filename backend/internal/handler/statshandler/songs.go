@@ -0,0 +1,78 @@
+package statshandler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultTopSongs = 10
+	maxTopSongs     = 100
+)
+
+func (sth statsHandler) GetSongStats(ctx *gin.Context) {
+	fields, err := util.BindNamedParams(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	context := ctx.Request.Context()
+
+	stats, err := sth.sts.FetchSongStats(context, fields["id"])
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"stats": stats})
+}
+
+func (sth statsHandler) GetTopSongs(ctx *gin.Context) {
+	fromTime, fromErr := util.StringToTime(ctx.Query("from"))
+	toTime, toErr := util.StringToTime(ctx.Query("to"))
+
+	if fromErr != nil {
+		ctx.JSON(domain.Status(fromErr), gin.H{"error": fromErr.Error()})
+
+		return
+	}
+
+	if toErr != nil {
+		ctx.JSON(domain.Status(toErr), gin.H{"error": toErr.Error()})
+
+		return
+	}
+
+	limit := defaultTopSongs
+
+	if limitQuery := ctx.Query("limit"); limitQuery != "" {
+		parsed, err := strconv.Atoi(limitQuery)
+		if err != nil || parsed <= 0 || parsed > maxTopSongs {
+			newErr := domain.NewBadRequestErr(fmt.Sprintf("limit must be between 1 and %d", maxTopSongs))
+			ctx.JSON(domain.Status(newErr), gin.H{"error": newErr.Error()})
+
+			return
+		}
+
+		limit = parsed
+	}
+
+	context := ctx.Request.Context()
+
+	stats, err := sth.sts.FetchTopSongs(context, fromTime, toTime, limit)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"songs": stats})
+}
@@ -0,0 +1,23 @@
+package statshandler
+
+import (
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+type statsHandler struct {
+	sts domain.StatsService
+}
+
+func Initialize(group *gin.RouterGroup, sts domain.StatsService, mwh domain.MiddlewareHandler) {
+	statshandler := &statsHandler{
+		sts: sts,
+	}
+
+	songs := group.Group("songs")
+	songs.GET(":id/stats", mwh.AuthenticateUser(), statshandler.GetSongStats)
+
+	stats := group.Group("stats")
+	stats.GET("songs", mwh.AuthenticateUser(), statshandler.GetTopSongs)
+	stats.GET("ccli", mwh.AuthenticateUser(), statshandler.GetCCLIReport)
+}
@@ -0,0 +1,118 @@
+package statshandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCCLIReport(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
+	mockReport := &domain.CCLIReport{
+		From: from,
+		To:   to,
+		Rows: []domain.CCLIReportRow{
+			{SongID: 1, CCLINumber: "22025", Title: "Foo", TimesUsed: 2},
+			{SongID: 2, Title: "Bar", TimesUsed: 1, MissingCCLINumber: true},
+		},
+	}
+	period := "from=2023-01-01T00:00:00Z&to=2023-04-01T00:00:00Z"
+	mockEditor := &domain.User{ID: 2, Permission: domain.EDITOR}
+
+	t.Run("Correct json", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockStatsService{}
+
+		mockSTS.
+			On("FetchCCLIReport", context.TODO(), from, to, mockEditor).
+			Return(mockReport, nil)
+
+		writer := prepareAndServeGet(t, "/stats/ccli?"+period, mockSTS, mockEditor)
+
+		expectedBytes, err := json.Marshal(gin.H{"report": mockReport})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Correct csv", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockStatsService{}
+
+		mockSTS.
+			On("FetchCCLIReport", context.TODO(), from, to, mockEditor).
+			Return(mockReport, nil)
+
+		writer := prepareAndServeGet(t, "/stats/ccli?format=csv&"+period, mockSTS, mockEditor)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", writer.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=\"ccli-report.csv\"", writer.Header().Get("Content-Disposition"))
+		assert.Equal(t, "CCLI Song Number,Title,Authors,Copyright,Publisher,Times Used,Missing CCLI Number\n"+
+			"22025,Foo,,,,2,\n,Bar,,,,1,yes\n", writer.Body.String())
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid query", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockStatsService{}
+
+		for _, query := range []string{"from=yesterday", "to=today", "format=xml"} {
+			writer := prepareAndServeGet(t, "/stats/ccli?"+query, mockSTS, mockEditor)
+
+			assert.Equal(t, http.StatusBadRequest, writer.Code, query)
+		}
+
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchCCLIReport error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSTS := &mocks.MockStatsService{}
+
+		mockSTS.
+			On("FetchCCLIReport", context.TODO(), from, to, mockEditor).
+			Return(nil, expErr)
+
+		writer := prepareAndServeGet(t, "/stats/ccli?"+period, mockSTS, mockEditor)
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSTS.AssertExpectations(t)
+	})
+	t.Run("Fail not editor", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockSTS := &mocks.MockStatsService{}
+
+		mockSTS.
+			On("FetchCCLIReport", context.TODO(), from, to, mockMember).
+			Return(nil, expErr)
+
+		writer := prepareAndServeGet(t, "/stats/ccli?"+period, mockSTS, mockMember)
+
+		assert.Equal(t, http.StatusUnauthorized, writer.Code)
+		mockSTS.AssertExpectations(t)
+	})
+}
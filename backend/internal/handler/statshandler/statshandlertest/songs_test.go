@@ -0,0 +1,186 @@
+package statshandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/statshandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockMember = &domain.User{ID: 1, Permission: domain.MEMBER}
+
+func prepareAndServeGet(
+	t *testing.T,
+	path string,
+	mockSTS domain.StatsService,
+	mockUser *domain.User,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+	mockMWH := &mocks.MockMiddlewareHandler{}
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	statshandler.Initialize(&router.RouterGroup, mockSTS, mockMWH)
+
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, path, nil)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestGetSongStats(t *testing.T) {
+	t.Parallel()
+
+	last := time.Date(2023, time.May, 7, 10, 0, 0, 0, time.UTC)
+	mockStats := &domain.SongStats{SongID: 1, Title: "Foo", PlayCount: 2, FirstPlayed: &last, LastPlayed: &last}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockStatsService{}
+
+		mockSTS.
+			On("FetchSongStats", context.TODO(), int64(1)).
+			Return(mockStats, nil)
+
+		writer := prepareAndServeGet(t, "/songs/1/stats", mockSTS, mockMember)
+
+		expectedBytes, err := json.Marshal(gin.H{"stats": mockStats})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockStatsService{}
+
+		writer := prepareAndServeGet(t, "/songs/a/stats", mockSTS, mockMember)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchSongStats error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		mockSTS := &mocks.MockStatsService{}
+
+		mockSTS.
+			On("FetchSongStats", context.TODO(), int64(1)).
+			Return(nil, expErr)
+
+		writer := prepareAndServeGet(t, "/songs/1/stats", mockSTS, mockMember)
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSTS.AssertExpectations(t)
+	})
+}
+
+func TestGetTopSongs(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC)
+	mockStats := []domain.SongStats{
+		{SongID: 2, Title: "Bar", PlayCount: 3},
+		{SongID: 1, Title: "Foo", PlayCount: 1},
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockStatsService{}
+
+		mockSTS.
+			On("FetchTopSongs", context.TODO(), from, to, 5).
+			Return(mockStats, nil)
+
+		writer := prepareAndServeGet(t, "/stats/songs?from=2023-01-01T00:00:00Z&to=2023-07-01T00:00:00Z&limit=5", mockSTS, mockMember)
+
+		expectedBytes, err := json.Marshal(gin.H{"songs": mockStats})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Correct default period", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockStatsService{}
+
+		mockSTS.
+			On("FetchTopSongs", context.TODO(), time.Time{}, time.Time{}, 10).
+			Return(mockStats, nil)
+
+		writer := prepareAndServeGet(t, "/stats/songs", mockSTS, mockMember)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid query", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockStatsService{}
+
+		for _, query := range []string{"from=yesterday", "to=today", "limit=0", "limit=a"} {
+			writer := prepareAndServeGet(t, "/stats/songs?"+query, mockSTS, mockMember)
+
+			assert.Equal(t, http.StatusBadRequest, writer.Code, query)
+		}
+
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchTopSongs error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("From field cannot be after To field.")
+		mockSTS := &mocks.MockStatsService{}
+
+		mockSTS.
+			On("FetchTopSongs", context.TODO(), to, from, 10).
+			Return(nil, expErr)
+
+		writer := prepareAndServeGet(t, "/stats/songs?from=2023-07-01T00:00:00Z&to=2023-01-01T00:00:00Z", mockSTS, mockMember)
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSTS.AssertExpectations(t)
+	})
+}
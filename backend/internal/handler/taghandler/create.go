@@ -0,0 +1,45 @@
+package taghandler
+
+import (
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type tagReq struct {
+	Name     string             `json:"name" binding:"required,lte=64"`
+	Category domain.TagCategory `json:"category"`
+}
+
+func (th tagHandler) Create(ctx *gin.Context) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	var req tagReq
+	if err := util.BindModel(ctx, &req); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	tag := &domain.Tag{
+		Name:     req.Name,
+		Category: req.Category,
+	}
+
+	context := ctx.Request.Context()
+
+	if err := th.ts.Store(context, tag, user); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"tag": tag})
+}
@@ -0,0 +1,27 @@
+package taghandler
+
+import (
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+type tagHandler struct {
+	ts domain.TagService
+}
+
+func Initialize(group *gin.RouterGroup, ts domain.TagService, mwh domain.MiddlewareHandler) {
+	taghandler := &tagHandler{
+		ts: ts,
+	}
+
+	tags := group.Group("tags")
+	tags.GET("", taghandler.GetAll)
+	tags.GET(":id", taghandler.GetByID)
+	tags.POST("create", mwh.AuthenticateUser(), taghandler.Create)
+	tags.PUT(":id/update", mwh.AuthenticateUser(), taghandler.UpdateByID)
+	tags.DELETE(":id/delete", mwh.AuthenticateUser(), taghandler.Delete)
+
+	songs := group.Group("songs")
+	songs.GET(":id/tags", taghandler.GetBySong)
+	songs.PUT(":id/tags", mwh.AuthenticateUser(), taghandler.SetSongTags)
+}
@@ -0,0 +1,86 @@
+package taghandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTag := &domain.Tag{Name: "Advent", Category: domain.TagSeason}
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("Store", context.TODO(), mockTag, mockUser).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				arg, ok := args.Get(1).(*domain.Tag)
+				assert.True(t, ok)
+				arg.ID = 1
+			})
+
+		body, err := json.Marshal(gin.H{"name": "Advent", "category": "season"})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodPost, "/tags/create", body)
+		mockTag.ID = 1
+
+		expectedBytes, err := json.Marshal(gin.H{"tag": mockTag})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail missing name", func(t *testing.T) {
+		t.Parallel()
+
+		mockTS := &mocks.MockTagService{}
+
+		body, err := json.Marshal(gin.H{"category": "season"})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodPost, "/tags/create", body)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail Store error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("foo is not a valid tag category")
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("Store", context.TODO(), &domain.Tag{Name: "grace", Category: "foo"}, mockUser).
+			Return(expErr)
+
+		body, err := json.Marshal(gin.H{"name": "grace", "category": "foo"})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodPost, "/tags/create", body)
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockTS.AssertExpectations(t)
+	})
+}
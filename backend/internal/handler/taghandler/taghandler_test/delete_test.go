@@ -0,0 +1,48 @@
+package taghandler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("Remove", context.TODO(), int64(1), mockUser).
+			Return(nil)
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodDelete, "/tags/1/delete", nil)
+
+		assert.Equal(t, http.StatusAccepted, writer.Code)
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail Remove error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("only editors can remove tags")
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("Remove", context.TODO(), int64(1), mockUser).
+			Return(expErr)
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodDelete, "/tags/1/delete", nil)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		mockTS.AssertExpectations(t)
+	})
+}
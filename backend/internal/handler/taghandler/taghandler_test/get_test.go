@@ -0,0 +1,156 @@
+package taghandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/taghandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func prepareAndServe(
+	t *testing.T,
+	mockTS domain.TagService,
+	mockUser *domain.User,
+	method string,
+	path string,
+	body []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	taghandler.Initialize(&router.RouterGroup, mockTS, mockMWH)
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(context.TODO(), method, path, reader)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestGetByID(t *testing.T) {
+	t.Parallel()
+
+	mockTag := &domain.Tag{ID: 1, Name: "grace", Category: domain.TagTheme}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("FetchByID", context.TODO(), mockTag.ID).
+			Return(mockTag, nil)
+
+		writer := prepareAndServe(t, mockTS, nil, http.MethodGet, "/tags/1", nil)
+
+		expectedBytes, err := json.Marshal(gin.H{"tag": mockTag})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		mockTS := &mocks.MockTagService{}
+
+		writer := prepareAndServe(t, mockTS, nil, http.MethodGet, "/tags/a", nil)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchByID error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("FetchByID", context.TODO(), mockTag.ID).
+			Return(nil, expErr)
+
+		writer := prepareAndServe(t, mockTS, nil, http.MethodGet, "/tags/1", nil)
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockTS.AssertExpectations(t)
+	})
+}
+
+func TestGetAll(t *testing.T) {
+	t.Parallel()
+
+	mockTags := &[]domain.Tag{
+		{ID: 2, Name: "Advent", Category: domain.TagSeason},
+		{ID: 1, Name: "grace", Category: domain.TagTheme},
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("FetchAll", context.TODO()).
+			Return(mockTags, nil)
+
+		writer := prepareAndServe(t, mockTS, nil, http.MethodGet, "/tags", nil)
+
+		expectedBytes, err := json.Marshal(gin.H{"tags": mockTags})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchAll error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("FetchAll", context.TODO()).
+			Return(nil, expErr)
+
+		writer := prepareAndServe(t, mockTS, nil, http.MethodGet, "/tags", nil)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		mockTS.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,133 @@
+package taghandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBySong(t *testing.T) {
+	t.Parallel()
+
+	mockTags := []domain.Tag{{ID: 1, Name: "grace", Category: domain.TagTheme}}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("FetchBySong", context.TODO(), int64(3)).
+			Return(mockTags, nil)
+
+		writer := prepareAndServe(t, mockTS, nil, http.MethodGet, "/songs/3/tags", nil)
+
+		expectedBytes, err := json.Marshal(gin.H{"tags": mockTags})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchBySong error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "3")
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("FetchBySong", context.TODO(), int64(3)).
+			Return(nil, expErr)
+
+		writer := prepareAndServe(t, mockTS, nil, http.MethodGet, "/songs/3/tags", nil)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		mockTS.AssertExpectations(t)
+	})
+}
+
+func TestSetSongTags(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+	mockTags := []domain.Tag{
+		{ID: 2, Name: "Advent", Category: domain.TagSeason},
+		{ID: 1, Name: "grace", Category: domain.TagTheme},
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("SetSongTags", context.TODO(), int64(3), []int64{1, 2}, mockUser).
+			Return(mockTags, nil)
+
+		body, err := json.Marshal(gin.H{"tag_ids": []int64{1, 2}})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodPut, "/songs/3/tags", body)
+
+		expectedBytes, err := json.Marshal(gin.H{"tags": mockTags})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Correct clear tags", func(t *testing.T) {
+		t.Parallel()
+
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("SetSongTags", context.TODO(), int64(3), []int64{}, mockUser).
+			Return([]domain.Tag{}, nil)
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodPut, "/songs/3/tags", []byte(`{"tag_ids": []}`))
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.JSONEq(t, `{"tags": []}`, writer.Body.String())
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail missing tag ids", func(t *testing.T) {
+		t.Parallel()
+
+		mockTS := &mocks.MockTagService{}
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodPut, "/songs/3/tags", []byte(`{}`))
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail SetSongTags error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("user is neither an editor nor creator of the song")
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("SetSongTags", context.TODO(), int64(3), []int64{1}, mockUser).
+			Return(nil, expErr)
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodPut, "/songs/3/tags", []byte(`{"tag_ids": [1]}`))
+
+		expectedBytes, err := json.Marshal(gin.H{"error": expErr.Error()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockTS.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,75 @@
+package taghandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateByID(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+	mockTag := &domain.Tag{ID: 1, Name: "communion", Category: domain.TagTheme}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("Update", context.TODO(), mockTag, mockUser).
+			Return(nil)
+
+		body, err := json.Marshal(gin.H{"name": "communion", "category": "theme"})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodPut, "/tags/1/update", body)
+
+		expectedBytes, err := json.Marshal(gin.H{"tag": mockTag})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		mockTS := &mocks.MockTagService{}
+
+		body, err := json.Marshal(gin.H{"name": "communion"})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodPut, "/tags/a/update", body)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail Update error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		mockTS := &mocks.MockTagService{}
+
+		mockTS.
+			On("Update", context.TODO(), mockTag, mockUser).
+			Return(expErr)
+
+		body, err := json.Marshal(gin.H{"name": "communion", "category": "theme"})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockTS, mockUser, http.MethodPut, "/tags/1/update", body)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		mockTS.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,85 @@
+package templatehandler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type copyReq struct {
+	Name     string    `json:"name" binding:"lte=255"`
+	Deadline time.Time `json:"deadline" binding:"required"`
+}
+
+// bindCopy reads the principal, the id in the named parameter and the
+// name and deadline of the setlist to create.
+func bindCopy(ctx *gin.Context, param string) (*domain.User, int64, *domain.Setlist, error) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	fields, err := util.BindNamedParams(ctx, param)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	var req copyReq
+	if err := util.BindModel(ctx, &req); err != nil {
+		return nil, 0, nil, err
+	}
+
+	setlist := &domain.Setlist{
+		Name:     req.Name,
+		Deadline: req.Deadline.Local().Truncate(time.Minute),
+	}
+
+	return user, fields[param], setlist, nil
+}
+
+func respondCopy(ctx *gin.Context, copied *domain.CopiedSetlist) {
+	ctx.JSON(http.StatusCreated, gin.H{
+		"setlist": copied.Setlist,
+		"entries": copied.Entries,
+		"roles":   copied.Roles,
+	})
+}
+
+func (th templateHandler) Clone(ctx *gin.Context) {
+	user, sid, setlist, err := bindCopy(ctx, "id")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	copied, err := th.sts.Clone(ctx.Request.Context(), sid, setlist, user)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	respondCopy(ctx, copied)
+}
+
+func (th templateHandler) FromTemplate(ctx *gin.Context) {
+	user, tid, setlist, err := bindCopy(ctx, "tid")
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	copied, err := th.sts.Instantiate(ctx.Request.Context(), tid, setlist, user)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	respondCopy(ctx, copied)
+}
@@ -0,0 +1,95 @@
+package templatehandler
+
+import (
+	"net/http"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+)
+
+type templateSlotReq struct {
+	Name      string `json:"name" binding:"lte=255"`
+	Type      string `json:"type"`
+	SongID    int64  `json:"song_id"`
+	Title     string `json:"title" binding:"lte=255"`
+	Content   string `json:"content"`
+	Reference string `json:"reference" binding:"lte=255"`
+	Transpose int16  `json:"transpose"`
+	Notes     string `json:"notes"`
+	Duration  uint   `json:"duration"`
+	Rank      int64  `json:"rank" binding:"required"`
+}
+
+type templateRoleReq struct {
+	RoleID     int64 `json:"role_id" binding:"required"`
+	UserRoleID int64 `json:"userrole_id"`
+}
+
+type templateReq struct {
+	Name  string            `json:"name" binding:"required,lte=255"`
+	Notes string            `json:"notes"`
+	Slots []templateSlotReq `json:"slots" binding:"dive"`
+	Roles []templateRoleReq `json:"roles" binding:"dive"`
+}
+
+func (req *templateReq) template(tid int64) *domain.SetlistTemplate {
+	template := &domain.SetlistTemplate{
+		ID:    tid,
+		Name:  req.Name,
+		Notes: req.Notes,
+		Slots: make([]domain.TemplateSlot, len(req.Slots)),
+		Roles: make([]domain.TemplateRole, len(req.Roles)),
+	}
+
+	for idx, slot := range req.Slots {
+		template.Slots[idx] = domain.TemplateSlot{
+			Name:      slot.Name,
+			Type:      domain.SetlistItemType(slot.Type),
+			SongID:    slot.SongID,
+			Title:     slot.Title,
+			Content:   slot.Content,
+			Reference: slot.Reference,
+			Transpose: slot.Transpose,
+			Notes:     slot.Notes,
+			Duration:  slot.Duration,
+			Rank:      slot.Rank,
+		}
+	}
+
+	for idx, role := range req.Roles {
+		template.Roles[idx] = domain.TemplateRole{
+			RoleID:     role.RoleID,
+			UserRoleID: role.UserRoleID,
+		}
+	}
+
+	return template
+}
+
+func (th templateHandler) Create(ctx *gin.Context) {
+	user, err := util.Principal(ctx)
+	if err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	var req templateReq
+	if err := util.BindModel(ctx, &req); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	template := req.template(0)
+	context := ctx.Request.Context()
+
+	if err := th.sts.Store(context, template, user); err != nil {
+		ctx.JSON(domain.Status(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"template": template})
+}
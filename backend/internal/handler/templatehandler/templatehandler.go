@@ -0,0 +1,27 @@
+package templatehandler
+
+import (
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+type templateHandler struct {
+	sts domain.SetlistTemplateService
+}
+
+func Initialize(group *gin.RouterGroup, sts domain.SetlistTemplateService, mwh domain.MiddlewareHandler) {
+	templatehandler := &templateHandler{
+		sts: sts,
+	}
+
+	templates := group.Group("templates")
+	templates.GET("", templatehandler.GetAll)
+	templates.GET(":id", templatehandler.GetByID)
+	templates.POST("create", mwh.AuthenticateUser(), templatehandler.Create)
+	templates.PUT(":id/update", mwh.AuthenticateUser(), templatehandler.UpdateByID)
+	templates.DELETE(":id/delete", mwh.AuthenticateUser(), templatehandler.Delete)
+
+	setlists := group.Group("setlists")
+	setlists.POST(":id/clone", mwh.AuthenticateUser(), templatehandler.Clone)
+	setlists.POST("from-template/:tid", mwh.AuthenticateUser(), templatehandler.FromTemplate)
+}
@@ -0,0 +1,310 @@
+package templatehandler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/handler/templatehandler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func prepareAndServe(
+	t *testing.T,
+	mockSTS domain.SetlistTemplateService,
+	mockUser *domain.User,
+	method string,
+	path string,
+	body []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	writer := httptest.NewRecorder()
+
+	var mockAuthHF gin.HandlerFunc = func(ctx *gin.Context) {
+		ctx.Set("user", mockUser)
+		ctx.Next()
+	}
+
+	mockMWH := &mocks.MockMiddlewareHandler{}
+	mockMWH.
+		On("AuthenticateUser").
+		Return(mockAuthHF)
+
+	templatehandler.Initialize(&router.RouterGroup, mockSTS, mockMWH)
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(context.TODO(), method, path, reader)
+	assert.NoError(t, err)
+
+	router.ServeHTTP(writer, req)
+
+	return writer
+}
+
+func TestGetByID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTemplate := &domain.SetlistTemplate{ID: 1, Name: "Sunday", Slots: []domain.TemplateSlot{{ID: 1, Name: "Opening"}}}
+		mockSTS := &mocks.MockSetlistTemplateService{}
+
+		mockSTS.
+			On("FetchByID", context.TODO(), int64(1)).
+			Return(mockTemplate, nil)
+
+		writer := prepareAndServe(t, mockSTS, nil, http.MethodGet, "/templates/1", nil)
+
+		expectedBytes, err := json.Marshal(gin.H{"template": mockTemplate})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail FetchByID error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		mockSTS := &mocks.MockSetlistTemplateService{}
+
+		mockSTS.
+			On("FetchByID", context.TODO(), int64(1)).
+			Return(nil, expErr)
+
+		writer := prepareAndServe(t, mockSTS, nil, http.MethodGet, "/templates/1", nil)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		mockSTS.AssertExpectations(t)
+	})
+}
+
+func TestGetAll(t *testing.T) {
+	t.Parallel()
+
+	mockTemplates := &[]domain.SetlistTemplate{{ID: 1, Name: "Christmas"}, {ID: 2, Name: "Sunday"}}
+	mockSTS := &mocks.MockSetlistTemplateService{}
+
+	mockSTS.
+		On("FetchAll", context.TODO()).
+		Return(mockTemplates, nil)
+
+	writer := prepareAndServe(t, mockSTS, nil, http.MethodGet, "/templates", nil)
+
+	expectedBytes, err := json.Marshal(gin.H{"templates": mockTemplates})
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, writer.Code)
+	assert.Equal(t, expectedBytes, writer.Body.Bytes())
+	mockSTS.AssertExpectations(t)
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTemplate := &domain.SetlistTemplate{
+			Name:  "Sunday",
+			Slots: []domain.TemplateSlot{{Name: "Opening", Duration: 240, Rank: 1}},
+			Roles: []domain.TemplateRole{{RoleID: 2, UserRoleID: 4}},
+		}
+		mockSTS := &mocks.MockSetlistTemplateService{}
+
+		mockSTS.
+			On("Store", context.TODO(), mockTemplate, mockUser).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				arg, ok := args.Get(1).(*domain.SetlistTemplate)
+				assert.True(t, ok)
+				arg.ID = 1
+			})
+
+		body, err := json.Marshal(gin.H{
+			"name":  "Sunday",
+			"slots": []gin.H{{"name": "Opening", "duration": 240, "rank": 1}},
+			"roles": []gin.H{{"role_id": 2, "userrole_id": 4}},
+		})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSTS, mockUser, http.MethodPost, "/templates/create", body)
+		mockTemplate.ID = 1
+
+		expectedBytes, err := json.Marshal(gin.H{"template": mockTemplate})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail missing slot rank", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockSetlistTemplateService{}
+
+		body, err := json.Marshal(gin.H{"name": "Sunday", "slots": []gin.H{{"name": "Opening"}}})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSTS, mockUser, http.MethodPost, "/templates/create", body)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSTS.AssertExpectations(t)
+	})
+}
+
+func TestUpdateByID(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+	expErr := domain.NewNotAuthorizedErr("user is neither an admin nor creator of the setlist template")
+	mockSTS := &mocks.MockSetlistTemplateService{}
+
+	mockSTS.
+		On("Update", context.TODO(), &domain.SetlistTemplate{
+			ID:    1,
+			Name:  "Sunday",
+			Slots: []domain.TemplateSlot{},
+			Roles: []domain.TemplateRole{},
+		}, mockUser).
+		Return(expErr)
+
+	body, err := json.Marshal(gin.H{"name": "Sunday"})
+	assert.NoError(t, err)
+
+	writer := prepareAndServe(t, mockSTS, mockUser, http.MethodPut, "/templates/1/update", body)
+
+	assert.Equal(t, expErr.Status(), writer.Code)
+	mockSTS.AssertExpectations(t)
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+	mockSTS := &mocks.MockSetlistTemplateService{}
+
+	mockSTS.
+		On("Remove", context.TODO(), int64(1), mockUser).
+		Return(nil)
+
+	writer := prepareAndServe(t, mockSTS, mockUser, http.MethodDelete, "/templates/1/delete", nil)
+
+	assert.Equal(t, http.StatusAccepted, writer.Code)
+	mockSTS.AssertExpectations(t)
+}
+
+func TestCopy(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+	deadline := time.Now().Add(time.Hour * 24).Truncate(time.Minute)
+
+	t.Run("Correct clone", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockSetlistTemplateService{}
+		copied := &domain.CopiedSetlist{
+			Setlist: domain.Setlist{ID: 2, Name: "Easter", CreatorID: 1, Deadline: deadline},
+			Entries: []domain.SetlistEntry{{ID: 3, SetlistID: 2, Type: domain.SongItem, SongID: 1, Rank: 1}},
+			Roles:   []domain.SetlistRole{},
+		}
+
+		mockSTS.
+			On("Clone", context.TODO(), int64(1), &domain.Setlist{Deadline: deadline}, mockUser).
+			Return(copied, nil)
+
+		body, err := json.Marshal(gin.H{"deadline": deadline})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSTS, mockUser, http.MethodPost, "/setlists/1/clone", body)
+
+		expectedBytes, err := json.Marshal(gin.H{
+			"setlist": copied.Setlist,
+			"entries": copied.Entries,
+			"roles":   copied.Roles,
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, writer.Code)
+		assert.Equal(t, expectedBytes, writer.Body.Bytes())
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Correct from template", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockSetlistTemplateService{}
+		copied := &domain.CopiedSetlist{
+			Setlist: domain.Setlist{ID: 2, Name: "Evening", CreatorID: 1, Deadline: deadline},
+			Entries: []domain.SetlistEntry{},
+			Roles:   []domain.SetlistRole{{ID: 1, SetlistID: 2, UserRoleID: 4}},
+		}
+
+		mockSTS.
+			On("Instantiate", context.TODO(), int64(3), &domain.Setlist{Name: "Evening", Deadline: deadline}, mockUser).
+			Return(copied, nil)
+
+		body, err := json.Marshal(gin.H{"name": "Evening", "deadline": deadline})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSTS, mockUser, http.MethodPost, "/setlists/from-template/3", body)
+
+		assert.Equal(t, http.StatusCreated, writer.Code)
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail missing deadline", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTS := &mocks.MockSetlistTemplateService{}
+
+		body, err := json.Marshal(gin.H{"name": "Evening"})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSTS, mockUser, http.MethodPost, "/setlists/1/clone", body)
+
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		mockSTS.AssertExpectations(t)
+	})
+
+	t.Run("Fail Instantiate error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "3")
+		mockSTS := &mocks.MockSetlistTemplateService{}
+
+		mockSTS.
+			On("Instantiate", context.TODO(), int64(3), &domain.Setlist{Deadline: deadline}, mockUser).
+			Return(nil, expErr)
+
+		body, err := json.Marshal(gin.H{"deadline": deadline})
+		assert.NoError(t, err)
+
+		writer := prepareAndServe(t, mockSTS, mockUser, http.MethodPost, "/setlists/from-template/3", body)
+
+		assert.Equal(t, expErr.Status(), writer.Code)
+		mockSTS.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+type gormAttachmentRepository struct {
+	db *gorm.DB
+}
+
+//revive:disable:unexported-return
+func NewGormAttachmentRepository(db *gorm.DB) *gormAttachmentRepository {
+	return &gormAttachmentRepository{
+		db: db,
+	}
+}
+
+func (ar gormAttachmentRepository) GetByID(ctx context.Context, id int64) (*domain.Attachment, error) {
+	var attachment domain.Attachment
+	res := ar.db.First(&attachment, id)
+
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(id))
+		default:
+			return nil, domain.NewInternalErr()
+		}
+	}
+
+	return &attachment, nil
+}
+
+func (ar gormAttachmentRepository) GetByOwner(
+	ctx context.Context,
+	owner domain.AttachmentOwner,
+	ownerID int64,
+) ([]domain.Attachment, error) {
+	var attachments []domain.Attachment
+	res := ar.db.
+		Where("owner_type = ? AND owner_id = ?", owner, ownerID).
+		Order("id").
+		Find(&attachments)
+
+	if err := res.Error; err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return attachments, nil
+}
+
+func (ar gormAttachmentRepository) Create(ctx context.Context, attachment *domain.Attachment) error {
+	res := ar.db.Create(attachment)
+
+	if err := res.Error; err != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
+
+func (ar gormAttachmentRepository) Delete(ctx context.Context, id int64) error {
+	res := ar.db.Delete(&domain.Attachment{ID: id})
+
+	if err := res.Error; err != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/go-sql-driver/mysql"
@@ -90,22 +91,26 @@ func (br gormBundleRepository) Create(ctx context.Context, bundle *domain.Bundle
 	return nil
 }
 
-func (br gormBundleRepository) Delete(ctx context.Context, bid int64) error {
+// Delete removes the bundle, a bundle with a version is only removed if it was not changed since.
+func (br gormBundleRepository) Delete(ctx context.Context, bid int64, version time.Time) error {
 	bundle := domain.Bundle{ID: bid}
-	res := br.db.Delete(&bundle)
+	res := atVersion(br.db, version).Delete(&bundle)
 
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
 	}
 
-	return nil
+	return checkVersion(res, version)
 }
 
+// Update saves every column of the bundle, a bundle with an UpdatedAt is only saved if it was not changed since.
 func (br gormBundleRepository) Update(ctx context.Context, bundle *domain.Bundle) error {
-	res := br.db.Save(bundle)
+	version := bundle.UpdatedAt
+
+	res := atVersion(br.db.Model(bundle), version).Select("*").Omit("deleted_at").Updates(bundle)
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
 	}
 
-	return nil
+	return checkVersion(res, version)
 }
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormCalendarTokenRepository struct {
+	db *gorm.DB
+}
+
+//revive:disable:unexported-return
+func NewGormCalendarTokenRepository(db *gorm.DB) *gormCalendarTokenRepository {
+	return &gormCalendarTokenRepository{
+		db: db,
+	}
+}
+
+func (ctr gormCalendarTokenRepository) GetByUser(ctx context.Context, uid int64) (*domain.CalendarToken, error) {
+	var token domain.CalendarToken
+	res := ctr.db.Where("user_id = ?", uid).First(&token)
+
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.NewRecordNotFoundErr("user_id", fmt.Sprint(uid))
+		default:
+			return nil, domain.NewInternalErr()
+		}
+	}
+
+	return &token, nil
+}
+
+func (ctr gormCalendarTokenRepository) GetByToken(ctx context.Context, token string) (*domain.CalendarToken, error) {
+	var calendarToken domain.CalendarToken
+	res := ctr.db.Where("token = ?", token).First(&calendarToken)
+
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.NewObjectNotFoundErr("calendar")
+		default:
+			return nil, domain.NewInternalErr()
+		}
+	}
+
+	return &calendarToken, nil
+}
+
+// Save creates the token of the user or replaces the token the user already has.
+func (ctr gormCalendarTokenRepository) Save(ctx context.Context, token *domain.CalendarToken) error {
+	res := ctr.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"token", "created_at"}),
+	}).Create(token)
+
+	if res.Error != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
+
+func (ctr gormCalendarTokenRepository) DeleteByUser(ctx context.Context, uid int64) error {
+	res := ctr.db.Where("user_id = ?", uid).Delete(&domain.CalendarToken{})
+
+	if res.Error != nil {
+		return domain.NewInternalErr()
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.NewRecordNotFoundErr("user_id", fmt.Sprint(uid))
+	}
+
+	return nil
+}
@@ -22,7 +22,7 @@ func NewGormRoleRepository(db *gorm.DB) *gormRoleRepository {
 }
 
 func (rr gormRoleRepository) Create(ctx context.Context, role *domain.Role) error {
-	res := rr.db.Create(role)
+	res := conn(ctx, rr.db).Create(role)
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
 
@@ -42,7 +42,7 @@ func (rr gormRoleRepository) Create(ctx context.Context, role *domain.Role) erro
 func (rr gormRoleRepository) GetByID(ctx context.Context, rid int64) (*domain.Role, error) {
 	var role domain.Role
 
-	res := rr.db.First(&role, rid)
+	res := conn(ctx, rr.db).First(&role, rid)
 	if err := res.Error; err != nil {
 		switch {
 		case errors.Is(gorm.ErrRecordNotFound, err):
@@ -58,7 +58,7 @@ func (rr gormRoleRepository) GetByID(ctx context.Context, rid int64) (*domain.Ro
 func (rr gormRoleRepository) GetAll(ctx context.Context) (*[]domain.Role, error) {
 	var roles []domain.Role
 
-	res := rr.db.Find(&roles)
+	res := conn(ctx, rr.db).Find(&roles)
 	if err := res.Error; err != nil {
 		return nil, domain.NewInternalErr()
 	}
@@ -67,7 +67,7 @@ func (rr gormRoleRepository) GetAll(ctx context.Context) (*[]domain.Role, error)
 }
 
 func (rr gormRoleRepository) Update(ctx context.Context, role *domain.Role) error {
-	res := rr.db.Updates(role)
+	res := conn(ctx, rr.db).Updates(role)
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
 
@@ -85,7 +85,7 @@ func (rr gormRoleRepository) Update(ctx context.Context, role *domain.Role) erro
 }
 
 func (rr gormRoleRepository) Delete(ctx context.Context, rid int64) error {
-	res := rr.db.Delete(&domain.Role{}, rid)
+	res := conn(ctx, rr.db).Delete(&domain.Role{}, rid)
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/go-sql-driver/mysql"
@@ -23,7 +24,7 @@ func NewGormSetlistEntryRepository(db *gorm.DB) *gormSetlistEntryRepository {
 
 func (ser gormSetlistEntryRepository) GetByID(ctx context.Context, sid int64) (*domain.SetlistEntry, error) {
 	var setlistEntry domain.SetlistEntry
-	res := ser.db.
+	res := conn(ctx, ser.db).
 		First(&setlistEntry, sid)
 
 	if err := res.Error; err != nil {
@@ -50,7 +51,10 @@ func (ser gormSetlistEntryRepository) GetBySetlist(ctx context.Context, setlists
 		setlistIDs[idx] = setlist.ID
 	}
 
-	res := ser.db.Find(&setlistEntries, setlistIDs)
+	res := conn(ctx, ser.db).
+		Where("setlist_id IN ?", setlistIDs).
+		Order("setlist_entries.setlist_id, setlist_entries.rank, setlist_entries.id").
+		Find(&setlistEntries)
 
 	if err := res.Error; err != nil {
 		return nil, domain.NewInternalErr()
@@ -61,7 +65,9 @@ func (ser gormSetlistEntryRepository) GetBySetlist(ctx context.Context, setlists
 
 func (ser gormSetlistEntryRepository) GetAll(ctx context.Context) (*[]domain.SetlistEntry, error) {
 	var setlists []domain.SetlistEntry
-	res := ser.db.Find(&setlists)
+	res := conn(ctx, ser.db).
+		Order("setlist_entries.setlist_id, setlist_entries.rank, setlist_entries.id").
+		Find(&setlists)
 
 	if err := res.Error; err != nil {
 		return nil, domain.NewInternalErr()
@@ -71,7 +77,7 @@ func (ser gormSetlistEntryRepository) GetAll(ctx context.Context) (*[]domain.Set
 }
 
 func (ser gormSetlistEntryRepository) Create(ctx context.Context, setlistEntry *domain.SetlistEntry) error {
-	res := ser.db.Create(setlistEntry)
+	res := conn(ctx, ser.db).Create(setlistEntry)
 
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
@@ -92,7 +98,7 @@ func (ser gormSetlistEntryRepository) Create(ctx context.Context, setlistEntry *
 }
 
 func (ser gormSetlistEntryRepository) CreateBatch(ctx context.Context, setlistEntries *[]domain.SetlistEntry) error {
-	res := ser.db.Create(setlistEntries)
+	res := conn(ctx, ser.db).Create(setlistEntries)
 
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
@@ -114,7 +120,7 @@ func (ser gormSetlistEntryRepository) CreateBatch(ctx context.Context, setlistEn
 
 func (ser gormSetlistEntryRepository) Delete(ctx context.Context, sid int64) error {
 	setlistEntry := domain.SetlistEntry{ID: sid}
-	res := ser.db.Delete(&setlistEntry)
+	res := conn(ctx, ser.db).Delete(&setlistEntry)
 
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
@@ -129,7 +135,7 @@ func (ser gormSetlistEntryRepository) DeleteBatch(ctx context.Context, sids []in
 		setlistEntries[idx].ID = val
 	}
 
-	res := ser.db.Delete(&setlistEntries)
+	res := conn(ctx, ser.db).Delete(&setlistEntries)
 
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
@@ -139,7 +145,7 @@ func (ser gormSetlistEntryRepository) DeleteBatch(ctx context.Context, sids []in
 }
 
 func (ser gormSetlistEntryRepository) Update(ctx context.Context, setlistEntry *domain.SetlistEntry) error {
-	res := ser.db.Updates(setlistEntry)
+	res := conn(ctx, ser.db).Updates(setlistEntry)
 
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
@@ -159,10 +165,25 @@ func (ser gormSetlistEntryRepository) Update(ctx context.Context, setlistEntry *
 	return nil
 }
 
+// UpdateBatch saves the entries in a single transaction. The columns are selected
+// so empty values such as a zero duration are saved as well.
 func (ser gormSetlistEntryRepository) UpdateBatch(ctx context.Context, setlistEntries *[]domain.SetlistEntry) error {
-	res := ser.db.Updates(setlistEntries)
+	err := conn(ctx, ser.db).Transaction(func(tx *gorm.DB) error {
+		for idx := range *setlistEntries {
+			entry := &(*setlistEntries)[idx]
+
+			err := tx.Model(entry).
+				Select("type", "song_id", "title", "content", "reference", "transpose",
+					"notes", "arrangement", "languages", "duration", "rank").
+				Updates(entry).Error
+			if err != nil {
+				return err
+			}
+		}
 
-	if err := res.Error; err != nil {
+		return nil
+	})
+	if err != nil {
 		var mysqlErr *mysql.MySQLError
 
 		if errors.As(err, &mysqlErr) {
@@ -179,3 +200,37 @@ func (ser gormSetlistEntryRepository) UpdateBatch(ctx context.Context, setlistEn
 
 	return nil
 }
+
+// UpdateRanks saves the rank of every entry in a single transaction
+// and marks their setlist as updated, which changes its version.
+func (ser gormSetlistEntryRepository) UpdateRanks(ctx context.Context, setlistEntries *[]domain.SetlistEntry) error {
+	err := conn(ctx, ser.db).Transaction(func(tx *gorm.DB) error {
+		for _, entry := range *setlistEntries {
+			res := tx.Model(&domain.SetlistEntry{ID: entry.ID}).Update("rank", entry.Rank)
+			if err := res.Error; err != nil {
+				return err
+			}
+
+			if res.RowsAffected == 0 {
+				return gorm.ErrRecordNotFound
+			}
+		}
+
+		if len(*setlistEntries) == 0 {
+			return nil
+		}
+
+		setlist := &domain.Setlist{ID: (*setlistEntries)[0].SetlistID}
+
+		return tx.Model(setlist).Update("updated_at", time.Now()).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.NewObjectNotFoundErr("setlist entry")
+		}
+
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
@@ -24,7 +24,7 @@ func NewGormSetlistRepository(db *gorm.DB) *gormSetlistRepository {
 
 func (slr gormSetlistRepository) GetByID(ctx context.Context, sid int64) (*domain.Setlist, error) {
 	var setlist domain.Setlist
-	res := slr.db.Order("setlist_id, deadline asc").First(&setlist, sid)
+	res := conn(ctx, slr.db).Order("setlist_id, deadline asc").First(&setlist, sid)
 
 	if err := res.Error; err != nil {
 		switch {
@@ -38,17 +38,23 @@ func (slr gormSetlistRepository) GetByID(ctx context.Context, sid int64) (*domai
 	return &setlist, nil
 }
 
+// GetByIDs returns every setlist with one of the given ids, ids may repeat.
+// It returns a not found error unless all of the setlists exist.
 func (slr gormSetlistRepository) GetByIDs(ctx context.Context, sids []int64) (*[]domain.Setlist, error) {
 	var setlists []domain.Setlist
-	res := slr.db.First(&setlists, sids)
+	res := conn(ctx, slr.db).Find(&setlists, sids)
 
 	if err := res.Error; err != nil {
-		switch {
-		case errors.Is(err, gorm.ErrRecordNotFound):
-			return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(sids))
-		default:
-			return nil, domain.NewInternalErr()
-		}
+		return nil, domain.NewInternalErr()
+	}
+
+	unique := make(map[int64]bool, len(sids))
+	for _, sid := range sids {
+		unique[sid] = true
+	}
+
+	if len(setlists) == 0 || len(setlists) != len(unique) {
+		return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(sids))
 	}
 
 	return &setlists, nil
@@ -56,7 +62,7 @@ func (slr gormSetlistRepository) GetByIDs(ctx context.Context, sids []int64) (*[
 
 func (slr gormSetlistRepository) GetAll(ctx context.Context) (*[]domain.Setlist, error) {
 	var setlists []domain.Setlist
-	res := slr.db.Find(&setlists)
+	res := conn(ctx, slr.db).Find(&setlists)
 
 	if err := res.Error; err != nil {
 		return nil, domain.NewInternalErr()
@@ -71,11 +77,11 @@ func (slr gormSetlistRepository) Get(ctx context.Context, fromTime time.Time, to
 	var result *gorm.DB
 
 	if fromTime.IsZero() || toTime.IsZero() {
-		result = slr.db.
+		result = conn(ctx, slr.db).
 			Where("deadline BETWEEN ? AND ?", fromTime, toTime).
 			Find(&setlists)
 	} else {
-		result = slr.db.
+		result = conn(ctx, slr.db).
 			Find(&setlists)
 	}
 
@@ -88,7 +94,7 @@ func (slr gormSetlistRepository) Get(ctx context.Context, fromTime time.Time, to
 
 func (slr gormSetlistRepository) GetByTimeframe(ctx context.Context, from time.Time, to time.Time) (*[]domain.Setlist, error) {
 	var setlists []domain.Setlist
-	res := slr.db.
+	res := conn(ctx, slr.db).
 		Where("deadline BETWEEN ? AND ?", from, to).
 		Find(&setlists)
 
@@ -100,7 +106,7 @@ func (slr gormSetlistRepository) GetByTimeframe(ctx context.Context, from time.T
 }
 
 func (slr gormSetlistRepository) Create(ctx context.Context, setlist *domain.Setlist) error {
-	res := slr.db.Create(setlist)
+	res := conn(ctx, slr.db).Create(setlist)
 
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
@@ -120,19 +126,23 @@ func (slr gormSetlistRepository) Create(ctx context.Context, setlist *domain.Set
 	return nil
 }
 
-func (slr gormSetlistRepository) Delete(ctx context.Context, sid int64) error {
+// Delete removes the setlist, a setlist with a version is only removed if it was not changed since.
+func (slr gormSetlistRepository) Delete(ctx context.Context, sid int64, version time.Time) error {
 	setlist := domain.Setlist{ID: sid}
-	res := slr.db.Delete(&setlist)
+	res := atVersion(conn(ctx, slr.db), version).Delete(&setlist)
 
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
 	}
 
-	return nil
+	return checkVersion(res, version)
 }
 
+// Update saves the non-empty fields of the setlist, a setlist with an UpdatedAt
+// is only saved if it was not changed since.
 func (slr gormSetlistRepository) Update(ctx context.Context, setlist *domain.Setlist) (*domain.Setlist, error) {
-	res := slr.db.Updates(setlist)
+	version := setlist.UpdatedAt
+	res := atVersion(conn(ctx, slr.db).Model(setlist), version).Updates(setlist)
 
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
@@ -149,9 +159,13 @@ func (slr gormSetlistRepository) Update(ctx context.Context, setlist *domain.Set
 		return nil, domain.NewInternalErr()
 	}
 
+	if err := checkVersion(res, version); err != nil {
+		return nil, err
+	}
+
 	var updatedSetlist domain.Setlist
 
-	res = slr.db.First(&updatedSetlist, setlist.ID)
+	res = conn(ctx, slr.db).First(&updatedSetlist, setlist.ID)
 	if err := res.Error; err != nil {
 		return nil, domain.NewInternalErr()
 	}
@@ -21,7 +21,7 @@ func NewGormSetlistRoleRepository(db *gorm.DB) *gormSetlistRoleRepository {
 }
 
 func (gsrs gormSetlistRoleRepository) Create(ctx context.Context, setlistRoles *[]domain.SetlistRole) error {
-	res := gsrs.db.Create(setlistRoles)
+	res := conn(ctx, gsrs.db).Create(setlistRoles)
 
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
@@ -46,11 +46,11 @@ func (gsrs gormSetlistRoleRepository) Get(ctx context.Context, setlistIDs []int6
 
 	conditions := make(map[string][]int64, 0)
 
-	if len(setlistIDs) <= 0 {
+	if len(setlistIDs) > 0 {
 		conditions["setlist_id"] = setlistIDs
 	}
 
-	results := gsrs.db.Where(conditions).Find(&retrievedSetlistRoles)
+	results := conn(ctx, gsrs.db).Where(conditions).Find(&retrievedSetlistRoles)
 
 	if err := results.Error; err != nil {
 		return nil, nil
@@ -59,12 +59,24 @@ func (gsrs gormSetlistRoleRepository) Get(ctx context.Context, setlistIDs []int6
 	return &retrievedSetlistRoles, nil
 }
 
+func (gsrs gormSetlistRoleRepository) GetByUserRoles(ctx context.Context, userRoleIDs []int64) (*[]domain.SetlistRole, error) {
+	var retrievedSetlistRoles []domain.SetlistRole
+
+	results := conn(ctx, gsrs.db).Where("user_role_id IN ?", userRoleIDs).Find(&retrievedSetlistRoles)
+
+	if err := results.Error; err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return &retrievedSetlistRoles, nil
+}
+
 func (gsrs gormSetlistRoleRepository) Update(ctx context.Context, setlistRoles *[]domain.SetlistRole) error {
 	if setlistRoles == nil {
 		return domain.NewInternalErr()
 	}
 
-	res := gsrs.db.Updates(setlistRoles)
+	res := conn(ctx, gsrs.db).Updates(setlistRoles)
 
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
@@ -90,7 +102,7 @@ func (gsrs gormSetlistRoleRepository) Delete(ctx context.Context, setlistRoleIDs
 		setlistEntries[idx].ID = val
 	}
 
-	res := gsrs.db.Delete(&setlistEntries)
+	res := conn(ctx, gsrs.db).Delete(&setlistEntries)
 
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+type gormSetlistSeriesRepository struct {
+	db *gorm.DB
+}
+
+//revive:disable:unexported-return
+func NewGormSetlistSeriesRepository(db *gorm.DB) *gormSetlistSeriesRepository {
+	return &gormSetlistSeriesRepository{
+		db: db,
+	}
+}
+
+func seriesErr(err error) error {
+	var mysqlErr *mysql.MySQLError
+
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == domain.MySQLUniqueErr {
+		return domain.NewBadRequestErr(mysqlErr.Message)
+	}
+
+	return domain.NewInternalErr()
+}
+
+func (ssr gormSetlistSeriesRepository) GetByID(ctx context.Context, sid int64) (*domain.SetlistSeries, error) {
+	var series domain.SetlistSeries
+	res := ssr.db.First(&series, sid)
+
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(sid))
+		default:
+			return nil, domain.NewInternalErr()
+		}
+	}
+
+	return &series, nil
+}
+
+func (ssr gormSetlistSeriesRepository) GetAll(ctx context.Context) (*[]domain.SetlistSeries, error) {
+	var series []domain.SetlistSeries
+	res := ssr.db.Order("name").Find(&series)
+
+	if err := res.Error; err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return &series, nil
+}
+
+func (ssr gormSetlistSeriesRepository) Create(ctx context.Context, series *domain.SetlistSeries) error {
+	if err := ssr.db.Create(series).Error; err != nil {
+		return seriesErr(err)
+	}
+
+	return nil
+}
+
+func (ssr gormSetlistSeriesRepository) Update(ctx context.Context, series *domain.SetlistSeries) error {
+	res := ssr.db.
+		Model(series).
+		Select("name", "creator_id", "rule", "timezone", "start", "template_id", "updated_at").
+		Updates(series)
+
+	if err := res.Error; err != nil {
+		return seriesErr(err)
+	}
+
+	return nil
+}
+
+// Delete removes the series and its occurrences, the setlists created for it are kept.
+func (ssr gormSetlistSeriesRepository) Delete(ctx context.Context, sid int64) error {
+	err := ssr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("setlist_series_id = ?", sid).Delete(&domain.SeriesOccurrence{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&domain.SetlistSeries{ID: sid}).Error
+	})
+	if err != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
+
+func (ssr gormSetlistSeriesRepository) GetOccurrences(
+	ctx context.Context,
+	sid int64,
+	from time.Time,
+	to time.Time,
+) (*[]domain.SeriesOccurrence, error) {
+	var occurrences []domain.SeriesOccurrence
+	res := ssr.db.
+		Where("setlist_series_id = ? AND date BETWEEN ? AND ?", sid, from, to).
+		Order("date").
+		Find(&occurrences)
+
+	if err := res.Error; err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return &occurrences, nil
+}
+
+func (ssr gormSetlistSeriesRepository) CreateOccurrence(ctx context.Context, occurrence *domain.SeriesOccurrence) error {
+	if err := conn(ctx, ssr.db).Create(occurrence).Error; err != nil {
+		return seriesErr(err)
+	}
+
+	return nil
+}
+
+func (ssr gormSetlistSeriesRepository) DeleteOccurrence(ctx context.Context, sid int64, date time.Time) error {
+	res := ssr.db.Where("setlist_series_id = ? AND date = ?", sid, date).Delete(&domain.SeriesOccurrence{})
+
+	if err := res.Error; err != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+type gormSetlistTemplateRepository struct {
+	db *gorm.DB
+}
+
+//revive:disable:unexported-return
+func NewGormSetlistTemplateRepository(db *gorm.DB) *gormSetlistTemplateRepository {
+	return &gormSetlistTemplateRepository{
+		db: db,
+	}
+}
+
+func (str gormSetlistTemplateRepository) preload() *gorm.DB {
+	return str.db.
+		Preload("Slots", func(db *gorm.DB) *gorm.DB {
+			return db.Order("template_slots.rank, template_slots.id")
+		}).
+		Preload("Roles")
+}
+
+func templateErr(err error) error {
+	var mysqlErr *mysql.MySQLError
+
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == domain.MySQLUniqueErr {
+		return domain.NewBadRequestErr(mysqlErr.Message)
+	}
+
+	return domain.NewInternalErr()
+}
+
+func (str gormSetlistTemplateRepository) GetByID(ctx context.Context, tid int64) (*domain.SetlistTemplate, error) {
+	var template domain.SetlistTemplate
+	res := str.preload().First(&template, tid)
+
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(tid))
+		default:
+			return nil, domain.NewInternalErr()
+		}
+	}
+
+	return &template, nil
+}
+
+func (str gormSetlistTemplateRepository) GetAll(ctx context.Context) (*[]domain.SetlistTemplate, error) {
+	var templates []domain.SetlistTemplate
+	res := str.preload().Order("name").Find(&templates)
+
+	if err := res.Error; err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return &templates, nil
+}
+
+func (str gormSetlistTemplateRepository) Create(ctx context.Context, template *domain.SetlistTemplate) error {
+	if err := str.db.Create(template).Error; err != nil {
+		return templateErr(err)
+	}
+
+	return nil
+}
+
+// Update saves the template and replaces its slots and roles in a single transaction.
+func (str gormSetlistTemplateRepository) Update(ctx context.Context, template *domain.SetlistTemplate) error {
+	err := str.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(template).Select("name", "creator_id", "notes", "updated_at").Updates(template).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("setlist_template_id = ?", template.ID).Delete(&domain.TemplateSlot{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("setlist_template_id = ?", template.ID).Delete(&domain.TemplateRole{}).Error; err != nil {
+			return err
+		}
+
+		for idx := range template.Slots {
+			template.Slots[idx].ID = 0
+			template.Slots[idx].SetlistTemplateID = template.ID
+		}
+
+		for idx := range template.Roles {
+			template.Roles[idx].ID = 0
+			template.Roles[idx].SetlistTemplateID = template.ID
+		}
+
+		if len(template.Slots) > 0 {
+			if err := tx.Create(&template.Slots).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(template.Roles) > 0 {
+			if err := tx.Create(&template.Roles).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return templateErr(err)
+	}
+
+	return nil
+}
+
+func (str gormSetlistTemplateRepository) Delete(ctx context.Context, tid int64) error {
+	err := str.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("setlist_template_id = ?", tid).Delete(&domain.TemplateSlot{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("setlist_template_id = ?", tid).Delete(&domain.TemplateRole{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&domain.SetlistTemplate{ID: tid}).Error
+	})
+	if err != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
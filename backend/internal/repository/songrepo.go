@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
 )
@@ -48,11 +51,52 @@ func (sr gormSongRepository) GetAll(ctx context.Context) (*[]domain.Song, error)
 	return &songs, nil
 }
 
-func (sr gormSongRepository) Get(ctx context.Context, options *domain.SongFilterOptions) ([]domain.Song, error) {
+// songLastPlayed selects the latest deadline of a past setlist containing the song, drafts
+// were never played, like in the stats. Songs that were never played sort as if played at neverPlayed.
+const songLastPlayed = "(SELECT MAX(setlists.deadline) FROM setlist_entries " +
+	"JOIN setlists ON setlists.id = setlist_entries.setlist_id " +
+	"WHERE setlist_entries.song_id = songs.id AND setlists.deadline <= NOW() " +
+	"AND setlists.status <> '" + string(domain.SetlistDraft) + "')"
+
+var neverPlayed = time.Date(1000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func songSortColumn(sort domain.SongSort) string {
+	switch sort {
+	case domain.SongSortTitle:
+		return "songs.title"
+	case domain.SongSortBpm:
+		return "songs.bpm"
+	case domain.SongSortUpdatedAt:
+		return "songs.updated_at"
+	case domain.SongSortLastPlayed:
+		return "COALESCE(" + songLastPlayed + ", CAST('1000-01-01' AS DATETIME))"
+	default:
+		return "songs.id"
+	}
+}
+
+func songCursorValue(sort domain.SongSort, value string) (any, error) {
+	switch sort {
+	case domain.SongSortBpm:
+		return strconv.ParseUint(value, 10, 32)
+	case domain.SongSortUpdatedAt:
+		return time.Parse(time.RFC3339Nano, value)
+	case domain.SongSortLastPlayed:
+		if value == "" {
+			return neverPlayed, nil
+		}
+
+		return time.Parse(time.RFC3339Nano, value)
+	default:
+		return value, nil
+	}
+}
+
+func (sr gormSongRepository) filter(options *domain.SongFilterOptions) (*gorm.DB, error) {
 	transaction := sr.db.Model(&domain.Song{})
 
 	if len(options.IDs) > 0 {
-		transaction = transaction.Where("id IN ?", options.IDs)
+		transaction = transaction.Where("songs.id IN ?", options.IDs)
 	}
 
 	if len(options.BIDs) > 0 {
@@ -63,18 +107,92 @@ func (sr gormSongRepository) Get(ctx context.Context, options *domain.SongFilter
 		transaction = transaction.Where("creator_id IN ?", options.CIDs)
 	}
 
+	if len(options.TIDs) > 0 {
+		transaction = transaction.Where(
+			"songs.id IN (?)",
+			sr.db.Model(&domain.SongTag{}).Select("song_id").Where("tag_id IN ?", options.TIDs),
+		)
+	}
+
 	if len(options.Keys) > 0 {
 		transaction = transaction.Where("song_key IN ?", options.Keys)
 	}
 
+	if options.CompatibleKey != "" {
+		keys, err := util.CompatibleKeys(options.CompatibleKey)
+		if err != nil {
+			return nil, domain.NewBadRequestErr(err.Error())
+		}
+
+		transaction = transaction.Where("song_key IN ?", keys)
+	}
+
 	if len(options.Bpms) > 0 {
 		transaction = transaction.Where("bpm IN ?", options.Bpms)
 	}
 
+	if options.MinBpm > 0 {
+		transaction = transaction.Where("bpm >= ?", options.MinBpm)
+	}
+
+	if options.MaxBpm > 0 {
+		transaction = transaction.Where("bpm <= ?", options.MaxBpm)
+	}
+
+	if !options.UpdatedSince.IsZero() {
+		transaction = transaction.Where("songs.updated_at >= ?", options.UpdatedSince)
+	}
+
 	if options.Title != "" {
 		transaction = transaction.Where("title LIKE ?", options.Title+"%")
 	}
 
+	return transaction, nil
+}
+
+func (sr gormSongRepository) Get(ctx context.Context, options *domain.SongFilterOptions) ([]domain.Song, error) {
+	transaction, err := sr.filter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	column := songSortColumn(options.Sort)
+	direction, comparison := "ASC", ">"
+
+	if options.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if options.Sort == domain.SongSortLastPlayed {
+		transaction = transaction.Select("songs.*, " + songLastPlayed + " AS last_played")
+	}
+
+	if options.After != nil {
+		if options.Sort == domain.SongSortID {
+			transaction = transaction.Where("songs.id "+comparison+" ?", options.After.ID)
+		} else {
+			value, err := songCursorValue(options.Sort, options.After.Value)
+			if err != nil {
+				return nil, domain.NewBadRequestErr("invalid cursor")
+			}
+
+			transaction = transaction.Where(
+				fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND songs.id %[2]s ?))", column, comparison),
+				value, value, options.After.ID,
+			)
+		}
+	}
+
+	if options.Sort != domain.SongSortID {
+		transaction = transaction.Order(column + " " + direction)
+	}
+
+	transaction = transaction.Order("songs.id " + direction)
+
+	if options.Limit > 0 {
+		transaction = transaction.Limit(options.Limit)
+	}
+
 	var songs []domain.Song
 
 	res := transaction.Find(&songs)
@@ -86,6 +204,47 @@ func (sr gormSongRepository) Get(ctx context.Context, options *domain.SongFilter
 	return songs, nil
 }
 
+func (sr gormSongRepository) Count(ctx context.Context, options *domain.SongFilterOptions) (int64, error) {
+	transaction, err := sr.filter(options)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+
+	res := transaction.Count(&total)
+
+	if res.Error != nil {
+		return 0, domain.NewInternalErr()
+	}
+
+	return total, nil
+}
+
+func (sr gormSongRepository) CountTags(ctx context.Context, options *domain.SongFilterOptions) ([]domain.TagCount, error) {
+	songs, err := sr.filter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []domain.TagCount
+
+	res := sr.db.
+		Table("song_tags").
+		Select("tags.id AS tag_id, tags.name, COUNT(*) AS count").
+		Joins("JOIN tags ON tags.id = song_tags.tag_id").
+		Where("song_tags.song_id IN (?)", songs.Select("songs.id")).
+		Group("tags.id, tags.name").
+		Order("count DESC, tags.name").
+		Scan(&counts)
+
+	if res.Error != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return counts, nil
+}
+
 func (sr gormSongRepository) Create(ctx context.Context, song *domain.Song) error {
 	res := sr.db.Create(song)
 
@@ -107,19 +266,23 @@ func (sr gormSongRepository) Create(ctx context.Context, song *domain.Song) erro
 	return nil
 }
 
-func (sr gormSongRepository) Delete(ctx context.Context, sid int64) error {
+// Delete removes the song, a song with a version is only removed if it was not changed since.
+func (sr gormSongRepository) Delete(ctx context.Context, sid int64, version time.Time) error {
 	song := domain.Song{ID: sid}
-	res := sr.db.Delete(&song)
+	res := atVersion(sr.db, version).Delete(&song)
 
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
 	}
 
-	return nil
+	return checkVersion(res, version)
 }
 
+// Update saves every column of the song, including the empty ones, so
+// a field can be cleared. A song with an UpdatedAt is only saved if it was not changed since.
 func (sr gormSongRepository) Update(ctx context.Context, song *domain.Song) error {
-	res := sr.db.Updates(song)
+	version := song.UpdatedAt
+	res := atVersion(sr.db.Model(song), version).Select("*").Omit("deleted_at").Updates(song)
 
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
@@ -136,5 +299,44 @@ func (sr gormSongRepository) Update(ctx context.Context, song *domain.Song) erro
 		return domain.NewInternalErr()
 	}
 
+	return checkVersion(res, version)
+}
+
+// Merge moves the setlist entries, tags and attachments of the duplicates
+// to the song and soft deletes the duplicates in a single transaction.
+func (sr gormSongRepository) Merge(ctx context.Context, sid int64, duplicateIDs []int64) error {
+	err := sr.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&domain.SetlistEntry{}).
+			Where("song_id IN ?", duplicateIDs).
+			Update("song_id", sid).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(
+			"INSERT IGNORE INTO song_tags (song_id, tag_id) SELECT ?, tag_id FROM song_tags WHERE song_id IN ?",
+			sid, duplicateIDs,
+		).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("song_id IN ?", duplicateIDs).Delete(&domain.SongTag{}).Error; err != nil {
+			return err
+		}
+
+		err = tx.Model(&domain.Attachment{}).
+			Where("owner_type = ? AND owner_id IN ?", domain.SongAttachment, duplicateIDs).
+			Update("owner_id", sid).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(&domain.Song{}, duplicateIDs).Error
+	})
+	if err != nil {
+		return domain.NewInternalErr()
+	}
+
 	return nil
 }
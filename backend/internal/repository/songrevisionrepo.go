@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+type gormSongRevisionRepository struct {
+	db *gorm.DB
+}
+
+//revive:disable:unexported-return
+func NewGormSongRevisionRepository(db *gorm.DB) *gormSongRevisionRepository {
+	return &gormSongRevisionRepository{
+		db: db,
+	}
+}
+
+func (srr gormSongRevisionRepository) GetByID(ctx context.Context, id int64) (*domain.SongRevision, error) {
+	var revision domain.SongRevision
+	res := srr.db.First(&revision, id)
+
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(id))
+		default:
+			return nil, domain.NewInternalErr()
+		}
+	}
+
+	return &revision, nil
+}
+
+func (srr gormSongRevisionRepository) GetBySong(ctx context.Context, sid int64) ([]domain.SongRevision, error) {
+	var revisions []domain.SongRevision
+	res := srr.db.Where("song_id = ?", sid).Order("id").Find(&revisions)
+
+	if res.Error != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return revisions, nil
+}
+
+func (srr gormSongRevisionRepository) Create(ctx context.Context, revision *domain.SongRevision) error {
+	res := srr.db.Create(revision)
+
+	if res.Error != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
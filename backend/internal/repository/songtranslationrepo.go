@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormSongTranslationRepository struct {
+	db *gorm.DB
+}
+
+//revive:disable:unexported-return
+func NewGormSongTranslationRepository(db *gorm.DB) *gormSongTranslationRepository {
+	return &gormSongTranslationRepository{
+		db: db,
+	}
+}
+
+func (str gormSongTranslationRepository) GetBySong(ctx context.Context, sid int64) ([]domain.SongTranslation, error) {
+	var translations []domain.SongTranslation
+	res := str.db.Where("song_id = ?", sid).Order("language").Find(&translations)
+
+	if res.Error != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return translations, nil
+}
+
+// Save creates the translation or replaces the translation of the song in the same language.
+func (str gormSongTranslationRepository) Save(ctx context.Context, translation *domain.SongTranslation) error {
+	res := str.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "song_id"}, {Name: "language"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "lyrics", "updated_at"}),
+	}).Create(translation)
+
+	if res.Error != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
+
+func (str gormSongTranslationRepository) Delete(ctx context.Context, sid int64, language string) error {
+	res := str.db.Where("song_id = ? AND language = ?", sid, language).Delete(&domain.SongTranslation{})
+
+	if res.Error != nil {
+		return domain.NewInternalErr()
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.NewRecordNotFoundErr("language", language)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+type gormStatsRepository struct {
+	db *gorm.DB
+}
+
+//revive:disable:unexported-return
+func NewGormStatsRepository(db *gorm.DB) *gormStatsRepository {
+	return &gormStatsRepository{
+		db: db,
+	}
+}
+
+func (str gormStatsRepository) plays(options *domain.PlayFilterOptions) *gorm.DB {
+	transaction := str.db.
+		Table("setlist_entries").
+		Joins("JOIN setlists ON setlists.id = setlist_entries.setlist_id").
+		Joins("JOIN songs ON songs.id = setlist_entries.song_id AND songs.deleted_at IS NULL").
+		Where("setlists.status <> ?", domain.SetlistDraft)
+
+	if len(options.SongIDs) > 0 {
+		transaction = transaction.Where("setlist_entries.song_id IN ?", options.SongIDs)
+	}
+
+	if !options.From.IsZero() {
+		transaction = transaction.Where("setlists.deadline >= ?", options.From)
+	}
+
+	if !options.To.IsZero() {
+		transaction = transaction.Where("setlists.deadline <= ?", options.To)
+	}
+
+	return transaction
+}
+
+func (str gormStatsRepository) GetPlayCounts(ctx context.Context, options *domain.PlayFilterOptions) ([]domain.SongStats, error) {
+	transaction := str.plays(options).
+		Select("setlist_entries.song_id, songs.title, " +
+			"COUNT(DISTINCT setlist_entries.setlist_id) AS play_count, " +
+			"MIN(setlists.deadline) AS first_played, " +
+			"MAX(setlists.deadline) AS last_played").
+		Group("setlist_entries.song_id, songs.title").
+		Order("play_count DESC, last_played DESC, setlist_entries.song_id")
+
+	if options.Limit > 0 {
+		transaction = transaction.Limit(options.Limit)
+	}
+
+	var stats []domain.SongStats
+
+	if err := transaction.Scan(&stats).Error; err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return stats, nil
+}
+
+func (str gormStatsRepository) GetTransposeCounts(
+	ctx context.Context,
+	options *domain.PlayFilterOptions,
+) ([]domain.TransposeCount, error) {
+	var counts []domain.TransposeCount
+
+	res := str.plays(options).
+		Select("setlist_entries.song_id, setlist_entries.transpose, COUNT(*) AS count").
+		Group("setlist_entries.song_id, setlist_entries.transpose").
+		Scan(&counts)
+
+	if err := res.Error; err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+type gormTagRepository struct {
+	db *gorm.DB
+}
+
+//revive:disable:unexported-return
+func NewGormTagRepository(db *gorm.DB) *gormTagRepository {
+	return &gormTagRepository{
+		db: db,
+	}
+}
+
+func (tr gormTagRepository) GetByID(ctx context.Context, tid int64) (*domain.Tag, error) {
+	var tag domain.Tag
+	res := tr.db.First(&tag, tid)
+
+	if err := res.Error; err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(tid))
+		default:
+			return nil, domain.NewInternalErr()
+		}
+	}
+
+	return &tag, nil
+}
+
+func (tr gormTagRepository) GetAll(ctx context.Context) (*[]domain.Tag, error) {
+	var tags []domain.Tag
+	res := tr.db.Order("category, name").Find(&tags)
+
+	if err := res.Error; err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return &tags, nil
+}
+
+func (tr gormTagRepository) GetByIDs(ctx context.Context, ids []int64) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	res := tr.db.Where("id IN ?", ids).Order("category, name").Find(&tags)
+
+	if err := res.Error; err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return tags, nil
+}
+
+func (tr gormTagRepository) GetBySong(ctx context.Context, sid int64) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	res := tr.db.
+		Joins("JOIN song_tags ON song_tags.tag_id = tags.id").
+		Where("song_tags.song_id = ?", sid).
+		Order("tags.category, tags.name").
+		Find(&tags)
+
+	if err := res.Error; err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return tags, nil
+}
+
+func (tr gormTagRepository) SetSongTags(ctx context.Context, sid int64, tagIDs []int64) error {
+	err := tr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("song_id = ?", sid).Delete(&domain.SongTag{}).Error; err != nil {
+			return err
+		}
+
+		if len(tagIDs) == 0 {
+			return nil
+		}
+
+		songTags := make([]domain.SongTag, len(tagIDs))
+		for idx, tagID := range tagIDs {
+			songTags[idx] = domain.SongTag{SongID: sid, TagID: tagID}
+		}
+
+		return tx.Create(&songTags).Error
+	})
+	if err != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
+
+func (tr gormTagRepository) Create(ctx context.Context, tag *domain.Tag) error {
+	res := tr.db.Create(tag)
+
+	if err := res.Error; err != nil {
+		var mysqlErr *mysql.MySQLError
+
+		if errors.As(err, &mysqlErr) {
+			switch mysqlErr.Number {
+			case domain.MySQLUniqueErr:
+				return domain.NewBadRequestErr(mysqlErr.Message)
+			default:
+				return domain.NewInternalErr()
+			}
+		}
+
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
+
+func (tr gormTagRepository) Update(ctx context.Context, tag *domain.Tag) error {
+	res := tr.db.Save(tag)
+
+	if err := res.Error; err != nil {
+		var mysqlErr *mysql.MySQLError
+
+		if errors.As(err, &mysqlErr) {
+			switch mysqlErr.Number {
+			case domain.MySQLUniqueErr:
+				return domain.NewBadRequestErr(mysqlErr.Message)
+			default:
+				return domain.NewInternalErr()
+			}
+		}
+
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
+
+func (tr gormTagRepository) Delete(ctx context.Context, tid int64) error {
+	err := tr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", tid).Delete(&domain.SongTag{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&domain.Tag{ID: tid}).Error
+	})
+	if err != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
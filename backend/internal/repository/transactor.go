@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+type transactionKey struct{}
+
+type gormTransactor struct {
+	db *gorm.DB
+}
+
+//revive:disable:unexported-return
+func NewGormTransactor(db *gorm.DB) *gormTransactor {
+	return &gormTransactor{
+		db: db,
+	}
+}
+
+func (gt gormTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, exists := ctx.Value(transactionKey{}).(*gorm.DB); exists {
+		return fn(ctx)
+	}
+
+	var fnErr error
+
+	err := gt.db.Transaction(func(tx *gorm.DB) error {
+		fnErr = fn(context.WithValue(ctx, transactionKey{}, tx))
+
+		return fnErr
+	})
+
+	if fnErr != nil {
+		return fnErr
+	}
+
+	if err != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
+
+// conn returns the transaction of the unit of work in the context, or db outside of one.
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, exists := ctx.Value(transactionKey{}).(*gorm.DB); exists {
+		return tx
+	}
+
+	return db
+}
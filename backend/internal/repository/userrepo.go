@@ -21,7 +21,7 @@ func NewGormUserRepository(db *gorm.DB) *gormUserRepository {
 }
 
 func (ur gormUserRepository) Create(ctx context.Context, user *domain.User) error {
-	res := ur.db.Create(user)
+	res := conn(ctx, ur.db).Create(user)
 	if err := res.Error; err != nil {
 		log.Println(err)
 		return domain.NewInternalErr()
@@ -31,7 +31,7 @@ func (ur gormUserRepository) Create(ctx context.Context, user *domain.User) erro
 }
 
 func (ur gormUserRepository) CreateBatch(ctx context.Context, users *[]domain.User) error {
-	res := ur.db.CreateInBatches(users, 50)
+	res := conn(ctx, ur.db).CreateInBatches(users, 50)
 	if res.Error != nil {
 		return domain.NewInternalErr()
 	}
@@ -42,7 +42,7 @@ func (ur gormUserRepository) CreateBatch(ctx context.Context, users *[]domain.Us
 func (ur gormUserRepository) GetByID(ctx context.Context, userID int64) (*domain.User, error) {
 	var user domain.User
 
-	res := ur.db.First(&user, userID)
+	res := conn(ctx, ur.db).First(&user, userID)
 	if err := res.Error; err != nil {
 		switch {
 		case errors.Is(gorm.ErrRecordNotFound, err):
@@ -58,7 +58,7 @@ func (ur gormUserRepository) GetByID(ctx context.Context, userID int64) (*domain
 func (ur gormUserRepository) GetAll(ctx context.Context) (*[]domain.User, error) {
 	var users []domain.User
 
-	res := ur.db.Find(&users)
+	res := conn(ctx, ur.db).Find(&users)
 	if err := res.Error; err != nil {
 		return nil, domain.NewInternalErr()
 	}
@@ -69,7 +69,7 @@ func (ur gormUserRepository) GetAll(ctx context.Context) (*[]domain.User, error)
 func (ur gormUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var user domain.User
 
-	res := ur.db.Where("email = ?", &email).First(&user)
+	res := conn(ctx, ur.db).Where("email = ?", &email).First(&user)
 	if err := res.Error; err != nil {
 		switch {
 		case errors.Is(gorm.ErrRecordNotFound, err):
@@ -85,7 +85,7 @@ func (ur gormUserRepository) GetByEmail(ctx context.Context, email string) (*dom
 // Update updates a user by the given non-zero user.ID and only updates columns
 // with non-zero values.
 func (ur gormUserRepository) Update(ctx context.Context, user *domain.User) error {
-	res := ur.db.Updates(user)
+	res := conn(ctx, ur.db).Updates(user)
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
 	}
@@ -94,7 +94,7 @@ func (ur gormUserRepository) Update(ctx context.Context, user *domain.User) erro
 }
 
 func (ur gormUserRepository) Delete(ctx context.Context, id int64) error {
-	res := ur.db.Delete(&domain.User{}, id)
+	res := conn(ctx, ur.db).Delete(&domain.User{}, id)
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
 	}
@@ -24,7 +24,7 @@ func NewGormUserRoleRepository(db *gorm.DB) *gormUserRoleRepository {
 func (urr gormUserRoleRepository) GetByID(ctx context.Context, urid int64) (*domain.UserRole, error) {
 	var role domain.UserRole
 
-	res := urr.db.Preload("User").Preload("Role").First(&role, urid)
+	res := conn(ctx, urr.db).Preload("User").Preload("Role").First(&role, urid)
 	if err := res.Error; err != nil {
 		switch {
 		case errors.Is(gorm.ErrRecordNotFound, err):
@@ -40,7 +40,7 @@ func (urr gormUserRoleRepository) GetByID(ctx context.Context, urid int64) (*dom
 func (urr gormUserRoleRepository) GetAll(ctx context.Context) (*[]domain.UserRole, error) {
 	var userroles []domain.UserRole
 
-	res := urr.db.Preload("User").Preload("Role").Find(&userroles)
+	res := conn(ctx, urr.db).Preload("User").Preload("Role").Find(&userroles)
 	if err := res.Error; err != nil {
 		return nil, domain.NewInternalErr()
 	}
@@ -51,7 +51,7 @@ func (urr gormUserRoleRepository) GetAll(ctx context.Context) (*[]domain.UserRol
 func (urr gormUserRoleRepository) Get(ctx context.Context, ids []int64) (*[]domain.UserRole, error) {
 	var userroles []domain.UserRole
 
-	res := urr.db.Preload("User").Preload("Role").Where("user_id = ?").Find(&userroles, ids)
+	res := conn(ctx, urr.db).Preload("User").Preload("Role").Find(&userroles, ids)
 	if err := res.Error; err != nil {
 		return nil, domain.NewInternalErr()
 	}
@@ -62,7 +62,7 @@ func (urr gormUserRoleRepository) Get(ctx context.Context, ids []int64) (*[]doma
 func (urr gormUserRoleRepository) GetByUID(ctx context.Context, uid int64) (*[]domain.UserRole, error) {
 	var userroles []domain.UserRole
 
-	res := urr.db.Preload("User").Preload("Role").Where("user_id = ?", uid).Find(&userroles)
+	res := conn(ctx, urr.db).Preload("User").Preload("Role").Where("user_id = ?", uid).Find(&userroles)
 	if err := res.Error; err != nil {
 		return nil, domain.NewInternalErr()
 	}
@@ -71,7 +71,7 @@ func (urr gormUserRoleRepository) GetByUID(ctx context.Context, uid int64) (*[]d
 }
 
 func (urr gormUserRoleRepository) Create(ctx context.Context, userrole *domain.UserRole) error {
-	res := urr.db.Preload("User").Preload("Role").Create(userrole)
+	res := conn(ctx, urr.db).Preload("User").Preload("Role").Create(userrole)
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
 
@@ -89,7 +89,7 @@ func (urr gormUserRoleRepository) Create(ctx context.Context, userrole *domain.U
 }
 
 func (urr gormUserRoleRepository) CreateBatch(ctx context.Context, userroles *[]domain.UserRole) error {
-	res := urr.db.Preload("User").Preload("Role").Create(userroles)
+	res := conn(ctx, urr.db).Preload("User").Preload("Role").Create(userroles)
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
 
@@ -107,7 +107,7 @@ func (urr gormUserRoleRepository) CreateBatch(ctx context.Context, userroles *[]
 }
 
 func (urr gormUserRoleRepository) Update(ctx context.Context, userrole *domain.UserRole) error {
-	res := urr.db.Preload("User").Preload("Role").Updates(userrole)
+	res := conn(ctx, urr.db).Preload("User").Preload("Role").Updates(userrole)
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
 
@@ -125,7 +125,7 @@ func (urr gormUserRoleRepository) Update(ctx context.Context, userrole *domain.U
 }
 
 func (urr gormUserRoleRepository) UpdateBatch(ctx context.Context, userroles *[]domain.UserRole) error {
-	res := urr.db.Preload("User").Preload("Role").Save(userroles)
+	res := conn(ctx, urr.db).Preload("User").Preload("Role").Save(userroles)
 	if err := res.Error; err != nil {
 		var mysqlErr *mysql.MySQLError
 
@@ -143,7 +143,7 @@ func (urr gormUserRoleRepository) UpdateBatch(ctx context.Context, userroles *[]
 }
 
 func (urr gormUserRoleRepository) Delete(ctx context.Context, rid int64) error {
-	res := urr.db.Delete(&domain.UserRole{}, rid)
+	res := conn(ctx, urr.db).Delete(&domain.UserRole{}, rid)
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
 	}
@@ -152,7 +152,7 @@ func (urr gormUserRoleRepository) Delete(ctx context.Context, rid int64) error {
 }
 
 func (urr gormUserRoleRepository) DeleteBatch(ctx context.Context, rids []int64) error {
-	res := urr.db.Delete(&domain.UserRole{}, rids)
+	res := conn(ctx, urr.db).Delete(&domain.UserRole{}, rids)
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
 	}
@@ -161,7 +161,7 @@ func (urr gormUserRoleRepository) DeleteBatch(ctx context.Context, rids []int64)
 }
 
 func (urr gormUserRoleRepository) DeleteByRID(ctx context.Context, rid int64) error {
-	res := urr.db.Where("role_id = ?", rid).Delete(&domain.UserRole{})
+	res := conn(ctx, urr.db).Where("role_id = ?", rid).Delete(&domain.UserRole{})
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
 	}
@@ -170,7 +170,7 @@ func (urr gormUserRoleRepository) DeleteByRID(ctx context.Context, rid int64) er
 }
 
 func (urr gormUserRoleRepository) DeleteByUID(ctx context.Context, uid int64) error {
-	res := urr.db.Where("user_id = ?", uid).Delete(&domain.UserRole{})
+	res := conn(ctx, urr.db).Where("user_id = ?", uid).Delete(&domain.UserRole{})
 	if err := res.Error; err != nil {
 		return domain.NewInternalErr()
 	}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"gorm.io/gorm"
+)
+
+// atVersion restricts an update to the row that still has the version the caller read,
+// an update without a version is unconditional.
+func atVersion(db *gorm.DB, updatedAt time.Time) *gorm.DB {
+	if updatedAt.IsZero() {
+		return db
+	}
+
+	return db.Where("updated_at = ?", updatedAt)
+}
+
+// checkVersion reports a precondition failure when an update restricted to a version
+// changed no rows, because the row was changed by someone else in the meantime.
+func checkVersion(res *gorm.DB, updatedAt time.Time) error {
+	if !updatedAt.IsZero() && res.RowsAffected == 0 {
+		return domain.NewPreconditionFailedErr("resource was changed by someone else, fetch it again before changing it")
+	}
+
+	return nil
+}
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+)
+
+type attachmentService struct {
+	ar   domain.AttachmentRepository
+	bs   domain.BlobStore
+	sr   domain.SongRepository
+	slr  domain.SetlistRepository
+	sler domain.SetlistEntryRepository
+}
+
+//revive:disable:unexported-return
+func NewAttachmentService(
+	ar domain.AttachmentRepository,
+	bs domain.BlobStore,
+	sr domain.SongRepository,
+	slr domain.SetlistRepository,
+	sler domain.SetlistEntryRepository,
+) *attachmentService {
+	return &attachmentService{
+		ar:   ar,
+		bs:   bs,
+		sr:   sr,
+		slr:  slr,
+		sler: sler,
+	}
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	size int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	cw.size += int64(len(p))
+
+	return len(p), nil
+}
+
+// fetchVisibleSetlist fetches the setlist, drafts the principal cannot see are not found.
+func (as attachmentService) fetchVisibleSetlist(ctx context.Context, sid int64, principal *domain.User) (*domain.Setlist, error) {
+	setlist, err := as.slr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if !setlist.IsVisibleTo(principal) {
+		return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(sid))
+	}
+
+	return setlist, nil
+}
+
+// fetchVisible fetches the attachment, attachments of entries of
+// setlists the principal cannot see are not found.
+func (as attachmentService) fetchVisible(ctx context.Context, id int64, principal *domain.User) (*domain.Attachment, error) {
+	attachment, err := as.ar.GetByID(ctx, id)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if attachment.OwnerType != domain.SetlistEntryAttachment {
+		return attachment, nil
+	}
+
+	entry, err := as.sler.GetByID(ctx, attachment.OwnerID)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if _, err := as.fetchVisibleSetlist(ctx, entry.SetlistID, principal); err != nil {
+		return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(id))
+	}
+
+	return attachment, nil
+}
+
+func (as attachmentService) FetchByID(ctx context.Context, id int64, principal *domain.User) (*domain.Attachment, error) {
+	return as.fetchVisible(ctx, id, principal)
+}
+
+func (as attachmentService) FetchBySong(ctx context.Context, sid int64) ([]domain.Attachment, error) {
+	if _, err := as.sr.GetByID(ctx, sid); err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	attachments, err := as.ar.GetByOwner(ctx, domain.SongAttachment, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return attachments, nil
+}
+
+func (as attachmentService) fetchEntry(ctx context.Context, setlistID, entryID int64) (*domain.SetlistEntry, error) {
+	entry, err := as.sler.GetByID(ctx, entryID)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if entry.SetlistID != setlistID {
+		return nil, domain.NewRecordNotFoundErr("setlist_id", fmt.Sprint(setlistID))
+	}
+
+	return entry, nil
+}
+
+func (as attachmentService) FetchByEntry(
+	ctx context.Context,
+	setlistID, entryID int64,
+	principal *domain.User,
+) ([]domain.Attachment, error) {
+	if _, err := as.fetchVisibleSetlist(ctx, setlistID, principal); err != nil {
+		return nil, err
+	}
+
+	if _, err := as.fetchEntry(ctx, setlistID, entryID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := as.ar.GetByOwner(ctx, domain.SetlistEntryAttachment, entryID)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return attachments, nil
+}
+
+func (as attachmentService) StoreForSong(
+	ctx context.Context,
+	sid int64,
+	name string,
+	content io.Reader,
+	principal *domain.User,
+) (*domain.Attachment, error) {
+	song, err := as.sr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.EDITOR) && song.CreatorID != principal.ID {
+		return nil, domain.NewNotAuthorizedErr("user is neither an editor nor creator of the song")
+	}
+
+	return as.store(ctx, domain.SongAttachment, sid, name, content, principal)
+}
+
+func (as attachmentService) StoreForEntry(
+	ctx context.Context,
+	setlistID, entryID int64,
+	name string,
+	content io.Reader,
+	principal *domain.User,
+) (*domain.Attachment, error) {
+	setlist, err := as.slr.GetByID(ctx, setlistID)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.EDITOR) && setlist.CreatorID != principal.ID {
+		return nil, domain.NewNotAuthorizedErr("user is neither an editor nor creator of the setlist")
+	}
+
+	if err := checkLocked(setlist, principal); err != nil {
+		return nil, err
+	}
+
+	if _, err := as.fetchEntry(ctx, setlistID, entryID); err != nil {
+		return nil, err
+	}
+
+	return as.store(ctx, domain.SetlistEntryAttachment, entryID, name, content, principal)
+}
+
+// store sniffs the content type and streams the content into the blob store
+// while computing its checksum, the blob is removed again if the content turns
+// out to be too large or the attachment cannot be saved.
+func (as attachmentService) store(
+	ctx context.Context,
+	owner domain.AttachmentOwner,
+	ownerID int64,
+	name string,
+	content io.Reader,
+	principal *domain.User,
+) (*domain.Attachment, error) {
+	name = strings.TrimSpace(filepath.Base(name))
+	if name == "" || name == "." || name == string(filepath.Separator) {
+		return nil, domain.NewBadRequestErr("attachment name cannot be empty")
+	}
+
+	reader := bufio.NewReaderSize(content, util.SniffLength)
+
+	head, err := reader.Peek(util.SniffLength)
+	if err != nil && err != io.EOF {
+		return nil, domain.NewInternalErr()
+	}
+
+	if len(head) == 0 {
+		return nil, domain.NewBadRequestErr("attachment cannot be empty")
+	}
+
+	contentType, err := util.SniffContentType(name, head)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := util.NewStorageKey()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	counter := &countingWriter{}
+	limited := io.LimitReader(reader, domain.MaxAttachmentSize+1)
+
+	if err := as.bs.Put(ctx, key, io.TeeReader(limited, io.MultiWriter(hash, counter))); err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	if counter.size > domain.MaxAttachmentSize {
+		_ = as.bs.Delete(ctx, key)
+
+		return nil, domain.NewTooLargeErr(fmt.Sprintf("attachment exceeds %d bytes", domain.MaxAttachmentSize))
+	}
+
+	attachment := &domain.Attachment{
+		OwnerType:   owner,
+		OwnerID:     ownerID,
+		CreatorID:   principal.ID,
+		Name:        name,
+		ContentType: contentType,
+		Size:        counter.size,
+		Checksum:    hex.EncodeToString(hash.Sum(nil)),
+		StorageKey:  key,
+	}
+
+	if err := as.ar.Create(ctx, attachment); err != nil {
+		_ = as.bs.Delete(ctx, key)
+
+		return nil, domain.FromError(err)
+	}
+
+	return attachment, nil
+}
+
+func (as attachmentService) Open(
+	ctx context.Context,
+	id int64,
+	principal *domain.User,
+) (*domain.Attachment, io.ReadSeekCloser, error) {
+	attachment, err := as.fetchVisible(ctx, id, principal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := as.bs.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, domain.NewInternalErr()
+	}
+
+	return attachment, content, nil
+}
+
+func (as attachmentService) Remove(ctx context.Context, id int64, principal *domain.User) error {
+	attachment, err := as.ar.GetByID(ctx, id)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.EDITOR) && attachment.CreatorID != principal.ID {
+		return domain.NewNotAuthorizedErr("user is neither an editor nor creator of the attachment")
+	}
+
+	if attachment.OwnerType == domain.SetlistEntryAttachment {
+		entry, err := as.sler.GetByID(ctx, attachment.OwnerID)
+		if err != nil {
+			return domain.FromError(err)
+		}
+
+		setlist, err := as.slr.GetByID(ctx, entry.SetlistID)
+		if err != nil {
+			return domain.FromError(err)
+		}
+
+		if err := checkLocked(setlist, principal); err != nil {
+			return err
+		}
+	}
+
+	if err := as.ar.Delete(ctx, id); err != nil {
+		return domain.FromError(err)
+	}
+
+	if err := as.bs.Delete(ctx, attachment.StorageKey); err != nil {
+		return domain.NewInternalErr()
+	}
+
+	return nil
+}
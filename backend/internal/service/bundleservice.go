@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 )
@@ -70,7 +71,7 @@ func contains(bundles *[]domain.Bundle, bid int64) bool {
 	return false
 }
 
-func (bs bundleService) Remove(ctx context.Context, bid int64, principal *domain.User) error {
+func (bs bundleService) Remove(ctx context.Context, bid int64, version time.Time, principal *domain.User) error {
 	if !principal.HasClearance(domain.MEMBER) {
 		return domain.NewNotAuthorizedErr("")
 	}
@@ -90,7 +91,7 @@ func (bs bundleService) Remove(ctx context.Context, bid int64, principal *domain
 		return domain.NewBadRequestErr("given id is not a leaf bundle")
 	}
 
-	err = bs.br.Delete(ctx, bid)
+	err = bs.br.Delete(ctx, bid, version)
 	if err != nil {
 		return domain.FromError(err)
 	}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+)
+
+type calendarService struct {
+	ctr  domain.CalendarTokenRepository
+	ur   domain.UserRepository
+	slr  domain.SetlistRepository
+	sler domain.SetlistEntryRepository
+	sr   domain.SongRepository
+	slrr domain.SetlistRoleRepository
+	urr  domain.UserRoleRepository
+}
+
+//revive:disable:unexported-return
+func NewCalendarService(
+	ctr domain.CalendarTokenRepository,
+	ur domain.UserRepository,
+	slr domain.SetlistRepository,
+	sler domain.SetlistEntryRepository,
+	sr domain.SongRepository,
+	slrr domain.SetlistRoleRepository,
+	urr domain.UserRoleRepository,
+) *calendarService {
+	return &calendarService{
+		ctr:  ctr,
+		ur:   ur,
+		slr:  slr,
+		sler: sler,
+		sr:   sr,
+		slrr: slrr,
+		urr:  urr,
+	}
+}
+
+func (cs calendarService) fetchSongs(ctx context.Context, entries []domain.SetlistEntry) (map[int64]domain.Song, error) {
+	songs := make(map[int64]domain.Song)
+
+	for _, entry := range entries {
+		if _, exists := songs[entry.SongID]; exists || !entry.IsSong() {
+			continue
+		}
+
+		song, err := cs.sr.GetByID(ctx, entry.SongID)
+		if err != nil {
+			return nil, domain.FromError(err)
+		}
+
+		songs[entry.SongID] = *song
+	}
+
+	return songs, nil
+}
+
+// fetchAssignments returns the user roles assigned to each of the setlists.
+func (cs calendarService) fetchAssignments(ctx context.Context, setlistIDs []int64) (map[int64][]domain.UserRole, error) {
+	assignments := make(map[int64][]domain.UserRole)
+
+	setlistRoles, err := cs.slrr.Get(ctx, setlistIDs)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if setlistRoles == nil || len(*setlistRoles) == 0 {
+		return assignments, nil
+	}
+
+	userRoleIDs := make([]int64, 0, len(*setlistRoles))
+	for _, setlistRole := range *setlistRoles {
+		userRoleIDs = append(userRoleIDs, setlistRole.UserRoleID)
+	}
+
+	userRoles, err := cs.urr.Get(ctx, userRoleIDs)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	byID := make(map[int64]domain.UserRole, len(*userRoles))
+	for _, userRole := range *userRoles {
+		byID[userRole.ID] = userRole
+	}
+
+	for _, setlistRole := range *setlistRoles {
+		if userRole, exists := byID[setlistRole.UserRoleID]; exists {
+			assignments[setlistRole.SetlistID] = append(assignments[setlistRole.SetlistID], userRole)
+		}
+	}
+
+	return assignments, nil
+}
+
+// render renders a calendar with an event for every setlist in order of their deadline.
+func (cs calendarService) render(ctx context.Context, name string, setlists []domain.Setlist) ([]byte, error) {
+	calendar := &domain.Calendar{
+		Name:      name,
+		Events:    make([]domain.CalendarEvent, 0, len(setlists)),
+		Generated: time.Now(),
+	}
+
+	if len(setlists) == 0 {
+		return util.RenderCalendar(calendar), nil
+	}
+
+	sort.SliceStable(setlists, func(i, j int) bool {
+		return setlists[i].Deadline.Before(setlists[j].Deadline)
+	})
+
+	setlistIDs := make([]int64, len(setlists))
+	for idx, setlist := range setlists {
+		setlistIDs[idx] = setlist.ID
+	}
+
+	entries, err := cs.sler.GetBySetlist(ctx, &setlists)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	songs, err := cs.fetchSongs(ctx, *entries)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := cs.fetchAssignments(ctx, setlistIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	entriesBySetlist := make(map[int64][]domain.SetlistEntry, len(setlists))
+	for _, entry := range *entries {
+		entriesBySetlist[entry.SetlistID] = append(entriesBySetlist[entry.SetlistID], entry)
+	}
+
+	for idx := range setlists {
+		setlist := &setlists[idx]
+		event := util.SetlistEvent(setlist, entriesBySetlist[setlist.ID], songs, assignments[setlist.ID])
+		calendar.Events = append(calendar.Events, event)
+	}
+
+	return util.RenderCalendar(calendar), nil
+}
+
+// FetchFeed returns a calendar of all setlists the principal can see,
+// anonymous principals only see setlists that are not drafts.
+func (cs calendarService) FetchFeed(ctx context.Context, principal *domain.User) ([]byte, error) {
+	setlists, err := cs.slr.GetAll(ctx)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return cs.render(ctx, "Setlists", *visible(setlists, principal))
+}
+
+// FetchPersonalFeed returns a calendar of the setlists the owner of
+// the token is assigned to through one of their user roles.
+func (cs calendarService) FetchPersonalFeed(ctx context.Context, token string) ([]byte, error) {
+	calendarToken, err := cs.ctr.GetByToken(ctx, token)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	user, err := cs.ur.GetByID(ctx, calendarToken.UserID)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	name := fmt.Sprintf("Setlists of %s %s", user.FirstName, user.LastName)
+
+	userRoles, err := cs.urr.GetByUID(ctx, user.ID)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if len(*userRoles) == 0 {
+		return cs.render(ctx, name, nil)
+	}
+
+	userRoleIDs := make([]int64, len(*userRoles))
+	for idx, userRole := range *userRoles {
+		userRoleIDs[idx] = userRole.ID
+	}
+
+	setlistRoles, err := cs.slrr.GetByUserRoles(ctx, userRoleIDs)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	setlistIDs := make([]int64, 0, len(*setlistRoles))
+	seen := make(map[int64]bool, len(*setlistRoles))
+
+	for _, setlistRole := range *setlistRoles {
+		if !seen[setlistRole.SetlistID] {
+			seen[setlistRole.SetlistID] = true
+			setlistIDs = append(setlistIDs, setlistRole.SetlistID)
+		}
+	}
+
+	if len(setlistIDs) == 0 {
+		return cs.render(ctx, name, nil)
+	}
+
+	setlists := make([]domain.Setlist, 0, len(setlistIDs))
+
+	for _, setlistID := range setlistIDs {
+		setlist, err := cs.slr.GetByID(ctx, setlistID)
+		if err != nil {
+			// Roles can outlive their setlist, a removed setlist is left out of the feed.
+			if domain.FromError(err).Type == domain.NotFound {
+				continue
+			}
+
+			return nil, domain.FromError(err)
+		}
+
+		setlists = append(setlists, *setlist)
+	}
+
+	return cs.render(ctx, name, *visible(&setlists, user))
+}
+
+func (cs calendarService) FetchToken(ctx context.Context, principal *domain.User) (*domain.CalendarToken, error) {
+	token, err := cs.ctr.GetByUser(ctx, principal.ID)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return token, nil
+}
+
+// RotateToken gives the principal a new calendar token, the previous token stops working.
+func (cs calendarService) RotateToken(ctx context.Context, principal *domain.User) (*domain.CalendarToken, error) {
+	secret, err := util.NewCalendarToken()
+	if err != nil {
+		return nil, err
+	}
+
+	token := &domain.CalendarToken{
+		UserID:    principal.ID,
+		Token:     secret,
+		CreatedAt: time.Now(),
+	}
+
+	if err := cs.ctr.Save(ctx, token); err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return token, nil
+}
+
+func (cs calendarService) RevokeToken(ctx context.Context, principal *domain.User) error {
+	if err := cs.ctr.DeleteByUser(ctx, principal.ID); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+)
+
+type exportService struct {
+	slr  domain.SetlistRepository
+	sler domain.SetlistEntryRepository
+	sr   domain.SongRepository
+	slrr domain.SetlistRoleRepository
+	urr  domain.UserRoleRepository
+}
+
+//revive:disable:unexported-return
+func NewExportService(
+	slr domain.SetlistRepository,
+	sler domain.SetlistEntryRepository,
+	sr domain.SongRepository,
+	slrr domain.SetlistRoleRepository,
+	urr domain.UserRoleRepository,
+) *exportService {
+	return &exportService{
+		slr:  slr,
+		sler: sler,
+		sr:   sr,
+		slrr: slrr,
+		urr:  urr,
+	}
+}
+
+func (es exportService) fetchAssignments(ctx context.Context, setlist *domain.Setlist) ([]domain.UserRole, error) {
+	setlistRoles, err := es.slrr.Get(ctx, []int64{setlist.ID})
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if setlistRoles == nil || len(*setlistRoles) == 0 {
+		return []domain.UserRole{}, nil
+	}
+
+	userRoleIDs := make([]int64, 0, len(*setlistRoles))
+
+	for _, setlistRole := range *setlistRoles {
+		if setlistRole.SetlistID == setlist.ID {
+			userRoleIDs = append(userRoleIDs, setlistRole.UserRoleID)
+		}
+	}
+
+	userRoles, err := es.urr.Get(ctx, userRoleIDs)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return *userRoles, nil
+}
+
+func (es exportService) fetchCharts(ctx context.Context, setlist *domain.Setlist) ([]domain.Chart, error) {
+	entries, err := es.sler.GetBySetlist(ctx, &[]domain.Setlist{*setlist})
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	sort.SliceStable(*entries, func(i, j int) bool {
+		return (*entries)[i].Rank < (*entries)[j].Rank
+	})
+
+	charts := make([]domain.Chart, 0, len(*entries))
+
+	for _, entry := range *entries {
+		if !entry.IsSong() {
+			continue
+		}
+
+		song, err := es.sr.GetByID(ctx, entry.SongID)
+		if err != nil {
+			return nil, domain.FromError(err)
+		}
+
+		sheet, err := newSheet(song, entry.Transpose, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		arrangement, err := util.ParseArrangement(entry.Arrangement)
+		if err != nil {
+			return nil, domain.NewBadRequestErr(err.Error())
+		}
+
+		charts = append(charts, domain.Chart{
+			Sheet:       *sheet,
+			Arrangement: arrangement,
+			Notes:       entry.Notes,
+		})
+	}
+
+	return charts, nil
+}
+
+func (es exportService) ExportPDF(ctx context.Context, setlistID int64, principal *domain.User) ([]byte, error) {
+	setlist, err := es.slr.GetByID(ctx, setlistID)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if !setlist.IsVisibleTo(principal) {
+		return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(setlistID))
+	}
+
+	assignments, err := es.fetchAssignments(ctx, setlist)
+	if err != nil {
+		return nil, err
+	}
+
+	charts, err := es.fetchCharts(ctx, setlist)
+	if err != nil {
+		return nil, err
+	}
+
+	booklet := &domain.Booklet{
+		Setlist:     *setlist,
+		Assignments: assignments,
+		Charts:      charts,
+	}
+
+	pdf, err := util.RenderBookletPDF(booklet)
+	if err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return pdf, nil
+}
@@ -10,14 +10,21 @@ type roleService struct {
 	rr  domain.RoleRepository
 	ur  domain.UserRepository
 	urr domain.UserRoleRepository
+	tx  domain.Transactor
 }
 
 //revive:disable:unexported-return
-func NewRoleService(rr domain.RoleRepository, ur domain.UserRepository, urr domain.UserRoleRepository) *roleService {
+func NewRoleService(
+	rr domain.RoleRepository,
+	ur domain.UserRepository,
+	urr domain.UserRoleRepository,
+	tx domain.Transactor,
+) *roleService {
 	return &roleService{
 		rr:  rr,
 		ur:  ur,
 		urr: urr,
+		tx:  tx,
 	}
 }
 
@@ -61,35 +68,38 @@ func (rs roleService) Update(ctx context.Context, role *domain.Role, principal *
 	return nil
 }
 
+// Store creates the role and gives every user the role, both or neither are saved.
 func (rs roleService) Store(ctx context.Context, role *domain.Role, principal *domain.User) error {
 	if principal.Permission != domain.ADMIN {
 		return domain.NewNotAuthorizedErr("not authorized to create roles")
 	}
 
-	err := rs.rr.Create(ctx, role)
-	if err != nil {
-		return domain.FromError(err)
-	}
+	return rs.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := rs.rr.Create(ctx, role)
+		if err != nil {
+			return domain.FromError(err)
+		}
 
-	users, err := rs.ur.GetAll(ctx)
-	if err != nil {
-		return domain.FromError(err)
-	}
+		users, err := rs.ur.GetAll(ctx)
+		if err != nil {
+			return domain.FromError(err)
+		}
 
-	userroles := make([]domain.UserRole, len(*users))
-	for idx, user := range *users {
-		userroles[idx] = domain.UserRole{
-			UserID: user.ID,
-			RoleID: role.ID,
+		userroles := make([]domain.UserRole, len(*users))
+		for idx, user := range *users {
+			userroles[idx] = domain.UserRole{
+				UserID: user.ID,
+				RoleID: role.ID,
+			}
 		}
-	}
 
-	err = rs.urr.CreateBatch(ctx, &userroles)
-	if err != nil {
-		return domain.FromError(err)
-	}
+		err = rs.urr.CreateBatch(ctx, &userroles)
+		if err != nil {
+			return domain.FromError(err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (rs roleService) Remove(ctx context.Context, rid int64, principal *domain.User) error {
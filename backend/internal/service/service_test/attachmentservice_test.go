@@ -0,0 +1,628 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var mockPDF = []byte("%PDF-1.7\n1 0 obj\n<< /Type /Catalog >>\nendobj\n")
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for idx := range p {
+		p[idx] = 0
+	}
+
+	return len(p), nil
+}
+
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error {
+	return nil
+}
+
+func consumeBlob(t *testing.T, stored *bytes.Buffer) func(mock.Arguments) {
+	t.Helper()
+
+	return func(args mock.Arguments) {
+		content, ok := args.Get(2).(io.Reader)
+		assert.True(t, ok)
+
+		_, err := io.Copy(stored, content)
+		assert.NoError(t, err)
+	}
+}
+
+func TestAttachmentServiceStoreForSong(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+	mockSong := &domain.Song{ID: 1, CreatorID: 1}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		var stored bytes.Buffer
+
+		checksum := sha256.Sum256(mockPDF)
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockBS.
+			On("Put", context.TODO(), mock.AnythingOfType("string"), mock.Anything).
+			Return(nil).
+			Run(consumeBlob(t, &stored))
+		mockAR.
+			On("Create", context.TODO(), mock.AnythingOfType("*domain.Attachment")).
+			Return(nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		attachment, err := as.StoreForSong(context.TODO(), mockSong.ID, "../sheet.pdf", bytes.NewReader(mockPDF), mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SongAttachment, attachment.OwnerType)
+		assert.Equal(t, mockSong.ID, attachment.OwnerID)
+		assert.Equal(t, mockUser.ID, attachment.CreatorID)
+		assert.Equal(t, "sheet.pdf", attachment.Name)
+		assert.Equal(t, "application/pdf", attachment.ContentType)
+		assert.Equal(t, int64(len(mockPDF)), attachment.Size)
+		assert.Equal(t, hex.EncodeToString(checksum[:]), attachment.Checksum)
+		assert.Len(t, attachment.StorageKey, 32)
+		assert.Equal(t, mockPDF, stored.Bytes())
+		mockSR.AssertExpectations(t)
+		mockBS.AssertExpectations(t)
+		mockAR.AssertExpectations(t)
+	})
+
+	t.Run("Correct audio by extension", func(t *testing.T) {
+		t.Parallel()
+
+		var stored bytes.Buffer
+
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockBS.
+			On("Put", context.TODO(), mock.AnythingOfType("string"), mock.Anything).
+			Return(nil).
+			Run(consumeBlob(t, &stored))
+		mockAR.
+			On("Create", context.TODO(), mock.AnythingOfType("*domain.Attachment")).
+			Return(nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		content := []byte("fLaC\x00\x00\x00\x22")
+
+		attachment, err := as.StoreForSong(context.TODO(), mockSong.ID, "Practice.FLAC", bytes.NewReader(content), mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, "audio/flac", attachment.ContentType)
+		mockAR.AssertExpectations(t)
+	})
+
+	t.Run("Fail not creator", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		_, err := as.StoreForSong(context.TODO(), mockSong.ID, "sheet.pdf", bytes.NewReader(mockPDF), &domain.User{ID: 2, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		mockBS.AssertExpectations(t)
+		mockAR.AssertExpectations(t)
+	})
+
+	t.Run("Fail content type not allowed", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		_, err := as.StoreForSong(context.TODO(), mockSong.ID, "notes.mp3", bytes.NewReader([]byte("<html></html>")), mockUser)
+		assert.ErrorAs(t, err, &expErr)
+
+		_, err = as.StoreForSong(context.TODO(), mockSong.ID, "empty.pdf", bytes.NewReader(nil), mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		mockBS.AssertExpectations(t)
+		mockAR.AssertExpectations(t)
+	})
+
+	t.Run("Fail too large", func(t *testing.T) {
+		t.Parallel()
+
+		var stored bytes.Buffer
+
+		expErr := domain.NewTooLargeErr("")
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockBS.
+			On("Put", context.TODO(), mock.AnythingOfType("string"), mock.Anything).
+			Return(nil).
+			Run(consumeBlob(t, &stored))
+		mockBS.
+			On("Delete", context.TODO(), mock.AnythingOfType("string")).
+			Return(nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		content := io.MultiReader(bytes.NewReader(mockPDF), zeroReader{})
+
+		_, err := as.StoreForSong(context.TODO(), mockSong.ID, "sheet.pdf", content, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Equal(t, domain.MaxAttachmentSize+1, stored.Len())
+		mockBS.AssertExpectations(t)
+		mockAR.AssertExpectations(t)
+	})
+
+	t.Run("Fail Create error removes blob", func(t *testing.T) {
+		t.Parallel()
+
+		var stored bytes.Buffer
+
+		expErr := domain.NewInternalErr()
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockBS.
+			On("Put", context.TODO(), mock.AnythingOfType("string"), mock.Anything).
+			Return(nil).
+			Run(consumeBlob(t, &stored))
+		mockBS.
+			On("Delete", context.TODO(), mock.AnythingOfType("string")).
+			Return(nil)
+		mockAR.
+			On("Create", context.TODO(), mock.AnythingOfType("*domain.Attachment")).
+			Return(expErr)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		_, err := as.StoreForSong(context.TODO(), mockSong.ID, "sheet.pdf", bytes.NewReader(mockPDF), mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		mockBS.AssertExpectations(t)
+		mockAR.AssertExpectations(t)
+	})
+}
+
+func TestAttachmentServiceStoreForEntry(t *testing.T) {
+	t.Parallel()
+
+	mockSetlist := &domain.Setlist{ID: 1, CreatorID: 2}
+	mockEntry := &domain.SetlistEntry{ID: 3, SetlistID: 1}
+
+	t.Run("Correct editor", func(t *testing.T) {
+		t.Parallel()
+
+		var stored bytes.Buffer
+
+		mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(mockSetlist, nil)
+		mockSLER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+		mockBS.
+			On("Put", context.TODO(), mock.AnythingOfType("string"), mock.Anything).
+			Return(nil).
+			Run(consumeBlob(t, &stored))
+		mockAR.
+			On("Create", context.TODO(), mock.AnythingOfType("*domain.Attachment")).
+			Return(nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		attachment, err := as.StoreForEntry(context.TODO(), mockSetlist.ID, mockEntry.ID, "mix.pdf", bytes.NewReader(mockPDF), mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SetlistEntryAttachment, attachment.OwnerType)
+		assert.Equal(t, mockEntry.ID, attachment.OwnerID)
+		mockSLER.AssertExpectations(t)
+		mockAR.AssertExpectations(t)
+	})
+
+	t.Run("Fail not creator", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(mockSetlist, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		_, err := as.StoreForEntry(context.TODO(), mockSetlist.ID, mockEntry.ID, "mix.pdf", bytes.NewReader(mockPDF), &domain.User{ID: 1, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		mockSLER.AssertExpectations(t)
+		mockBS.AssertExpectations(t)
+	})
+
+	t.Run("Fail setlist locked", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(&domain.Setlist{ID: 1, CreatorID: 2, Deadline: time.Now().Add(-time.Hour), Status: domain.SetlistPublished}, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		_, err := as.StoreForEntry(context.TODO(), mockSetlist.ID, mockEntry.ID, "mix.pdf", bytes.NewReader(mockPDF), &domain.User{ID: 2, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		mockSLER.AssertExpectations(t)
+		mockBS.AssertExpectations(t)
+		mockAR.AssertExpectations(t)
+	})
+
+	t.Run("Fail entry of other setlist", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("", "")
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), int64(4)).
+			Return(&domain.Setlist{ID: 4, CreatorID: 2}, nil)
+		mockSLER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		_, err := as.StoreForEntry(context.TODO(), 4, mockEntry.ID, "mix.pdf", bytes.NewReader(mockPDF), &domain.User{ID: 2, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		mockBS.AssertExpectations(t)
+	})
+}
+
+func TestAttachmentServiceFetchByEntry(t *testing.T) {
+	t.Parallel()
+
+	mockDraft := &domain.Setlist{ID: 1, CreatorID: 2, Status: domain.SetlistDraft}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockAttachments := []domain.Attachment{{ID: 1, OwnerType: domain.SetlistEntryAttachment, OwnerID: 3}}
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(&domain.Setlist{ID: 1, Status: domain.SetlistPublished}, nil)
+		mockSLER.
+			On("GetByID", context.TODO(), int64(3)).
+			Return(&domain.SetlistEntry{ID: 3, SetlistID: 1}, nil)
+		mockAR.
+			On("GetByOwner", context.TODO(), domain.SetlistEntryAttachment, int64(3)).
+			Return(mockAttachments, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		attachments, err := as.FetchByEntry(context.TODO(), 1, 3, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, mockAttachments, attachments)
+		mockAR.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Correct draft of creator", func(t *testing.T) {
+		t.Parallel()
+
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockDraft.ID).
+			Return(mockDraft, nil)
+		mockSLER.
+			On("GetByID", context.TODO(), int64(3)).
+			Return(&domain.SetlistEntry{ID: 3, SetlistID: mockDraft.ID}, nil)
+		mockAR.
+			On("GetByOwner", context.TODO(), domain.SetlistEntryAttachment, int64(3)).
+			Return([]domain.Attachment{}, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		attachments, err := as.FetchByEntry(context.TODO(), mockDraft.ID, 3, &domain.User{ID: mockDraft.CreatorID})
+		assert.NoError(t, err)
+		assert.Empty(t, attachments)
+		mockAR.AssertExpectations(t)
+	})
+
+	t.Run("Fail draft hidden", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("", "")
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockDraft.ID).
+			Return(mockDraft, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		attachments, err := as.FetchByEntry(context.TODO(), mockDraft.ID, 3, nil)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, attachments)
+		mockAR.AssertExpectations(t)
+		mockSLER.AssertExpectations(t)
+	})
+}
+
+func TestAttachmentServiceFetchByID(t *testing.T) {
+	t.Parallel()
+
+	mockAttachment := &domain.Attachment{ID: 1, OwnerType: domain.SetlistEntryAttachment, OwnerID: 3}
+	mockEntry := &domain.SetlistEntry{ID: 3, SetlistID: 2}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockAR.
+			On("GetByID", context.TODO(), mockAttachment.ID).
+			Return(mockAttachment, nil)
+		mockSLER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+		mockSLR.
+			On("GetByID", context.TODO(), mockEntry.SetlistID).
+			Return(&domain.Setlist{ID: 2, Status: domain.SetlistArchived}, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		attachment, err := as.FetchByID(context.TODO(), mockAttachment.ID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, mockAttachment, attachment)
+		mockAR.AssertExpectations(t)
+		mockSLER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Fail draft hidden", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("", "")
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+		mockMember := &domain.User{ID: 1, Permission: domain.MEMBER}
+
+		mockAR.
+			On("GetByID", context.TODO(), mockAttachment.ID).
+			Return(mockAttachment, nil)
+		mockSLER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+		mockSLR.
+			On("GetByID", context.TODO(), mockEntry.SetlistID).
+			Return(&domain.Setlist{ID: 2, CreatorID: 2, Status: domain.SetlistDraft}, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		attachment, err := as.FetchByID(context.TODO(), mockAttachment.ID, mockMember)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, attachment)
+
+		attachment, content, err := as.Open(context.TODO(), mockAttachment.ID, mockMember)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, attachment)
+		assert.Nil(t, content)
+		mockBS.AssertExpectations(t)
+	})
+}
+
+func TestAttachmentServiceOpen(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockAttachment := &domain.Attachment{ID: 1, OwnerType: domain.SongAttachment, StorageKey: "abc"}
+		mockContent := nopSeekCloser{bytes.NewReader(mockPDF)}
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockAR.
+			On("GetByID", context.TODO(), mockAttachment.ID).
+			Return(mockAttachment, nil)
+		mockBS.
+			On("Open", context.TODO(), mockAttachment.StorageKey).
+			Return(mockContent, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		attachment, content, err := as.Open(context.TODO(), mockAttachment.ID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, mockAttachment, attachment)
+		assert.Equal(t, mockContent, content)
+		mockBS.AssertExpectations(t)
+	})
+}
+
+func TestAttachmentServiceRemove(t *testing.T) {
+	t.Parallel()
+
+	mockAttachment := &domain.Attachment{ID: 1, CreatorID: 1, StorageKey: "abc"}
+
+	t.Run("Correct creator", func(t *testing.T) {
+		t.Parallel()
+
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockAR.
+			On("GetByID", context.TODO(), mockAttachment.ID).
+			Return(mockAttachment, nil)
+		mockAR.
+			On("Delete", context.TODO(), mockAttachment.ID).
+			Return(nil)
+		mockBS.
+			On("Delete", context.TODO(), mockAttachment.StorageKey).
+			Return(nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		err := as.Remove(context.TODO(), mockAttachment.ID, &domain.User{ID: 1, Permission: domain.MEMBER})
+		assert.NoError(t, err)
+		mockAR.AssertExpectations(t)
+		mockBS.AssertExpectations(t)
+	})
+
+	t.Run("Fail not creator", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockAR.
+			On("GetByID", context.TODO(), mockAttachment.ID).
+			Return(mockAttachment, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		err := as.Remove(context.TODO(), mockAttachment.ID, &domain.User{ID: 2, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		mockAR.AssertExpectations(t)
+		mockBS.AssertExpectations(t)
+	})
+
+	t.Run("Fail setlist locked", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockAR := &mocks.MockAttachmentRepository{}
+		mockBS := &mocks.MockBlobStore{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSLER := &mocks.MockSetlistEntryRepository{}
+
+		mockAR.
+			On("GetByID", context.TODO(), int64(2)).
+			Return(&domain.Attachment{ID: 2, CreatorID: 1, OwnerType: domain.SetlistEntryAttachment, OwnerID: 3, StorageKey: "def"}, nil)
+		mockSLER.
+			On("GetByID", context.TODO(), int64(3)).
+			Return(&domain.SetlistEntry{ID: 3, SetlistID: 4}, nil)
+		mockSLR.
+			On("GetByID", context.TODO(), int64(4)).
+			Return(&domain.Setlist{ID: 4, CreatorID: 1, Deadline: time.Now().Add(-time.Hour), Status: domain.SetlistPublished}, nil)
+
+		as := service.NewAttachmentService(mockAR, mockBS, mockSR, mockSLR, mockSLER)
+
+		err := as.Remove(context.TODO(), 2, &domain.User{ID: 1, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		mockAR.AssertExpectations(t)
+		mockSLER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+		mockBS.AssertExpectations(t)
+		mockAR.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
@@ -3,6 +3,7 @@ package service_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
@@ -201,7 +202,7 @@ func TestRemoveCorrect(t *testing.T) {
 	mockBR := &mocks.MockBundleRepository{}
 
 	mockBR.
-		On("Delete", context.TODO(), mockBundle.ID).
+		On("Delete", context.TODO(), mockBundle.ID, time.Time{}).
 		Return(nil)
 	mockBR.
 		On("GetByID", context.TODO(), mockBundle.ID).
@@ -213,7 +214,7 @@ func TestRemoveCorrect(t *testing.T) {
 	BS := service.NewBundleService(mockBR)
 	ctx := context.TODO()
 
-	err := BS.Remove(ctx, mockBundle.ID, mockUser)
+	err := BS.Remove(ctx, mockBundle.ID, time.Time{}, mockUser)
 	assert.NoError(t, err)
 	mockBR.AssertExpectations(t)
 }
@@ -252,7 +253,7 @@ func TestRemoveNotLeaf(t *testing.T) {
 	BS := service.NewBundleService(mockBR)
 	ctx := context.TODO()
 
-	err := BS.Remove(ctx, mockBundle.ID, mockUser)
+	err := BS.Remove(ctx, mockBundle.ID, time.Time{}, mockUser)
 	mockErr := domain.NewBadRequestErr("")
 	assert.ErrorAs(t, err, &mockErr)
 	mockBR.AssertExpectations(t)
@@ -285,7 +286,7 @@ func TestRemoveGetLeavesErr(t *testing.T) {
 	BS := service.NewBundleService(mockBR)
 	ctx := context.TODO()
 
-	err := BS.Remove(ctx, mockBundle.ID, mockUser)
+	err := BS.Remove(ctx, mockBundle.ID, time.Time{}, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
 	mockBR.AssertExpectations(t)
 }
@@ -309,7 +310,7 @@ func TestRemoveNoClearance(t *testing.T) {
 	BS := service.NewBundleService(mockBR)
 	ctx := context.TODO()
 
-	err := BS.Remove(ctx, mockBundle.ID, mockUser)
+	err := BS.Remove(ctx, mockBundle.ID, time.Time{}, mockUser)
 	mockErr := domain.NewNotAuthorizedErr("")
 	assert.ErrorAs(t, err, &mockErr)
 	mockBR.AssertExpectations(t)
@@ -339,7 +340,7 @@ func TestDeleteNoRecord(t *testing.T) {
 	BS := service.NewBundleService(mockBR)
 	ctx := context.TODO()
 
-	err := BS.Remove(ctx, mockBundle.ID, mockUser)
+	err := BS.Remove(ctx, mockBundle.ID, time.Time{}, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
 	mockBR.AssertExpectations(t)
 }
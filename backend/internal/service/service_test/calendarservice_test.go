@@ -0,0 +1,338 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type calendarMocks struct {
+	ctr  *mocks.MockCalendarTokenRepository
+	ur   *mocks.MockUserRepository
+	slr  *mocks.MockSetlistRepository
+	sler *mocks.MockSetlistEntryRepository
+	sr   *mocks.MockSongRepository
+	slrr *mocks.MockSetlistRoleRepository
+	urr  *mocks.MockUserRoleRepository
+}
+
+func newCalendarMocks() *calendarMocks {
+	return &calendarMocks{
+		ctr:  &mocks.MockCalendarTokenRepository{},
+		ur:   &mocks.MockUserRepository{},
+		slr:  &mocks.MockSetlistRepository{},
+		sler: &mocks.MockSetlistEntryRepository{},
+		sr:   &mocks.MockSongRepository{},
+		slrr: &mocks.MockSetlistRoleRepository{},
+		urr:  &mocks.MockUserRoleRepository{},
+	}
+}
+
+func (cm *calendarMocks) service() domain.CalendarService {
+	return service.NewCalendarService(cm.ctr, cm.ur, cm.slr, cm.sler, cm.sr, cm.slrr, cm.urr)
+}
+
+func (cm *calendarMocks) assertExpectations(t *testing.T) {
+	t.Helper()
+
+	cm.ctr.AssertExpectations(t)
+	cm.ur.AssertExpectations(t)
+	cm.slr.AssertExpectations(t)
+	cm.sler.AssertExpectations(t)
+	cm.sr.AssertExpectations(t)
+	cm.slrr.AssertExpectations(t)
+	cm.urr.AssertExpectations(t)
+}
+
+func TestCalendarFetchFeed(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	published := domain.Setlist{ID: 1, Name: "Sunday", CreatorID: 2, Deadline: deadline, Status: domain.SetlistPublished}
+	draft := domain.Setlist{ID: 2, Name: "Easter", CreatorID: 2, Deadline: deadline, Status: domain.SetlistDraft}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		cm := newCalendarMocks()
+
+		cm.slr.
+			On("GetAll", context.TODO()).
+			Return(&[]domain.Setlist{published, draft}, nil)
+		cm.sler.
+			On("GetBySetlist", context.TODO(), &[]domain.Setlist{published}).
+			Return(&[]domain.SetlistEntry{{ID: 1, SongID: 3, SetlistID: 1, Rank: 1000}}, nil)
+		cm.sr.
+			On("GetByID", context.TODO(), int64(3)).
+			Return(&domain.Song{ID: 3, Title: "Amazing Grace", Key: "G", Duration: 240}, nil)
+		cm.slrr.
+			On("Get", context.TODO(), []int64{1}).
+			Return(&[]domain.SetlistRole{{ID: 1, SetlistID: 1, UserRoleID: 5}}, nil)
+		cm.urr.
+			On("Get", context.TODO(), []int64{5}).
+			Return(&[]domain.UserRole{{
+				ID:   5,
+				User: &domain.User{FirstName: "Jane", LastName: "Doe"},
+				Role: &domain.Role{Name: "Vocals"},
+			}}, nil)
+
+		feed, err := cm.service().FetchFeed(context.TODO(), nil)
+		assert.NoError(t, err)
+
+		calendar := string(feed)
+		assert.Equal(t, 1, strings.Count(calendar, "BEGIN:VEVENT"))
+		assert.Contains(t, calendar, "UID:setlist-1@mkvstage")
+		assert.Contains(t, calendar, "SUMMARY:Sunday")
+		assert.Contains(t, calendar, "DURATION:PT240S")
+		assert.Contains(t, calendar, `DESCRIPTION:Songs:\n1. Amazing Grace (G)\nRoles:\nVocals: Jane Doe`)
+		assert.NotContains(t, calendar, "Easter")
+		cm.assertExpectations(t)
+	})
+
+	t.Run("Correct empty", func(t *testing.T) {
+		t.Parallel()
+
+		cm := newCalendarMocks()
+
+		cm.slr.
+			On("GetAll", context.TODO()).
+			Return(&[]domain.Setlist{draft}, nil)
+
+		feed, err := cm.service().FetchFeed(context.TODO(), nil)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(feed), "BEGIN:VCALENDAR\r\n"))
+		assert.NotContains(t, string(feed), "BEGIN:VEVENT")
+		cm.assertExpectations(t)
+	})
+
+	t.Run("Fail GetAll", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		cm := newCalendarMocks()
+
+		cm.slr.
+			On("GetAll", context.TODO()).
+			Return(nil, expErr)
+
+		feed, err := cm.service().FetchFeed(context.TODO(), nil)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, feed)
+		cm.assertExpectations(t)
+	})
+}
+
+func TestCalendarFetchPersonalFeed(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, FirstName: "Jane", LastName: "Doe", Permission: domain.MEMBER}
+	mockToken := &domain.CalendarToken{ID: 1, UserID: mockUser.ID, Token: "secret"}
+	deadline := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		assigned := domain.Setlist{ID: 4, Name: "Sunday", CreatorID: 2, Deadline: deadline, Status: domain.SetlistPublished}
+		cm := newCalendarMocks()
+
+		cm.ctr.
+			On("GetByToken", context.TODO(), "secret").
+			Return(mockToken, nil)
+		cm.ur.
+			On("GetByID", context.TODO(), mockUser.ID).
+			Return(mockUser, nil)
+		cm.urr.
+			On("GetByUID", context.TODO(), mockUser.ID).
+			Return(&[]domain.UserRole{{ID: 5}, {ID: 6}}, nil)
+		cm.slrr.
+			On("GetByUserRoles", context.TODO(), []int64{5, 6}).
+			Return(&[]domain.SetlistRole{{ID: 1, SetlistID: 4, UserRoleID: 5}, {ID: 2, SetlistID: 4, UserRoleID: 6}}, nil)
+		cm.slr.
+			On("GetByID", context.TODO(), int64(4)).
+			Return(&assigned, nil)
+		cm.sler.
+			On("GetBySetlist", context.TODO(), mock.Anything).
+			Return(&[]domain.SetlistEntry{}, nil)
+		cm.slrr.
+			On("Get", context.TODO(), []int64{4}).
+			Return(&[]domain.SetlistRole{}, nil)
+
+		feed, err := cm.service().FetchPersonalFeed(context.TODO(), "secret")
+		assert.NoError(t, err)
+		assert.Contains(t, string(feed), "X-WR-CALNAME:Setlists of Jane Doe")
+		assert.Equal(t, 1, strings.Count(string(feed), "UID:setlist-4@mkvstage"))
+		cm.assertExpectations(t)
+	})
+
+	t.Run("Correct setlist removed", func(t *testing.T) {
+		t.Parallel()
+
+		assigned := domain.Setlist{ID: 4, Name: "Sunday", CreatorID: 2, Deadline: deadline, Status: domain.SetlistPublished}
+		cm := newCalendarMocks()
+
+		cm.ctr.
+			On("GetByToken", context.TODO(), "secret").
+			Return(mockToken, nil)
+		cm.ur.
+			On("GetByID", context.TODO(), mockUser.ID).
+			Return(mockUser, nil)
+		cm.urr.
+			On("GetByUID", context.TODO(), mockUser.ID).
+			Return(&[]domain.UserRole{{ID: 5}}, nil)
+		cm.slrr.
+			On("GetByUserRoles", context.TODO(), []int64{5}).
+			Return(&[]domain.SetlistRole{{ID: 1, SetlistID: 3, UserRoleID: 5}, {ID: 2, SetlistID: 4, UserRoleID: 5}}, nil)
+		cm.slr.
+			On("GetByID", context.TODO(), int64(3)).
+			Return(nil, domain.NewRecordNotFoundErr("id", "3"))
+		cm.slr.
+			On("GetByID", context.TODO(), int64(4)).
+			Return(&assigned, nil)
+		cm.sler.
+			On("GetBySetlist", context.TODO(), mock.Anything).
+			Return(&[]domain.SetlistEntry{}, nil)
+		cm.slrr.
+			On("Get", context.TODO(), []int64{4}).
+			Return(&[]domain.SetlistRole{}, nil)
+
+		feed, err := cm.service().FetchPersonalFeed(context.TODO(), "secret")
+		assert.NoError(t, err)
+		assert.NotContains(t, string(feed), "UID:setlist-3@mkvstage")
+		assert.Equal(t, 1, strings.Count(string(feed), "UID:setlist-4@mkvstage"))
+		cm.assertExpectations(t)
+	})
+
+	t.Run("Correct no assignments", func(t *testing.T) {
+		t.Parallel()
+
+		cm := newCalendarMocks()
+
+		cm.ctr.
+			On("GetByToken", context.TODO(), "secret").
+			Return(mockToken, nil)
+		cm.ur.
+			On("GetByID", context.TODO(), mockUser.ID).
+			Return(mockUser, nil)
+		cm.urr.
+			On("GetByUID", context.TODO(), mockUser.ID).
+			Return(&[]domain.UserRole{}, nil)
+
+		feed, err := cm.service().FetchPersonalFeed(context.TODO(), "secret")
+		assert.NoError(t, err)
+		assert.NotContains(t, string(feed), "BEGIN:VEVENT")
+		cm.assertExpectations(t)
+	})
+
+	t.Run("Fail unknown token", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewObjectNotFoundErr("calendar")
+		cm := newCalendarMocks()
+
+		cm.ctr.
+			On("GetByToken", context.TODO(), "revoked").
+			Return(nil, expErr)
+
+		feed, err := cm.service().FetchPersonalFeed(context.TODO(), "revoked")
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, feed)
+		cm.assertExpectations(t)
+	})
+}
+
+func TestCalendarRotateToken(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		cm := newCalendarMocks()
+
+		cm.ctr.
+			On("Save", context.TODO(), mock.AnythingOfType("*domain.CalendarToken")).
+			Return(nil)
+
+		token, err := cm.service().RotateToken(context.TODO(), mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, mockUser.ID, token.UserID)
+		assert.Len(t, token.Token, 64)
+		cm.assertExpectations(t)
+	})
+
+	t.Run("Fail Save", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		cm := newCalendarMocks()
+
+		cm.ctr.
+			On("Save", context.TODO(), mock.AnythingOfType("*domain.CalendarToken")).
+			Return(expErr)
+
+		token, err := cm.service().RotateToken(context.TODO(), mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, token)
+		cm.assertExpectations(t)
+	})
+}
+
+func TestCalendarFetchToken(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+	mockToken := &domain.CalendarToken{ID: 1, UserID: mockUser.ID, Token: "secret"}
+	cm := newCalendarMocks()
+
+	cm.ctr.
+		On("GetByUser", context.TODO(), mockUser.ID).
+		Return(mockToken, nil)
+
+	token, err := cm.service().FetchToken(context.TODO(), mockUser)
+	assert.NoError(t, err)
+	assert.Equal(t, mockToken, token)
+	cm.assertExpectations(t)
+}
+
+func TestCalendarRevokeToken(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		cm := newCalendarMocks()
+
+		cm.ctr.
+			On("DeleteByUser", context.TODO(), mockUser.ID).
+			Return(nil)
+
+		err := cm.service().RevokeToken(context.TODO(), mockUser)
+		assert.NoError(t, err)
+		cm.assertExpectations(t)
+	})
+
+	t.Run("Fail no token", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("user_id", "1")
+		cm := newCalendarMocks()
+
+		cm.ctr.
+			On("DeleteByUser", context.TODO(), mockUser.ID).
+			Return(expErr)
+
+		err := cm.service().RevokeToken(context.TODO(), mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		cm.assertExpectations(t)
+	})
+}
@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestExportPDF(t *testing.T) {
+	t.Parallel()
+
+	mockSetlist := &domain.Setlist{
+		ID:       1,
+		Name:     "Foo",
+		Deadline: time.Date(2023, time.May, 7, 10, 0, 0, 0, time.UTC),
+		Status:   domain.SetlistPublished,
+	}
+	mockEntries := &[]domain.SetlistEntry{
+		{ID: 2, SongID: 2, SetlistID: 1, Rank: 2, Arrangement: datatypes.JSON([]byte(`["Verse"]`))},
+		{ID: 1, SongID: 1, SetlistID: 1, Rank: 1, Transpose: 2},
+		{ID: 3, Type: domain.HeaderItem, SetlistID: 1, Rank: 3, Title: "Offering"},
+	}
+	mockSongs := []*domain.Song{
+		{ID: 1, Title: "Bar", Key: "G", ChordSheet: datatypes.JSON([]byte(`{"Verse":"[G]Bar"}`))},
+		{ID: 2, Title: "Baz", Key: "C", ChordSheet: datatypes.JSON([]byte(`{"Verse":"[C]Baz"}`))},
+	}
+	mockSetlistRoles := &[]domain.SetlistRole{
+		{ID: 1, SetlistID: 1, UserRoleID: 3},
+	}
+	mockUserRoles := &[]domain.UserRole{
+		{ID: 3, User: &domain.User{FirstName: "John", LastName: "Doe"}, Role: &domain.Role{Name: "Keys"}},
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLRR := &mocks.MockSetlistRoleRepository{}
+		mockURR := &mocks.MockUserRoleRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(mockSetlist, nil)
+		mockSLRR.
+			On("Get", context.TODO(), []int64{mockSetlist.ID}).
+			Return(mockSetlistRoles, nil)
+		mockURR.
+			On("Get", context.TODO(), []int64{3}).
+			Return(mockUserRoles, nil)
+		mockSER.
+			On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
+			Return(mockEntries, nil)
+		mockSR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(mockSongs[0], nil)
+		mockSR.
+			On("GetByID", context.TODO(), int64(2)).
+			Return(mockSongs[1], nil)
+
+		es := service.NewExportService(mockSLR, mockSER, mockSR, mockSLRR, mockURR)
+
+		pdf, err := es.ExportPDF(context.TODO(), mockSetlist.ID, nil)
+		assert.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-")))
+		assert.Contains(t, string(pdf), "(Keys: John Doe) Tj")
+		assert.Contains(t, string(pdf), "(1. Bar \\(A\\)) Tj")
+		assert.Contains(t, string(pdf), "(2. Baz \\(C\\)) Tj")
+		assert.NotContains(t, string(pdf), "Offering")
+		mockSLR.AssertExpectations(t)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+		mockSLRR.AssertExpectations(t)
+		mockURR.AssertExpectations(t)
+	})
+
+	t.Run("Fail setlist not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewRecordNotFoundErr("id", "1")
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLRR := &mocks.MockSetlistRoleRepository{}
+		mockURR := &mocks.MockUserRoleRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(nil, mockErr)
+
+		es := service.NewExportService(mockSLR, mockSER, mockSR, mockSLRR, mockURR)
+
+		pdf, err := es.ExportPDF(context.TODO(), mockSetlist.ID, nil)
+		assert.ErrorAs(t, err, &mockErr)
+		assert.Nil(t, pdf)
+		mockSLR.AssertExpectations(t)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+		mockSLRR.AssertExpectations(t)
+		mockURR.AssertExpectations(t)
+	})
+
+	t.Run("Fail draft of someone else", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewRecordNotFoundErr("id", "1")
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLRR := &mocks.MockSetlistRoleRepository{}
+		mockURR := &mocks.MockUserRoleRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(&domain.Setlist{ID: 1, CreatorID: 2, Status: domain.SetlistDraft}, nil)
+
+		es := service.NewExportService(mockSLR, mockSER, mockSR, mockSLRR, mockURR)
+
+		pdf, err := es.ExportPDF(context.TODO(), mockSetlist.ID, &domain.User{ID: 1, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &mockErr)
+		assert.Nil(t, pdf)
+		mockSLR.AssertExpectations(t)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+		mockSLRR.AssertExpectations(t)
+		mockURR.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid arrangement", func(t *testing.T) {
+		t.Parallel()
+
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockSLRR := &mocks.MockSetlistRoleRepository{}
+		mockURR := &mocks.MockUserRoleRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(mockSetlist, nil)
+		mockSLRR.
+			On("Get", context.TODO(), []int64{mockSetlist.ID}).
+			Return(&[]domain.SetlistRole{}, nil)
+		mockSER.
+			On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
+			Return(&[]domain.SetlistEntry{
+				{ID: 1, SongID: 1, SetlistID: 1, Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1"]}`))},
+			}, nil)
+		mockSR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(mockSongs[0], nil)
+
+		es := service.NewExportService(mockSLR, mockSER, mockSR, mockSLRR, mockURR)
+
+		pdf, err := es.ExportPDF(context.TODO(), mockSetlist.ID, nil)
+		expErr := &domain.Error{}
+		assert.ErrorAs(t, err, &expErr)
+		assert.Equal(t, domain.BadRequest, expErr.Type)
+		assert.Nil(t, pdf)
+		mockSLR.AssertExpectations(t)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+		mockSLRR.AssertExpectations(t)
+		mockURR.AssertExpectations(t)
+	})
+}
@@ -29,7 +29,7 @@ func TestRSFetchByIDCorrect(t *testing.T) {
 		On("GetByID", context.TODO(), rid).
 		Return(mockRole, nil)
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	role, err := RS.FetchByID(context.TODO(), rid)
 	assert.NoError(t, err)
@@ -52,7 +52,7 @@ func TestRSFetchByIDGetErr(t *testing.T) {
 		On("GetByID", context.TODO(), rid).
 		Return(nil, mockErr)
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	role, err := RS.FetchByID(context.TODO(), rid)
 	assert.ErrorAs(t, err, &mockErr)
@@ -86,7 +86,7 @@ func TestRSFetchAllCorrect(t *testing.T) {
 		On("GetAll", context.TODO()).
 		Return(mockRoles, nil)
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	role, err := RS.FetchAll(context.TODO())
 	assert.NoError(t, err)
@@ -108,7 +108,7 @@ func TestRSFetchAllGetErr(t *testing.T) {
 		On("GetAll", context.TODO()).
 		Return(nil, mockErr)
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	role, err := RS.FetchAll(context.TODO())
 	assert.ErrorAs(t, err, &mockErr)
@@ -151,7 +151,7 @@ func TestRSUpdateCorrect(t *testing.T) {
 		On("GetByID", context.TODO(), rid).
 		Return(prevMockRole, nil)
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	err := RS.Update(context.TODO(), mockRole, mockUser)
 
@@ -180,7 +180,7 @@ func TestRSUpdateIDZero(t *testing.T) {
 	mockUR := &mocks.MockUserRepository{}
 	mockURR := &mocks.MockUserRoleRepository{}
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	err := RS.Update(context.TODO(), mockRole, mockUser)
 	mockErr := domain.NewBadRequestErr("")
@@ -209,7 +209,7 @@ func TestRSUpdateNoPermission(t *testing.T) {
 	mockUR := &mocks.MockUserRepository{}
 	mockURR := &mocks.MockUserRoleRepository{}
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	err := RS.Update(context.TODO(), mockRole, mockUser)
 	mockErr := domain.NewNotAuthorizedErr("")
@@ -243,7 +243,7 @@ func TestRSUpdateNoRecord(t *testing.T) {
 		On("GetByID", context.TODO(), rid).
 		Return(nil, mockErr)
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	err := RS.Update(context.TODO(), mockRole, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
@@ -286,7 +286,7 @@ func TestRSUpdateErr(t *testing.T) {
 		On("GetByID", context.TODO(), rid).
 		Return(prevMockRole, nil)
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	err := RS.Update(context.TODO(), mockRole, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
@@ -333,6 +333,7 @@ func TestRSStoreCorrect(t *testing.T) {
 	mockUR := &mocks.MockUserRepository{}
 	mockRR := &mocks.MockRoleRepository{}
 	mockURR := &mocks.MockUserRoleRepository{}
+	mockTX := &mocks.MockTransactor{}
 
 	mockUR.
 		On("GetAll", context.TODO()).
@@ -350,13 +351,18 @@ func TestRSStoreCorrect(t *testing.T) {
 			arg.ID = 1
 		})
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	mockTX.
+		On("WithinTransaction", context.TODO()).
+		Return(nil)
+
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, mockTX)
 
 	err := RS.Store(context.TODO(), mockRole, mockUser)
 	assert.NoError(t, err)
 	mockRR.AssertExpectations(t)
 	mockUR.AssertExpectations(t)
 	mockURR.AssertExpectations(t)
+	mockTX.AssertExpectations(t)
 }
 
 func TestRSStoreNoPermission(t *testing.T) {
@@ -377,7 +383,7 @@ func TestRSStoreNoPermission(t *testing.T) {
 	mockURR := &mocks.MockUserRoleRepository{}
 	mockRR := &mocks.MockRoleRepository{}
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	err := RS.Store(context.TODO(), mockRole, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
@@ -402,19 +408,25 @@ func TestRSStoreErr(t *testing.T) {
 	mockErr := domain.NewInternalErr()
 	mockUR := &mocks.MockUserRepository{}
 	mockURR := &mocks.MockUserRoleRepository{}
+	mockTX := &mocks.MockTransactor{}
 	mockRR := &mocks.MockRoleRepository{}
 
 	mockRR.
 		On("Create", context.TODO(), mockRole).
 		Return(mockErr)
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	mockTX.
+		On("WithinTransaction", context.TODO()).
+		Return(nil)
+
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, mockTX)
 
 	err := RS.Store(context.TODO(), mockRole, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
 	mockRR.AssertExpectations(t)
 	mockUR.AssertExpectations(t)
 	mockURR.AssertExpectations(t)
+	mockTX.AssertExpectations(t)
 }
 
 func TestRSStoreGetAllUserErr(t *testing.T) {
@@ -433,6 +445,7 @@ func TestRSStoreGetAllUserErr(t *testing.T) {
 	mockErr := domain.NewInternalErr()
 	mockUR := &mocks.MockUserRepository{}
 	mockURR := &mocks.MockUserRoleRepository{}
+	mockTX := &mocks.MockTransactor{}
 	mockRR := &mocks.MockRoleRepository{}
 
 	mockUR.
@@ -447,13 +460,18 @@ func TestRSStoreGetAllUserErr(t *testing.T) {
 			arg.ID = 1
 		})
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	mockTX.
+		On("WithinTransaction", context.TODO()).
+		Return(nil)
+
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, mockTX)
 
 	err := RS.Store(context.TODO(), mockRole, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
 	mockRR.AssertExpectations(t)
 	mockUR.AssertExpectations(t)
 	mockURR.AssertExpectations(t)
+	mockTX.AssertExpectations(t)
 }
 
 func TestRSStoreCreateUserRolesErr(t *testing.T) {
@@ -494,6 +512,7 @@ func TestRSStoreCreateUserRolesErr(t *testing.T) {
 	mockErr := domain.NewInternalErr()
 	mockUR := &mocks.MockUserRepository{}
 	mockURR := &mocks.MockUserRoleRepository{}
+	mockTX := &mocks.MockTransactor{}
 	mockRR := &mocks.MockRoleRepository{}
 
 	mockUR.
@@ -511,13 +530,18 @@ func TestRSStoreCreateUserRolesErr(t *testing.T) {
 			arg.ID = 1
 		})
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	mockTX.
+		On("WithinTransaction", context.TODO()).
+		Return(nil)
+
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, mockTX)
 
 	err := RS.Store(context.TODO(), mockRole, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
 	mockRR.AssertExpectations(t)
 	mockUR.AssertExpectations(t)
 	mockURR.AssertExpectations(t)
+	mockTX.AssertExpectations(t)
 }
 
 func TestRSDeleteCorrect(t *testing.T) {
@@ -541,7 +565,7 @@ func TestRSDeleteCorrect(t *testing.T) {
 		On("Delete", context.TODO(), rid).
 		Return(nil)
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	err := RS.Remove(context.TODO(), rid, mockUser)
 	assert.NoError(t, err)
@@ -564,7 +588,7 @@ func TestRSDeleteNoPermission(t *testing.T) {
 	mockURR := &mocks.MockUserRoleRepository{}
 	mockRR := &mocks.MockRoleRepository{}
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	err := RS.Remove(context.TODO(), rid, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
@@ -592,7 +616,7 @@ func TestRSDeleteErr(t *testing.T) {
 		On("Delete", context.TODO(), rid).
 		Return(mockErr)
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	err := RS.Remove(context.TODO(), rid, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
@@ -623,7 +647,7 @@ func TestRSDeleteDeleteByRIDErr(t *testing.T) {
 		On("Delete", context.TODO(), rid).
 		Return(nil)
 
-	RS := service.NewRoleService(mockRR, mockUR, mockURR)
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, &mocks.MockTransactor{})
 
 	err := RS.Remove(context.TODO(), rid, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
@@ -631,3 +655,36 @@ func TestRSDeleteDeleteByRIDErr(t *testing.T) {
 	mockUR.AssertExpectations(t)
 	mockURR.AssertExpectations(t)
 }
+
+func TestRSStoreTransactionErr(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.ADMIN,
+	}
+
+	mockRole := &domain.Role{
+		Name:        "Foo",
+		Description: "Foobar",
+	}
+
+	mockErr := domain.NewInternalErr()
+	mockUR := &mocks.MockUserRepository{}
+	mockURR := &mocks.MockUserRoleRepository{}
+	mockTX := &mocks.MockTransactor{}
+	mockRR := &mocks.MockRoleRepository{}
+
+	mockTX.
+		On("WithinTransaction", context.TODO()).
+		Return(mockErr)
+
+	RS := service.NewRoleService(mockRR, mockUR, mockURR, mockTX)
+
+	err := RS.Store(context.TODO(), mockRole, mockUser)
+	assert.ErrorAs(t, err, &mockErr)
+	mockRR.AssertExpectations(t)
+	mockUR.AssertExpectations(t)
+	mockURR.AssertExpectations(t)
+	mockTX.AssertExpectations(t)
+}
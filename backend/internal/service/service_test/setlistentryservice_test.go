@@ -3,6 +3,7 @@ package service_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
@@ -13,6 +14,11 @@ import (
 	"gorm.io/datatypes"
 )
 
+var mockArrangedSong = &domain.Song{
+	Key:        "G",
+	ChordSheet: datatypes.JSON([]byte(`{"Verse 1": "[G]Foo", "Verse 2": "[C]Bar", "Chorus": "[D]Baz"}`)),
+}
+
 func TestSetlistEntryStoreBatchCorrect(t *testing.T) {
 	t.Parallel()
 
@@ -27,30 +33,31 @@ func TestSetlistEntryStoreBatchCorrect(t *testing.T) {
 			SetlistID:   setlistID,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			SongID:      2,
 			SetlistID:   setlistID,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
 	for _, entry := range *mockSetlistEntries {
 		mockSR.
 			On("GetByID", context.TODO(), entry.SongID).
-			Return(nil, nil)
+			Return(mockArrangedSong, nil)
 	}
 
 	mockSLR.
 		On("GetByID", context.TODO(), setlistID).
-		Return(nil, nil)
+		Return(&domain.Setlist{ID: setlistID, Deadline: time.Now().Add(time.Hour)}, nil)
 	mockSER.
 		On("CreateBatch", context.TODO(), mockSetlistEntries).
 		Return(nil).
@@ -63,7 +70,7 @@ func TestSetlistEntryStoreBatchCorrect(t *testing.T) {
 			}
 		})
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.NoError(t, err)
@@ -86,23 +93,24 @@ func TestSetlistEntryStoreBatchPrincipalNil(t *testing.T) {
 			SongID:      1,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.StoreBatch(context.TODO(), mockSetlistEntries, nil)
 	assert.Error(t, err)
@@ -126,23 +134,24 @@ func TestSetlistEntryStoreBatchClearanceErr(t *testing.T) {
 			SongID:      1,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewNotAuthorizedErr("")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -162,10 +171,11 @@ func TestSetlistEntryStoreBatchSetlistEntriesNil(t *testing.T) {
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.StoreBatch(context.TODO(), nil, mockUser)
 	assert.Error(t, err)
@@ -186,10 +196,11 @@ func TestSetlistEntryStoreBatchEmptySetlistEntries(t *testing.T) {
 	mockSetlistEntries := &[]domain.SetlistEntry{}
 
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.NoError(t, err)
@@ -212,23 +223,24 @@ func TestSetlistEntryStoreBatchInvalidTranspose(t *testing.T) {
 			SongID:      1,
 			Transpose:   util.TransposeMax + 1,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewBadRequestErr("")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -252,19 +264,20 @@ func TestSetlistEntryStoreBatchSongGetByIDErr(t *testing.T) {
 			SongID:      1,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewBadRequestErr("")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
@@ -272,7 +285,7 @@ func TestSetlistEntryStoreBatchSongGetByIDErr(t *testing.T) {
 		On("GetByID", context.TODO(), (*mockSetlistEntries)[0].SongID).
 		Return(nil, mockErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -297,7 +310,7 @@ func TestSetlistEntryStoreBatchSetlistGetByIDErr(t *testing.T) {
 			SetlistID:   setlistID,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
@@ -305,26 +318,27 @@ func TestSetlistEntryStoreBatchSetlistGetByIDErr(t *testing.T) {
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
 	for _, entry := range *mockSetlistEntries {
 		mockSR.
 			On("GetByID", context.TODO(), entry.SongID).
-			Return(nil, nil)
+			Return(mockArrangedSong, nil)
 	}
 
 	mockSLR.
 		On("GetByID", context.TODO(), setlistID).
 		Return(nil, mockErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -334,6 +348,44 @@ func TestSetlistEntryStoreBatchSetlistGetByIDErr(t *testing.T) {
 	mockSR.AssertExpectations(t)
 }
 
+func TestSetlistEntryStoreBatchSetlistLocked(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+	setlistID := int64(1)
+	mockSetlistEntries := &[]domain.SetlistEntry{
+		{
+			SongID:      1,
+			SetlistID:   setlistID,
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
+		},
+	}
+
+	mockErr := domain.NewNotAuthorizedErr("")
+	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
+	mockSLR := &mocks.MockSetlistRepository{}
+	mockSR := &mocks.MockSongRepository{}
+
+	mockSR.
+		On("GetByID", context.TODO(), int64(1)).
+		Return(mockArrangedSong, nil)
+	mockSLR.
+		On("GetByID", context.TODO(), setlistID).
+		Return(&domain.Setlist{ID: setlistID, Deadline: time.Now().Add(-time.Hour), Status: domain.SetlistPublished}, nil)
+
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+	err := slr.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
+	assert.ErrorAs(t, err, &mockErr)
+	mockSER.AssertExpectations(t)
+	mockSLR.AssertExpectations(t)
+	mockSR.AssertExpectations(t)
+}
+
 func TestSetlistEntryStoreBatchDifferentSetlistIDs(t *testing.T) {
 	t.Parallel()
 
@@ -349,7 +401,7 @@ func TestSetlistEntryStoreBatchDifferentSetlistIDs(t *testing.T) {
 			SetlistID:   setlistID,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
@@ -357,22 +409,23 @@ func TestSetlistEntryStoreBatchDifferentSetlistIDs(t *testing.T) {
 			SetlistID:   0,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
 	for _, entry := range *mockSetlistEntries {
 		mockSR.
 			On("GetByID", context.TODO(), entry.SongID).
-			Return(nil, nil)
+			Return(mockArrangedSong, nil)
 	}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -397,7 +450,7 @@ func TestSetlistEntryStoreBatchCreateBatchErr(t *testing.T) {
 			SetlistID:   setlistID,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
@@ -405,29 +458,30 @@ func TestSetlistEntryStoreBatchCreateBatchErr(t *testing.T) {
 			SetlistID:   setlistID,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
 	for _, entry := range *mockSetlistEntries {
 		mockSR.
 			On("GetByID", context.TODO(), entry.SongID).
-			Return(nil, nil)
+			Return(mockArrangedSong, nil)
 	}
 
 	mockSLR.
 		On("GetByID", context.TODO(), setlistID).
-		Return(nil, nil)
+		Return(&domain.Setlist{ID: setlistID, Deadline: time.Now().Add(time.Hour)}, nil)
 	mockSER.
 		On("CreateBatch", context.TODO(), mockSetlistEntries).
 		Return(mockErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -446,10 +500,11 @@ func TestSetlistEntryFetchByIDCorrect(t *testing.T) {
 		SongID:      1,
 		Transpose:   0,
 		Notes:       "",
-		Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+		Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 	}
 
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
@@ -457,7 +512,7 @@ func TestSetlistEntryFetchByIDCorrect(t *testing.T) {
 		On("GetByID", context.TODO(), slid).
 		Return(mockSetlistEntry, nil)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	setlistEntry, err := slr.FetchByID(context.TODO(), slid)
 	assert.NoError(t, err)
@@ -475,6 +530,7 @@ func TestSetlistEntryFetchByIDErr(t *testing.T) {
 
 	expErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
@@ -482,7 +538,7 @@ func TestSetlistEntryFetchByIDErr(t *testing.T) {
 		On("GetByID", context.TODO(), slid).
 		Return(nil, expErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	setlist, err := slr.FetchByID(context.TODO(), slid)
 	assert.ErrorAs(t, err, &expErr)
@@ -502,7 +558,7 @@ func TestSetlistEntryFetchAllCorrect(t *testing.T) {
 			SongID:      1,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 			Rank:        100,
 		},
 		{
@@ -510,12 +566,13 @@ func TestSetlistEntryFetchAllCorrect(t *testing.T) {
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 			Rank:        200,
 		},
 	}
 
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
@@ -523,7 +580,7 @@ func TestSetlistEntryFetchAllCorrect(t *testing.T) {
 		On("GetAll", context.TODO()).
 		Return(mockSetlistEntries, nil)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	setlistEntries, err := slr.FetchAll(context.TODO())
 	assert.NoError(t, err)
@@ -538,6 +595,7 @@ func TestSetlistEntryFetchAllErr(t *testing.T) {
 
 	expErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
@@ -545,7 +603,7 @@ func TestSetlistEntryFetchAllErr(t *testing.T) {
 		On("GetAll", context.TODO()).
 		Return(nil, expErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	setlist, err := slr.FetchAll(context.TODO())
 	assert.ErrorAs(t, err, &expErr)
@@ -555,7 +613,7 @@ func TestSetlistEntryFetchAllErr(t *testing.T) {
 	mockSR.AssertExpectations(t)
 }
 
-func TestSetlistEntryFetchAllIncorrectRank(t *testing.T) {
+func TestSetlistEntryFetchAllOutOfOrder(t *testing.T) {
 	t.Parallel()
 
 	mockSetlistEntries := &[]domain.SetlistEntry{
@@ -564,7 +622,7 @@ func TestSetlistEntryFetchAllIncorrectRank(t *testing.T) {
 			SongID:      1,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 			Rank:        300,
 		},
 		{
@@ -572,13 +630,13 @@ func TestSetlistEntryFetchAllIncorrectRank(t *testing.T) {
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 			Rank:        200,
 		},
 	}
 
-	expErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
@@ -586,11 +644,11 @@ func TestSetlistEntryFetchAllIncorrectRank(t *testing.T) {
 		On("GetAll", context.TODO()).
 		Return(mockSetlistEntries, nil)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	setlistEntries, err := slr.FetchAll(context.TODO())
-	assert.EqualError(t, err, expErr.Error())
-	assert.Nil(t, setlistEntries)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 1}, []int64{(*setlistEntries)[0].ID, (*setlistEntries)[1].ID})
 	mockSER.AssertExpectations(t)
 	mockSLR.AssertExpectations(t)
 	mockSR.AssertExpectations(t)
@@ -608,7 +666,7 @@ func TestSetlistEntryFetchBySetlistCorrect(t *testing.T) {
 			Transpose:   0,
 			SetlistID:   mockSetlist.ID,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
@@ -616,11 +674,12 @@ func TestSetlistEntryFetchBySetlistCorrect(t *testing.T) {
 			Transpose:   1,
 			SetlistID:   mockSetlist.ID,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
@@ -628,7 +687,7 @@ func TestSetlistEntryFetchBySetlistCorrect(t *testing.T) {
 		On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
 		Return(mockSetlistEntries, nil)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	setlistEntries, err := slr.FetchBySetlist(context.TODO(), &[]domain.Setlist{*mockSetlist})
 	assert.NoError(t, err)
@@ -645,6 +704,7 @@ func TestSetlistEntryFetchBySetlistSetlistNil(t *testing.T) {
 
 	expErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
@@ -652,7 +712,7 @@ func TestSetlistEntryFetchBySetlistSetlistNil(t *testing.T) {
 		On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
 		Return(nil, expErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	setlist, err := slr.FetchBySetlist(context.TODO(), &[]domain.Setlist{*mockSetlist})
 	assert.ErrorAs(t, err, &expErr)
@@ -667,10 +727,11 @@ func TestSetlistEntryFetchBySetlistGetBySetlistErr(t *testing.T) {
 
 	expErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	setlist, err := slr.FetchBySetlist(context.TODO(), nil)
 	assert.ErrorAs(t, err, &expErr)
@@ -680,7 +741,7 @@ func TestSetlistEntryFetchBySetlistGetBySetlistErr(t *testing.T) {
 	mockSR.AssertExpectations(t)
 }
 
-func TestSetlistEntryFetchBySetlistIncorrectRank(t *testing.T) {
+func TestSetlistEntryFetchBySetlistOutOfOrder(t *testing.T) {
 	t.Parallel()
 
 	mockSetlist := &domain.Setlist{ID: 1}
@@ -692,7 +753,7 @@ func TestSetlistEntryFetchBySetlistIncorrectRank(t *testing.T) {
 			Transpose:   0,
 			SetlistID:   mockSetlist.ID,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 			Rank:        300,
 		},
 		{
@@ -701,13 +762,13 @@ func TestSetlistEntryFetchBySetlistIncorrectRank(t *testing.T) {
 			Transpose:   1,
 			SetlistID:   mockSetlist.ID,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 			Rank:        200,
 		},
 	}
 
-	expErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
@@ -715,11 +776,11 @@ func TestSetlistEntryFetchBySetlistIncorrectRank(t *testing.T) {
 		On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
 		Return(mockSetlistEntries, nil)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	setlistEntries, err := slr.FetchBySetlist(context.TODO(), &[]domain.Setlist{*mockSetlist})
-	assert.EqualError(t, err, expErr.Error())
-	assert.Nil(t, setlistEntries)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 1}, []int64{(*setlistEntries)[0].ID, (*setlistEntries)[1].ID})
 	mockSER.AssertExpectations(t)
 	mockSLR.AssertExpectations(t)
 	mockSR.AssertExpectations(t)
@@ -740,7 +801,7 @@ func TestSetlistEntryUpdateBatchCorrect(t *testing.T) {
 			SetlistID:   setlistID,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
@@ -748,32 +809,33 @@ func TestSetlistEntryUpdateBatchCorrect(t *testing.T) {
 			SetlistID:   setlistID,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
 	for _, entry := range *mockSetlistEntries {
 		mockSR.
 			On("GetByID", context.TODO(), entry.SongID).
-			Return(nil, nil)
+			Return(mockArrangedSong, nil)
 		mockSER.
 			On("GetByID", context.TODO(), entry.ID).
-			Return(nil, nil)
+			Return(&domain.SetlistEntry{ID: entry.ID, SetlistID: entry.SetlistID}, nil)
 	}
 
 	mockSLR.
 		On("GetByID", context.TODO(), setlistID).
-		Return(nil, nil)
+		Return(&domain.Setlist{ID: setlistID, Deadline: time.Now().Add(time.Hour)}, nil)
 
 	mockSER.
 		On("UpdateBatch", context.TODO(), mockSetlistEntries).
 		Return(nil)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.UpdateBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.NoError(t, err)
@@ -791,23 +853,24 @@ func TestSetlistEntryUpdateBatchPrincipalNil(t *testing.T) {
 			SongID:      1,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.UpdateBatch(context.TODO(), mockSetlistEntries, nil)
 	assert.Error(t, err)
@@ -831,23 +894,24 @@ func TestSetlistEntryUpdateBatchClearanceErr(t *testing.T) {
 			SongID:      1,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewNotAuthorizedErr("")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.UpdateBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -867,10 +931,11 @@ func TestSetlistEntryUpdateBatchSetlistEntriesNil(t *testing.T) {
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.UpdateBatch(context.TODO(), nil, mockUser)
 	assert.Error(t, err)
@@ -894,19 +959,20 @@ func TestSetlistEntryUpdateBatchSongRepoErr(t *testing.T) {
 			SongID:      1,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewBadRequestErr("")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSR := &mocks.MockSongRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 
@@ -914,7 +980,7 @@ func TestSetlistEntryUpdateBatchSongRepoErr(t *testing.T) {
 		On("GetByID", context.TODO(), (*mockSetlistEntries)[0].SongID).
 		Return(nil, mockErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.UpdateBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -938,23 +1004,24 @@ func TestSetlistEntryUpdateBatchInvalidTranspose(t *testing.T) {
 			SongID:      1,
 			Transpose:   util.TransposeMax + 1,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewBadRequestErr("")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSR := &mocks.MockSongRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.UpdateBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -978,32 +1045,33 @@ func TestSetlistEntryUpdateBatchInvalidID(t *testing.T) {
 			SongID:      1,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewBadRequestErr("")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 
 	mockSR := &mocks.MockSongRepository{}
 
 	mockSR.
 		On("GetByID", context.TODO(), (*mockSetlistEntries)[0].SongID).
-		Return(nil, nil)
+		Return(mockArrangedSong, nil)
 
 	mockSER.
 		On("GetByID", context.TODO(), (*mockSetlistEntries)[0].ID).
 		Return(nil, mockErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.UpdateBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -1028,7 +1096,7 @@ func TestSetlistEntryUpdateBatchSetlistGetByIDErr(t *testing.T) {
 			SetlistID:   setlistID,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
@@ -1036,29 +1104,30 @@ func TestSetlistEntryUpdateBatchSetlistGetByIDErr(t *testing.T) {
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
 	for _, entry := range *mockSetlistEntries {
 		mockSR.
 			On("GetByID", context.TODO(), entry.SongID).
-			Return(nil, nil)
+			Return(mockArrangedSong, nil)
 		mockSER.
 			On("GetByID", context.TODO(), entry.ID).
-			Return(nil, nil)
+			Return(&domain.SetlistEntry{ID: entry.ID, SetlistID: entry.SetlistID}, nil)
 	}
 
 	mockSLR.
 		On("GetByID", context.TODO(), setlistID).
 		Return(nil, mockErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.UpdateBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -1089,7 +1158,7 @@ func TestSetlistEntryUpdateBatchSetlistDifferentSetlistID(t *testing.T) {
 			SetlistID:   mockSetlist.ID,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
@@ -1097,25 +1166,26 @@ func TestSetlistEntryUpdateBatchSetlistDifferentSetlistID(t *testing.T) {
 			SongID:      2,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewBadRequestErr("")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
 	for _, entry := range *mockSetlistEntries {
 		mockSR.
 			On("GetByID", context.TODO(), entry.SongID).
-			Return(nil, nil)
+			Return(mockArrangedSong, nil)
 		mockSER.
 			On("GetByID", context.TODO(), entry.ID).
-			Return(nil, nil)
+			Return(&domain.SetlistEntry{ID: entry.ID, SetlistID: entry.SetlistID}, nil)
 	}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.UpdateBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -1125,6 +1195,47 @@ func TestSetlistEntryUpdateBatchSetlistDifferentSetlistID(t *testing.T) {
 	mockSR.AssertExpectations(t)
 }
 
+func TestSetlistEntryUpdateBatchForeignEntry(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+
+	setlistID := int64(1)
+	mockSetlistEntries := &[]domain.SetlistEntry{
+		{
+			ID:          5,
+			SongID:      1,
+			SetlistID:   setlistID,
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
+		},
+	}
+
+	mockErr := domain.NewBadRequestErr("")
+	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
+	mockSR := &mocks.MockSongRepository{}
+	mockSLR := &mocks.MockSetlistRepository{}
+
+	mockSR.
+		On("GetByID", context.TODO(), int64(1)).
+		Return(mockArrangedSong, nil)
+	mockSER.
+		On("GetByID", context.TODO(), int64(5)).
+		Return(&domain.SetlistEntry{ID: 5, SetlistID: 2}, nil)
+
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+	err := slr.UpdateBatch(context.TODO(), mockSetlistEntries, mockUser)
+	assert.ErrorAs(t, err, &mockErr)
+	mockSR.AssertExpectations(t)
+	mockSLR.AssertExpectations(t)
+	mockSER.AssertExpectations(t)
+	mockSER.AssertNotCalled(t, "UpdateBatch", mock.Anything, mock.Anything)
+}
+
 func TestSetlistEntryUpdateBatchSetlistEntryGetByIDErr(t *testing.T) {
 	t.Parallel()
 
@@ -1141,7 +1252,7 @@ func TestSetlistEntryUpdateBatchSetlistEntryGetByIDErr(t *testing.T) {
 			SetlistID:   setlistID,
 			Transpose:   0,
 			Notes:       "",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "C1"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
 		},
 		{
 			ID:          2,
@@ -1149,33 +1260,34 @@ func TestSetlistEntryUpdateBatchSetlistEntryGetByIDErr(t *testing.T) {
 			SetlistID:   setlistID,
 			Transpose:   1,
 			Notes:       "Foobar",
-			Arrangement: datatypes.JSON([]byte(`{arrangement: ["V1", "V2"]}`)),
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Verse 2"]`)),
 		},
 	}
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSR := &mocks.MockSongRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 
 	for _, entry := range *mockSetlistEntries {
 		mockSR.
 			On("GetByID", context.TODO(), entry.SongID).
-			Return(nil, nil)
+			Return(mockArrangedSong, nil)
 		mockSER.
 			On("GetByID", context.TODO(), entry.ID).
-			Return(nil, nil)
+			Return(&domain.SetlistEntry{ID: entry.ID, SetlistID: entry.SetlistID}, nil)
 	}
 
 	mockSLR.
 		On("GetByID", context.TODO(), setlistID).
-		Return(nil, nil)
+		Return(&domain.Setlist{ID: setlistID, Deadline: time.Now().Add(time.Hour)}, nil)
 
 	mockSER.
 		On("UpdateBatch", context.TODO(), mockSetlistEntries).
 		Return(mockErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.UpdateBatch(context.TODO(), mockSetlistEntries, mockUser)
 	assert.Error(t, err)
@@ -1205,20 +1317,25 @@ func TestSetlistEntryRemoveBatchCorrect(t *testing.T) {
 	}
 
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSR := &mocks.MockSongRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 
+	mockSLR.
+		On("GetByID", context.TODO(), mockSetlist.ID).
+		Return(mockSetlist, nil)
+
 	for _, id := range mockSetlistEntryIds {
 		mockSER.
 			On("GetByID", context.TODO(), id).
-			Return(nil, nil)
+			Return(&domain.SetlistEntry{ID: id, SetlistID: mockSetlist.ID}, nil)
 	}
 
 	mockSER.
 		On("DeleteBatch", context.TODO(), mockSetlistEntryIds).
 		Return(nil)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBatch(context.TODO(), mockSetlist, mockSetlistEntryIds, mockUser)
 	assert.NoError(t, err)
@@ -1248,10 +1365,11 @@ func TestSetlistEntryRemoveBatchPrincipalNil(t *testing.T) {
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBatch(context.TODO(), mockSetlist, mockSetlistEntryIds, nil)
 	assert.Error(t, err)
@@ -1276,10 +1394,11 @@ func TestSetlistEntryRemoveBatchSetlistNil(t *testing.T) {
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBatch(context.TODO(), nil, mockSetlistEntryIds, mockUser)
 	assert.Error(t, err)
@@ -1306,10 +1425,11 @@ func TestSetlistEntryRemoveBatchIDsEmpty(t *testing.T) {
 	mockSetlistEntryIds := []int64{}
 
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBatch(context.TODO(), mockSetlist, mockSetlistEntryIds, mockUser)
 	assert.NoError(t, err)
@@ -1339,10 +1459,21 @@ func TestSetlistEntryRemoveBatchNotAuthorizedt(t *testing.T) {
 
 	mockErr := domain.NewNotAuthorizedErr("")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	mockSLR.
+		On("GetByID", context.TODO(), mockSetlist.ID).
+		Return(mockSetlist, nil)
+
+	for _, id := range mockSetlistEntryIds {
+		mockSER.
+			On("GetByID", context.TODO(), id).
+			Return(&domain.SetlistEntry{ID: id, SetlistID: mockSetlist.ID}, nil)
+	}
+
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBatch(context.TODO(), mockSetlist, mockSetlistEntryIds, mockUser)
 	assert.Error(t, err)
@@ -1352,6 +1483,83 @@ func TestSetlistEntryRemoveBatchNotAuthorizedt(t *testing.T) {
 	mockSR.AssertExpectations(t)
 }
 
+func TestSetlistEntryRemoveBatchSetlistLocked(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+
+	mockSetlist := &domain.Setlist{
+		ID:        1,
+		Name:      "Foobar",
+		CreatorID: mockUser.ID,
+		Deadline:  time.Now().Add(-time.Hour),
+		Status:    domain.SetlistPublished,
+	}
+
+	mockErr := domain.NewNotAuthorizedErr("")
+	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
+	mockSLR := &mocks.MockSetlistRepository{}
+	mockSR := &mocks.MockSongRepository{}
+
+	mockSLR.
+		On("GetByID", context.TODO(), mockSetlist.ID).
+		Return(mockSetlist, nil)
+
+	for _, id := range []int64{1, 2} {
+		mockSER.
+			On("GetByID", context.TODO(), id).
+			Return(&domain.SetlistEntry{ID: id, SetlistID: mockSetlist.ID}, nil)
+	}
+
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+	err := slr.RemoveBatch(context.TODO(), &domain.Setlist{ID: mockSetlist.ID}, []int64{1, 2}, mockUser)
+	assert.ErrorAs(t, err, &mockErr)
+	mockSER.AssertExpectations(t)
+	mockSLR.AssertExpectations(t)
+	mockSR.AssertExpectations(t)
+}
+
+func TestSetlistEntryRemoveBatchForeignEntry(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+
+	mockSetlist := &domain.Setlist{
+		ID:        1,
+		Name:      "Foobar",
+		CreatorID: mockUser.ID,
+	}
+
+	mockErr := domain.NewBadRequestErr("")
+	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
+	mockSLR := &mocks.MockSetlistRepository{}
+	mockSR := &mocks.MockSongRepository{}
+
+	mockSER.
+		On("GetByID", context.TODO(), int64(1)).
+		Return(&domain.SetlistEntry{ID: 1, SetlistID: mockSetlist.ID}, nil)
+	mockSER.
+		On("GetByID", context.TODO(), int64(5)).
+		Return(&domain.SetlistEntry{ID: 5, SetlistID: 2}, nil)
+
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+	err := slr.RemoveBatch(context.TODO(), mockSetlist, []int64{1, 5}, mockUser)
+	assert.ErrorAs(t, err, &mockErr)
+	mockSER.AssertExpectations(t)
+	mockSLR.AssertExpectations(t)
+	mockSER.AssertNotCalled(t, "DeleteBatch", mock.Anything, mock.Anything)
+}
+
 func TestSetlistEntryRemoveBatchSetlistEntryGetByIDErr(t *testing.T) {
 	t.Parallel()
 
@@ -1373,6 +1581,7 @@ func TestSetlistEntryRemoveBatchSetlistEntryGetByIDErr(t *testing.T) {
 
 	mockErr := domain.NewRecordNotFoundErr("", "")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
@@ -1380,7 +1589,7 @@ func TestSetlistEntryRemoveBatchSetlistEntryGetByIDErr(t *testing.T) {
 		On("GetByID", context.TODO(), mockSetlistEntryIds[0]).
 		Return(nil, mockErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBatch(context.TODO(), mockSetlist, mockSetlistEntryIds, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
@@ -1410,20 +1619,25 @@ func TestSetlistEntryRemoveBatchSetlistEntryDeleteBatchErr(t *testing.T) {
 
 	mockErr := domain.NewRecordNotFoundErr("", "")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
+	mockSLR.
+		On("GetByID", context.TODO(), mockSetlist.ID).
+		Return(mockSetlist, nil)
+
 	for _, id := range mockSetlistEntryIds {
 		mockSER.
 			On("GetByID", context.TODO(), id).
-			Return(nil, nil)
+			Return(&domain.SetlistEntry{ID: id, SetlistID: mockSetlist.ID}, nil)
 	}
 
 	mockSER.
 		On("DeleteBatch", context.TODO(), mockSetlistEntryIds).
 		Return(mockErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBatch(context.TODO(), mockSetlist, mockSetlistEntryIds, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
@@ -1452,9 +1666,14 @@ func TestSetlistEntryRemoveBySetlistCorrect(t *testing.T) {
 	}
 
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSR := &mocks.MockSongRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 
+	mockSLR.
+		On("GetByID", context.TODO(), mockSetlist.ID).
+		Return(mockSetlist, nil)
+
 	mockSER.
 		On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
 		Return(&[]domain.SetlistEntry{{ID: 1}, {ID: 2}}, nil)
@@ -1463,7 +1682,7 @@ func TestSetlistEntryRemoveBySetlistCorrect(t *testing.T) {
 		On("DeleteBatch", context.TODO(), mockSetlistEntryIds).
 		Return(nil)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBySetlist(context.TODO(), mockSetlist, mockUser)
 	assert.NoError(t, err)
@@ -1488,10 +1707,11 @@ func TestSetlistEntryRemoveBySetlistPrincipalNil(t *testing.T) {
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBySetlist(context.TODO(), mockSetlist, nil)
 	assert.Error(t, err)
@@ -1511,10 +1731,11 @@ func TestSetlistEntryRemoveBySetlistSetlistNil(t *testing.T) {
 
 	mockErr := domain.NewInternalErr()
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBySetlist(context.TODO(), nil, mockUser)
 	assert.Error(t, err)
@@ -1540,10 +1761,15 @@ func TestSetlistEntryRemoveBySetlistNotAuthorized(t *testing.T) {
 
 	mockErr := domain.NewNotAuthorizedErr("")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	mockSLR.
+		On("GetByID", context.TODO(), mockSetlist.ID).
+		Return(mockSetlist, nil)
+
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBySetlist(context.TODO(), mockSetlist, mockUser)
 	assert.Error(t, err)
@@ -1553,6 +1779,41 @@ func TestSetlistEntryRemoveBySetlistNotAuthorized(t *testing.T) {
 	mockSR.AssertExpectations(t)
 }
 
+func TestSetlistEntryRemoveBySetlistSetlistLocked(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+
+	mockSetlist := &domain.Setlist{
+		ID:        1,
+		Name:      "Foobar",
+		CreatorID: mockUser.ID,
+		Deadline:  time.Now().Add(-time.Hour),
+		Status:    domain.SetlistPublished,
+	}
+
+	mockErr := domain.NewNotAuthorizedErr("")
+	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
+	mockSLR := &mocks.MockSetlistRepository{}
+	mockSR := &mocks.MockSongRepository{}
+
+	mockSLR.
+		On("GetByID", context.TODO(), mockSetlist.ID).
+		Return(mockSetlist, nil)
+
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+	err := slr.RemoveBySetlist(context.TODO(), &domain.Setlist{ID: mockSetlist.ID}, mockUser)
+	assert.ErrorAs(t, err, &mockErr)
+	mockSER.AssertExpectations(t)
+	mockSLR.AssertExpectations(t)
+	mockSR.AssertExpectations(t)
+}
+
 func TestSetlistEntryRemoveBySetlistSetlistEntryGetBySetlistErr(t *testing.T) {
 	t.Parallel()
 
@@ -1569,14 +1830,19 @@ func TestSetlistEntryRemoveBySetlistSetlistEntryGetBySetlistErr(t *testing.T) {
 
 	mockErr := domain.NewRecordNotFoundErr("", "")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
+	mockSLR.
+		On("GetByID", context.TODO(), mockSetlist.ID).
+		Return(mockSetlist, nil)
+
 	mockSER.
 		On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
 		Return(nil, mockErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBySetlist(context.TODO(), mockSetlist, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
@@ -1606,9 +1872,14 @@ func TestSetlistEntryRemoveBySetlistSetlistEntryDeleteBatchErr(t *testing.T) {
 
 	mockErr := domain.NewRecordNotFoundErr("", "")
 	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 	mockSLR := &mocks.MockSetlistRepository{}
 	mockSR := &mocks.MockSongRepository{}
 
+	mockSLR.
+		On("GetByID", context.TODO(), mockSetlist.ID).
+		Return(mockSetlist, nil)
+
 	mockSER.
 		On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
 		Return(&[]domain.SetlistEntry{{ID: 1}, {ID: 2}}, nil)
@@ -1617,7 +1888,7 @@ func TestSetlistEntryRemoveBySetlistSetlistEntryDeleteBatchErr(t *testing.T) {
 		On("DeleteBatch", context.TODO(), mockSetlistEntryIds).
 		Return(mockErr)
 
-	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR)
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
 
 	err := slr.RemoveBySetlist(context.TODO(), mockSetlist, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
@@ -1625,3 +1896,915 @@ func TestSetlistEntryRemoveBySetlistSetlistEntryDeleteBatchErr(t *testing.T) {
 	mockSLR.AssertExpectations(t)
 	mockSR.AssertExpectations(t)
 }
+
+func TestSetlistEntryFetchSheet(t *testing.T) {
+	mockEntry := &domain.SetlistEntry{
+		ID:        2,
+		SongID:    3,
+		SetlistID: 1,
+		Transpose: 3,
+	}
+
+	mockSong := &domain.Song{
+		ID:         3,
+		Title:      "Foo",
+		Subtitle:   "Bar",
+		Key:        "G",
+		Bpm:        120,
+		ChordSheet: datatypes.JSON([]byte(`{"Verse":"[G]Foo [D/F#]bar [Em7]baz"}`)),
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		sheet, err := ses.FetchSheet(context.TODO(), mockEntry.SetlistID, mockEntry.ID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "G", sheet.OriginalKey)
+		assert.Equal(t, "Bb", sheet.Key)
+		assert.Equal(t, mockEntry.Transpose, sheet.Transpose)
+		assert.JSONEq(t, `{"Verse":"[Bb]Foo [F/A]bar [Gm7]baz"}`, sheet.ChordSheet.String())
+		mockSER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Correct Nashville notation", func(t *testing.T) {
+		t.Parallel()
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+		options := &domain.SheetOptions{Notation: domain.NashvilleNotation}
+
+		sheet, err := ses.FetchSheet(context.TODO(), mockEntry.SetlistID, mockEntry.ID, options)
+		assert.NoError(t, err)
+		assert.Equal(t, "Bb", sheet.Key)
+		assert.Equal(t, domain.NashvilleNotation, sheet.Notation)
+		assert.JSONEq(t, `{"Verse":"[1]Foo [5/7]bar [6m7]baz"}`, sheet.ChordSheet.String())
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid notation", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+		options := &domain.SheetOptions{Notation: "roman"}
+
+		sheet, err := ses.FetchSheet(context.TODO(), mockEntry.SetlistID, mockEntry.ID, options)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, sheet)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail entry of other setlist", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("", "")
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		sheet, err := ses.FetchSheet(context.TODO(), mockEntry.SetlistID+1, mockEntry.ID, nil)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, sheet)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail Song GetByID error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(nil, expErr)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		sheet, err := ses.FetchSheet(context.TODO(), mockEntry.SetlistID, mockEntry.ID, nil)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, sheet)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestSetlistEntryStoreBatchInvalidArrangement(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+
+	mockSetlistEntries := &[]domain.SetlistEntry{
+		{
+			SongID:      1,
+			SetlistID:   1,
+			Arrangement: datatypes.JSON([]byte(`["Verse 1", "Bridge 2"]`)),
+		},
+	}
+
+	mockErr := domain.NewBadRequestErr("")
+	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
+	mockSLR := &mocks.MockSetlistRepository{}
+	mockSR := &mocks.MockSongRepository{}
+
+	mockSR.
+		On("GetByID", context.TODO(), (*mockSetlistEntries)[0].SongID).
+		Return(mockArrangedSong, nil)
+
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+	err := slr.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
+	assert.ErrorAs(t, err, &mockErr)
+	assert.EqualError(t, err, "song has no section Bridge 2")
+	mockSER.AssertExpectations(t)
+	mockSLR.AssertExpectations(t)
+	mockSR.AssertExpectations(t)
+}
+
+func TestSetlistEntryUpdateBatchInvalidArrangement(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+
+	mockSetlistEntries := &[]domain.SetlistEntry{
+		{
+			ID:          1,
+			SongID:      1,
+			SetlistID:   1,
+			Arrangement: datatypes.JSON([]byte(`["Chorus x0"]`)),
+		},
+	}
+
+	mockErr := domain.NewBadRequestErr("")
+	mockSER := &mocks.MockSetlistEntryRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
+	mockSLR := &mocks.MockSetlistRepository{}
+	mockSR := &mocks.MockSongRepository{}
+
+	mockSR.
+		On("GetByID", context.TODO(), (*mockSetlistEntries)[0].SongID).
+		Return(mockArrangedSong, nil)
+
+	slr := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+	err := slr.UpdateBatch(context.TODO(), mockSetlistEntries, mockUser)
+	assert.ErrorAs(t, err, &mockErr)
+	mockSER.AssertExpectations(t)
+	mockSLR.AssertExpectations(t)
+	mockSR.AssertExpectations(t)
+}
+
+func TestSetlistEntryFetchArrangement(t *testing.T) {
+	mockEntry := &domain.SetlistEntry{
+		ID:          2,
+		SongID:      3,
+		SetlistID:   1,
+		Transpose:   2,
+		Arrangement: datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)),
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockEntry.SongID).
+			Return(mockArrangedSong, nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		arrangement, err := ses.FetchArrangement(context.TODO(), mockEntry.SetlistID, mockEntry.ID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.ExpandedArrangement{
+			EntryID: mockEntry.ID,
+			SongID:  mockArrangedSong.ID,
+			Key:     "A",
+			Sections: []domain.ArrangedSection{
+				{Tag: "Verse 1", Text: "[A]Foo"},
+				{Tag: "Chorus", Text: "[E]Baz"},
+				{Tag: "Chorus", Text: "[E]Baz"},
+			},
+			Text: "[A]Foo\n\n[E]Baz\n\n[E]Baz",
+		}, arrangement)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail entry of other setlist", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("", "")
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		arrangement, err := ses.FetchArrangement(context.TODO(), mockEntry.SetlistID+1, mockEntry.ID, nil)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, arrangement)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail unknown section", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(&domain.SetlistEntry{
+				ID:          mockEntry.ID,
+				SongID:      mockEntry.SongID,
+				SetlistID:   mockEntry.SetlistID,
+				Arrangement: datatypes.JSON([]byte(`["Bridge"]`)),
+			}, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockEntry.SongID).
+			Return(mockArrangedSong, nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		arrangement, err := ses.FetchArrangement(context.TODO(), mockEntry.SetlistID, mockEntry.ID, nil)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, arrangement)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestSetlistEntryFetchSheetLanguages(t *testing.T) {
+	mockEntry := &domain.SetlistEntry{
+		ID:        2,
+		SongID:    3,
+		SetlistID: 1,
+		Languages: "nl,en",
+	}
+
+	mockSong := &domain.Song{
+		ID:         3,
+		Title:      "Amazing Grace",
+		Key:        "G",
+		Language:   "en",
+		ChordSheet: datatypes.JSON([]byte(`[{"tag": "Chorus", "text": "[G]Free"}]`)),
+	}
+
+	mockTranslations := []domain.SongTranslation{
+		{ID: 1, SongID: 3, Language: "nl", Lyrics: datatypes.JSON([]byte(`{"Chorus": "Vrij"}`))},
+	}
+
+	t.Run("Correct entry languages", func(t *testing.T) {
+		t.Parallel()
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockSTR.
+			On("GetBySong", context.TODO(), mockSong.ID).
+			Return(mockTranslations, nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		sheet, err := ses.FetchSheet(context.TODO(), mockEntry.SetlistID, mockEntry.ID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "Amazing Grace", sheet.Title)
+		assert.Equal(t, []string{"nl", "en"}, sheet.Languages)
+		assert.Equal(t, "Vrij", sheet.Sections[0].Lines[0].Lyrics)
+		assert.Equal(t, map[string]string{"en": "Free"}, sheet.Sections[0].Lines[0].Translations)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Correct options override entry languages", func(t *testing.T) {
+		t.Parallel()
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSER.
+			On("GetByID", context.TODO(), mockEntry.ID).
+			Return(mockEntry, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+		options := &domain.SheetOptions{Notation: domain.LetterNotation, Languages: []string{"en"}}
+
+		sheet, err := ses.FetchSheet(context.TODO(), mockEntry.SetlistID, mockEntry.ID, options)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"en"}, sheet.Languages)
+		assert.Equal(t, "Free", sheet.Sections[0].Lines[0].Lyrics)
+		mockSTR.AssertExpectations(t)
+	})
+}
+
+func TestSetlistEntryFetchAnalysis(t *testing.T) {
+	mockSetlist := &domain.Setlist{ID: 1, Name: "Foo"}
+	mockEntries := &[]domain.SetlistEntry{
+		{ID: 1, SongID: 1, SetlistID: 1, Rank: 1},
+		{ID: 2, SongID: 2, SetlistID: 1, Rank: 2},
+		{ID: 3, SongID: 1, SetlistID: 1, Rank: 3},
+	}
+	mockFirst := &domain.Song{ID: 1, Title: "Foo", Key: "C", Bpm: 70}
+	mockSecond := &domain.Song{ID: 2, Title: "Bar", Key: "D", Bpm: 80}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(mockSetlist, nil)
+		mockSER.
+			On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
+			Return(mockEntries, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockFirst.ID).
+			Return(mockFirst, nil).
+			Once()
+		mockSR.
+			On("GetByID", context.TODO(), mockSecond.ID).
+			Return(mockSecond, nil).
+			Once()
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		analysis, err := ses.FetchAnalysis(context.TODO(), mockSetlist.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.SetlistAnalysis{
+			SetlistID: mockSetlist.ID,
+			Transitions: []domain.EntryTransition{
+				{FromEntryID: 1, ToEntryID: 2, FromKey: "C", ToKey: "D", KeyDistance: 2, BpmChange: 10},
+				{FromEntryID: 2, ToEntryID: 3, FromKey: "D", ToKey: "C", KeyDistance: 2, BpmChange: -10},
+			},
+			Warnings: []domain.SetlistWarning{
+				{Type: domain.RepeatedSongWarning, FromEntryID: 1, ToEntryID: 3, Message: "Foo is already in the setlist"},
+			},
+			Suggestions: []domain.TransposeSuggestion{},
+		}, analysis)
+		mockSLR.AssertExpectations(t)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail setlist GetByID", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("", "")
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(nil, domain.NewRecordNotFoundErr("id", "1"))
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		analysis, err := ses.FetchAnalysis(context.TODO(), mockSetlist.ID)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, analysis)
+		mockSLR.AssertExpectations(t)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail GetBySetlist", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(mockSetlist, nil)
+		mockSER.
+			On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
+			Return(nil, domain.NewInternalErr())
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		analysis, err := ses.FetchAnalysis(context.TODO(), mockSetlist.ID)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, analysis)
+		mockSLR.AssertExpectations(t)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail song GetByID", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("", "")
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(mockSetlist, nil)
+		mockSER.
+			On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
+			Return(mockEntries, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockFirst.ID).
+			Return(nil, domain.NewRecordNotFoundErr("id", "1"))
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		analysis, err := ses.FetchAnalysis(context.TODO(), mockSetlist.ID)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, analysis)
+		mockSLR.AssertExpectations(t)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestSetlistEntryFetchTimings(t *testing.T) {
+	mockEntries := &[]domain.SetlistEntry{
+		{ID: 1, SongID: 1, SetlistID: 1, Rank: 2000},
+		{ID: 2, SongID: 2, SetlistID: 1, Rank: 1000, Duration: 120},
+		{ID: 3, SongID: 1, SetlistID: 2, Rank: 1000},
+	}
+	mockSongs := []domain.Song{
+		{ID: 1, Duration: 300},
+		{ID: 2, Duration: 200},
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("Get", context.TODO(), &domain.SongFilterOptions{IDs: []int64{1, 2, 1}}).
+			Return(mockSongs, nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		timings, err := ses.FetchTimings(context.TODO(), mockEntries)
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64]domain.SetlistTiming{
+			1: {
+				SetlistID: 1,
+				RunTime:   420,
+				Entries: []domain.EntryTiming{
+					{EntryID: 2, Start: 0, Duration: 120},
+					{EntryID: 1, Start: 120, Duration: 300},
+				},
+			},
+			2: {
+				SetlistID: 2,
+				RunTime:   300,
+				Entries: []domain.EntryTiming{
+					{EntryID: 3, Start: 0, Duration: 300},
+				},
+			},
+		}, timings)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Correct no entries", func(t *testing.T) {
+		t.Parallel()
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		timings, err := ses.FetchTimings(context.TODO(), &[]domain.SetlistEntry{})
+		assert.NoError(t, err)
+		assert.Empty(t, timings)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail entries nil", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		timings, err := ses.FetchTimings(context.TODO(), nil)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, timings)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail song Get", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("Get", context.TODO(), &domain.SongFilterOptions{IDs: []int64{1, 2, 1}}).
+			Return(nil, domain.NewInternalErr())
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		timings, err := ses.FetchTimings(context.TODO(), mockEntries)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, timings)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestSetlistEntryStoreBatchItems(t *testing.T) {
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSetlistEntries := &[]domain.SetlistEntry{
+			{Type: domain.HeaderItem, SetlistID: 1, Title: "Worship", Rank: 1000},
+			{SongID: 1, SetlistID: 1, Rank: 2000},
+			{Type: domain.ScriptureItem, SetlistID: 1, Reference: " John 3:16 ", Duration: 120, Rank: 3000},
+			{Type: domain.TextItem, SetlistID: 1, Title: "Sermon", Notes: "Pastor", Duration: 1800, Rank: 4000},
+		}
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(mockArrangedSong, nil).
+			Once()
+		mockSLR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(&domain.Setlist{ID: 1, Deadline: time.Now().Add(time.Hour)}, nil)
+		mockSER.
+			On("CreateBatch", context.TODO(), mockSetlistEntries).
+			Return(nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		err := ses.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SongItem, (*mockSetlistEntries)[1].Type)
+		assert.Equal(t, "John 3:16", (*mockSetlistEntries)[2].Reference)
+		mockSER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid item", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSetlistEntries := &[]domain.SetlistEntry{
+			{Type: domain.HeaderItem, SetlistID: 1, Title: "Worship", SongID: 1, Rank: 1000},
+		}
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		err := ses.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		mockSER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid reference", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSetlistEntries := &[]domain.SetlistEntry{
+			{Type: domain.ScriptureItem, SetlistID: 1, Reference: "John", Rank: 1000},
+		}
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		err := ses.StoreBatch(context.TODO(), mockSetlistEntries, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		mockSER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestSetlistEntryItemsWithoutSong(t *testing.T) {
+	mockHeader := &domain.SetlistEntry{ID: 2, Type: domain.HeaderItem, SetlistID: 1, Title: "Worship", Duration: 60}
+
+	t.Run("Fail FetchSheet", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSER.
+			On("GetByID", context.TODO(), mockHeader.ID).
+			Return(mockHeader, nil)
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		sheet, err := ses.FetchSheet(context.TODO(), mockHeader.SetlistID, mockHeader.ID, nil)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, sheet)
+		mockSER.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Correct FetchTimings", func(t *testing.T) {
+		t.Parallel()
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		ses := service.NewSetlistEntryService(mockSER, mockSLR, mockSR, mockSTR)
+
+		timings, err := ses.FetchTimings(context.TODO(), &[]domain.SetlistEntry{*mockHeader})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64]domain.SetlistTiming{
+			1: {
+				SetlistID: 1,
+				RunTime:   60,
+				Entries:   []domain.EntryTiming{{EntryID: 2, Start: 0, Duration: 60}},
+			},
+		}, timings)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestSetlistEntryReorder(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+	mockSetlist := &domain.Setlist{ID: 1, Deadline: time.Now().Add(time.Hour), Status: domain.SetlistPublished}
+	newEntries := func() *[]domain.SetlistEntry {
+		return &[]domain.SetlistEntry{
+			{ID: 3, SetlistID: mockSetlist.ID, Rank: 3072},
+			{ID: 1, SetlistID: mockSetlist.ID, Rank: 1024},
+			{ID: 2, SetlistID: mockSetlist.ID, Rank: 2048},
+		}
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(mockSetlist, nil)
+		mockSER.
+			On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
+			Return(newEntries(), nil)
+		mockSER.
+			On("UpdateRanks", context.TODO(), &[]domain.SetlistEntry{{ID: 2, SetlistID: mockSetlist.ID, Rank: 4096}}).
+			Return(nil)
+
+		sles := service.NewSetlistEntryService(mockSER, mockSLR, &mocks.MockSongRepository{}, &mocks.MockSongTranslationRepository{})
+
+		entries, err := sles.Reorder(context.TODO(), mockSetlist.ID, []int64{1, 3, 2}, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, &[]domain.SetlistEntry{
+			{ID: 1, SetlistID: mockSetlist.ID, Rank: 1024},
+			{ID: 3, SetlistID: mockSetlist.ID, Rank: 3072},
+			{ID: 2, SetlistID: mockSetlist.ID, Rank: 4096},
+		}, entries)
+		mockSER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Correct unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(mockSetlist, nil)
+		mockSER.
+			On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
+			Return(newEntries(), nil)
+
+		sles := service.NewSetlistEntryService(mockSER, mockSLR, &mocks.MockSongRepository{}, &mocks.MockSongTranslationRepository{})
+
+		entries, err := sles.Reorder(context.TODO(), mockSetlist.ID, []int64{1, 2, 3}, mockUser)
+		assert.NoError(t, err)
+		assert.Len(t, *entries, 3)
+		mockSER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Fail not authorized", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		sles := service.NewSetlistEntryService(mockSER, mockSLR, &mocks.MockSongRepository{}, &mocks.MockSongTranslationRepository{})
+
+		entries, err := sles.Reorder(context.TODO(), mockSetlist.ID, []int64{1, 2, 3}, &domain.User{ID: 2, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, entries)
+		mockSER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Fail locked", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(&domain.Setlist{ID: mockSetlist.ID, Deadline: time.Now().Add(-time.Hour), Status: domain.SetlistPublished}, nil)
+
+		sles := service.NewSetlistEntryService(mockSER, mockSLR, &mocks.MockSongRepository{}, &mocks.MockSongTranslationRepository{})
+
+		entries, err := sles.Reorder(context.TODO(), mockSetlist.ID, []int64{1, 2, 3}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, entries)
+		mockSER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Fail incomplete order", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(mockSetlist, nil)
+		mockSER.
+			On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
+			Return(newEntries(), nil)
+
+		sles := service.NewSetlistEntryService(mockSER, mockSLR, &mocks.MockSongRepository{}, &mocks.MockSongTranslationRepository{})
+
+		entries, err := sles.Reorder(context.TODO(), mockSetlist.ID, []int64{1, 1, 2}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, entries)
+		mockSER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Fail UpdateRanks", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSER := &mocks.MockSetlistEntryRepository{}
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(mockSetlist, nil)
+		mockSER.
+			On("GetBySetlist", context.TODO(), &[]domain.Setlist{*mockSetlist}).
+			Return(newEntries(), nil)
+		mockSER.
+			On("UpdateRanks", context.TODO(), mock.Anything).
+			Return(expErr)
+
+		sles := service.NewSetlistEntryService(mockSER, mockSLR, &mocks.MockSongRepository{}, &mocks.MockSongTranslationRepository{})
+
+		entries, err := sles.Reorder(context.TODO(), mockSetlist.ID, []int64{3, 2, 1}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, entries)
+		mockSER.AssertExpectations(t)
+		mockSLR.AssertExpectations(t)
+	})
+}
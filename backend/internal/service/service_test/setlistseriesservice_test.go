@@ -0,0 +1,413 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type seriesMocks struct {
+	ssr  *mocks.MockSetlistSeriesRepository
+	str  *mocks.MockSetlistTemplateRepository
+	slr  *mocks.MockSetlistRepository
+	sler *mocks.MockSetlistEntryRepository
+	slrr *mocks.MockSetlistRoleRepository
+	tx   *mocks.MockTransactor
+}
+
+func newSeriesMocks() *seriesMocks {
+	return &seriesMocks{
+		ssr:  &mocks.MockSetlistSeriesRepository{},
+		str:  &mocks.MockSetlistTemplateRepository{},
+		slr:  &mocks.MockSetlistRepository{},
+		sler: &mocks.MockSetlistEntryRepository{},
+		slrr: &mocks.MockSetlistRoleRepository{},
+		tx:   &mocks.MockTransactor{},
+	}
+}
+
+func (sm *seriesMocks) service() domain.SetlistSeriesService {
+	return service.NewSetlistSeriesService(sm.ssr, sm.str, sm.slr, sm.sler, sm.slrr, sm.tx)
+}
+
+func (sm *seriesMocks) assertExpectations(t *testing.T) {
+	t.Helper()
+
+	sm.ssr.AssertExpectations(t)
+	sm.str.AssertExpectations(t)
+	sm.slr.AssertExpectations(t)
+	sm.sler.AssertExpectations(t)
+	sm.slrr.AssertExpectations(t)
+	sm.tx.AssertExpectations(t)
+}
+
+// dailySeries returns a series with two daily occurrences, the first an hour from now.
+func dailySeries() (*domain.SetlistSeries, time.Time, time.Time) {
+	now := time.Now().UTC()
+	first := time.Date(now.Year(), now.Month(), now.Day(), now.Hour()+1, now.Minute(), 0, 0, time.UTC)
+	second := time.Date(now.Year(), now.Month(), now.Day()+1, now.Hour()+1, now.Minute(), 0, 0, time.UTC)
+
+	return &domain.SetlistSeries{
+		ID:        1,
+		Name:      "Sunday",
+		CreatorID: 1,
+		Rule:      "FREQ=DAILY;COUNT=2",
+		Timezone:  "UTC",
+		Start:     first,
+	}, first, second
+}
+
+func TestSetlistSeriesStore(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+	start := time.Date(2024, time.January, 7, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSeries := &domain.SetlistSeries{
+			Name:       " Sunday service ",
+			Rule:       "freq=weekly;byday=su;byhour=10",
+			Timezone:   "Europe/Amsterdam",
+			Start:      start,
+			TemplateID: 2,
+		}
+		sm := newSeriesMocks()
+
+		sm.str.On("GetByID", context.TODO(), int64(2)).Return(&domain.SetlistTemplate{ID: 2}, nil)
+		sm.ssr.On("Create", context.TODO(), mockSeries).Return(nil)
+
+		err := sm.service().Store(context.TODO(), mockSeries, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, "Sunday service", mockSeries.Name)
+		assert.Equal(t, "FREQ=WEEKLY;BYDAY=SU;BYHOUR=10", mockSeries.Rule)
+		assert.Equal(t, mockUser.ID, mockSeries.CreatorID)
+		sm.assertExpectations(t)
+	})
+
+	t.Run("Fail invalid series", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		sm := newSeriesMocks()
+
+		invalid := []domain.SetlistSeries{
+			{Name: " ", Rule: "FREQ=DAILY", Timezone: "UTC", Start: start},
+			{Name: "Sunday", Rule: "FREQ=DAILY", Timezone: "UTC"},
+			{Name: "Sunday", Rule: "FREQ=YEARLY", Timezone: "UTC", Start: start},
+			{Name: "Sunday", Rule: "FREQ=DAILY", Timezone: "Europe/Nowhere", Start: start},
+		}
+
+		for idx := range invalid {
+			err := sm.service().Store(context.TODO(), &invalid[idx], mockUser)
+			assert.ErrorAs(t, err, &expErr)
+		}
+
+		sm.assertExpectations(t)
+	})
+
+	t.Run("Fail member", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		sm := newSeriesMocks()
+
+		err := sm.service().Store(
+			context.TODO(),
+			&domain.SetlistSeries{Name: "Sunday", Rule: "FREQ=DAILY", Timezone: "UTC", Start: start},
+			&domain.User{ID: 1, Permission: domain.MEMBER},
+		)
+		assert.ErrorAs(t, err, &expErr)
+		sm.assertExpectations(t)
+	})
+}
+
+func TestSetlistSeriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	mockSeries := &domain.SetlistSeries{
+		ID:       1,
+		Name:     "Youth night",
+		Rule:     "FREQ=MONTHLY;BYDAY=1FR",
+		Timezone: "UTC",
+		Start:    time.Date(2024, time.January, 1, 19, 30, 0, 0, time.UTC),
+	}
+
+	t.Run("Correct admin", func(t *testing.T) {
+		t.Parallel()
+
+		series := *mockSeries
+		sm := newSeriesMocks()
+
+		sm.ssr.On("GetByID", context.TODO(), int64(1)).Return(&domain.SetlistSeries{ID: 1, CreatorID: 2}, nil)
+		sm.ssr.On("Update", context.TODO(), &series).Return(nil)
+
+		err := sm.service().Update(context.TODO(), &series, &domain.User{ID: 3, Permission: domain.ADMIN})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), series.CreatorID)
+		sm.assertExpectations(t)
+	})
+
+	t.Run("Fail not creator", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		series := *mockSeries
+		sm := newSeriesMocks()
+
+		sm.ssr.On("GetByID", context.TODO(), int64(1)).Return(&domain.SetlistSeries{ID: 1, CreatorID: 2}, nil)
+
+		err := sm.service().Update(context.TODO(), &series, &domain.User{ID: 3, Permission: domain.EDITOR})
+		assert.ErrorAs(t, err, &expErr)
+		sm.assertExpectations(t)
+	})
+}
+
+func TestSetlistSeriesRemove(t *testing.T) {
+	t.Parallel()
+
+	sm := newSeriesMocks()
+
+	sm.ssr.On("GetByID", context.TODO(), int64(1)).Return(&domain.SetlistSeries{ID: 1, CreatorID: 2}, nil)
+	sm.ssr.On("Delete", context.TODO(), int64(1)).Return(nil)
+
+	err := sm.service().Remove(context.TODO(), 1, &domain.User{ID: 2, Permission: domain.EDITOR})
+	assert.NoError(t, err)
+	sm.assertExpectations(t)
+}
+
+func TestSetlistSeriesPreview(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSeries, first, second := dailySeries()
+		sm := newSeriesMocks()
+
+		sm.ssr.On("GetByID", context.TODO(), int64(1)).Return(mockSeries, nil)
+		sm.ssr.
+			On("GetOccurrences", context.TODO(), int64(1), first, second).
+			Return(&[]domain.SeriesOccurrence{{ID: 3, SetlistSeriesID: 1, Date: second, SetlistID: 4}}, nil)
+
+		occurrences, err := sm.service().Preview(context.TODO(), 1, time.Time{}, time.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, &[]domain.SeriesOccurrence{
+			{SetlistSeriesID: 1, Date: first},
+			{ID: 3, SetlistSeriesID: 1, Date: second, SetlistID: 4},
+		}, occurrences)
+		sm.assertExpectations(t)
+	})
+
+	t.Run("Fail horizon too far", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		sm := newSeriesMocks()
+		from := time.Now()
+
+		_, err := sm.service().Preview(context.TODO(), 1, from, from.AddDate(2, 0, 0))
+		assert.ErrorAs(t, err, &expErr)
+
+		_, err = sm.service().Preview(context.TODO(), 1, from, from.AddDate(0, 0, -1))
+		assert.ErrorAs(t, err, &expErr)
+		sm.assertExpectations(t)
+	})
+}
+
+func TestSetlistSeriesMaterialize(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSeries, first, second := dailySeries()
+		sm := newSeriesMocks()
+
+		sm.ssr.On("GetByID", context.TODO(), int64(1)).Return(mockSeries, nil)
+		sm.ssr.
+			On("GetOccurrences", context.TODO(), int64(1), first, second).
+			Return(&[]domain.SeriesOccurrence{{ID: 3, SetlistSeriesID: 1, Date: first, Skipped: true}}, nil)
+		sm.tx.On("WithinTransaction", context.TODO()).Return(nil)
+		sm.slr.
+			On("Create", context.TODO(), &domain.Setlist{
+				Name:      fmt.Sprintf("Sunday %s", second.Format("2006-01-02")),
+				CreatorID: 1,
+				Deadline:  second,
+				Status:    domain.SetlistDraft,
+			}).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				arg, ok := args.Get(1).(*domain.Setlist)
+				assert.True(t, ok)
+				arg.ID = 7
+			})
+		sm.ssr.
+			On("CreateOccurrence", context.TODO(), &domain.SeriesOccurrence{SetlistSeriesID: 1, Date: second, SetlistID: 7}).
+			Return(nil)
+
+		setlists, err := sm.service().Materialize(context.TODO(), 1, time.Now().AddDate(0, 0, 7), mockUser)
+		assert.NoError(t, err)
+		assert.Len(t, *setlists, 1)
+		assert.Equal(t, int64(7), (*setlists)[0].ID)
+		sm.assertExpectations(t)
+	})
+
+	t.Run("Correct from template", func(t *testing.T) {
+		t.Parallel()
+
+		mockSeries, first, second := dailySeries()
+		mockSeries.Rule = "FREQ=DAILY;COUNT=1"
+		mockSeries.TemplateID = 2
+		sm := newSeriesMocks()
+
+		sm.ssr.On("GetByID", context.TODO(), int64(1)).Return(mockSeries, nil)
+		sm.str.On("GetByID", context.TODO(), int64(2)).Return(&domain.SetlistTemplate{
+			ID:    2,
+			Slots: []domain.TemplateSlot{{ID: 1, Name: "Opening", Type: domain.SongItem, Rank: 1}},
+			Roles: []domain.TemplateRole{{ID: 1, RoleID: 1, UserRoleID: 4}},
+		}, nil)
+		sm.ssr.On("GetOccurrences", context.TODO(), int64(1), first, first).Return(&[]domain.SeriesOccurrence{}, nil)
+		sm.tx.On("WithinTransaction", context.TODO()).Return(nil)
+		sm.slr.
+			On("Create", context.TODO(), mock.AnythingOfType("*domain.Setlist")).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				arg, ok := args.Get(1).(*domain.Setlist)
+				assert.True(t, ok)
+				arg.ID = 7
+			})
+		sm.sler.
+			On("CreateBatch", context.TODO(), &[]domain.SetlistEntry{
+				{Type: domain.TextItem, SetlistID: 7, Title: "Opening", Rank: 1},
+			}).
+			Return(nil)
+		sm.slrr.
+			On("Create", context.TODO(), &[]domain.SetlistRole{{SetlistID: 7, UserRoleID: 4}}).
+			Return(nil)
+		sm.ssr.
+			On("CreateOccurrence", context.TODO(), &domain.SeriesOccurrence{SetlistSeriesID: 1, Date: first, SetlistID: 7}).
+			Return(nil)
+
+		setlists, err := sm.service().Materialize(context.TODO(), 1, second, mockUser)
+		assert.NoError(t, err)
+		assert.Len(t, *setlists, 1)
+		sm.assertExpectations(t)
+	})
+
+	t.Run("Fail CreateOccurrence error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSeries, first, _ := dailySeries()
+		mockSeries.Rule = "FREQ=DAILY;COUNT=1"
+		sm := newSeriesMocks()
+
+		sm.ssr.On("GetByID", context.TODO(), int64(1)).Return(mockSeries, nil)
+		sm.ssr.On("GetOccurrences", context.TODO(), int64(1), first, first).Return(&[]domain.SeriesOccurrence{}, nil)
+		sm.tx.On("WithinTransaction", context.TODO()).Return(nil)
+		sm.slr.On("Create", context.TODO(), mock.AnythingOfType("*domain.Setlist")).Return(nil)
+		sm.ssr.On("CreateOccurrence", context.TODO(), mock.AnythingOfType("*domain.SeriesOccurrence")).Return(expErr)
+
+		_, err := sm.service().Materialize(context.TODO(), 1, time.Now().AddDate(0, 0, 7), mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		sm.assertExpectations(t)
+	})
+
+	t.Run("Fail member", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		sm := newSeriesMocks()
+
+		_, err := sm.service().Materialize(
+			context.TODO(), 1, time.Now().AddDate(0, 0, 7), &domain.User{ID: 1, Permission: domain.MEMBER},
+		)
+		assert.ErrorAs(t, err, &expErr)
+		sm.assertExpectations(t)
+	})
+}
+
+func TestSetlistSeriesSkip(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+
+	t.Run("Correct skip", func(t *testing.T) {
+		t.Parallel()
+
+		mockSeries, first, _ := dailySeries()
+		sm := newSeriesMocks()
+
+		sm.ssr.On("GetByID", context.TODO(), int64(1)).Return(mockSeries, nil)
+		sm.ssr.On("GetOccurrences", context.TODO(), int64(1), first, first).Return(&[]domain.SeriesOccurrence{}, nil)
+		sm.ssr.
+			On("CreateOccurrence", context.TODO(), &domain.SeriesOccurrence{SetlistSeriesID: 1, Date: first, Skipped: true}).
+			Return(nil)
+
+		err := sm.service().Skip(context.TODO(), 1, first, mockUser)
+		assert.NoError(t, err)
+		sm.assertExpectations(t)
+	})
+
+	t.Run("Correct unskip", func(t *testing.T) {
+		t.Parallel()
+
+		mockSeries, _, second := dailySeries()
+		sm := newSeriesMocks()
+
+		sm.ssr.On("GetByID", context.TODO(), int64(1)).Return(mockSeries, nil)
+		sm.ssr.
+			On("GetOccurrences", context.TODO(), int64(1), second, second).
+			Return(&[]domain.SeriesOccurrence{{ID: 3, SetlistSeriesID: 1, Date: second, Skipped: true}}, nil)
+		sm.ssr.On("DeleteOccurrence", context.TODO(), int64(1), second).Return(nil)
+
+		err := sm.service().Unskip(context.TODO(), 1, second, mockUser)
+		assert.NoError(t, err)
+		sm.assertExpectations(t)
+	})
+
+	t.Run("Fail no occurrence", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSeries, first, _ := dailySeries()
+		sm := newSeriesMocks()
+
+		sm.ssr.On("GetByID", context.TODO(), int64(1)).Return(mockSeries, nil)
+
+		err := sm.service().Skip(context.TODO(), 1, first.Add(time.Minute), mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		sm.assertExpectations(t)
+	})
+
+	t.Run("Fail materialized", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSeries, first, _ := dailySeries()
+		sm := newSeriesMocks()
+
+		sm.ssr.On("GetByID", context.TODO(), int64(1)).Return(mockSeries, nil)
+		sm.ssr.
+			On("GetOccurrences", context.TODO(), int64(1), first, first).
+			Return(&[]domain.SeriesOccurrence{{ID: 3, SetlistSeriesID: 1, Date: first, SetlistID: 4}}, nil)
+
+		err := sm.service().Skip(context.TODO(), 1, first, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+
+		err = sm.service().Unskip(context.TODO(), 1, first, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		sm.assertExpectations(t)
+	})
+}
@@ -20,6 +20,8 @@ func TestSetlistFetchByIDCorrect(t *testing.T) {
 		ID:        1,
 		CreatorID: 1,
 		Name:      "Foo",
+		Deadline:  time.Now().Add(time.Hour),
+		Status:    domain.SetlistPublished,
 	}
 
 	mockUR := &mocks.MockUserRepository{}
@@ -31,7 +33,7 @@ func TestSetlistFetchByIDCorrect(t *testing.T) {
 
 	slr := service.NewSetlistService(mockUR, mockSLR)
 
-	setlist, err := slr.FetchByID(context.TODO(), slid)
+	setlist, err := slr.FetchByID(context.TODO(), slid, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, mockSetlist, setlist)
 }
@@ -51,7 +53,7 @@ func TestSetlistFetchByIDErr(t *testing.T) {
 
 	slr := service.NewSetlistService(mockUR, mockSLR)
 
-	setlist, err := slr.FetchByID(context.TODO(), slid)
+	setlist, err := slr.FetchByID(context.TODO(), slid, nil)
 	assert.ErrorAs(t, err, &expErr)
 	assert.Nil(t, setlist)
 }
@@ -64,11 +66,15 @@ func TestSetlistFetchAllCorrect(t *testing.T) {
 			ID:        1,
 			CreatorID: 1,
 			Name:      "Foo",
+			Deadline:  time.Now().Add(time.Hour),
+			Status:    domain.SetlistPublished,
 		},
 		{
 			ID:        2,
 			CreatorID: 1,
 			Name:      "Bar",
+			Deadline:  time.Now().Add(time.Hour),
+			Status:    domain.SetlistPublished,
 		},
 	}
 
@@ -81,7 +87,7 @@ func TestSetlistFetchAllCorrect(t *testing.T) {
 
 	slr := service.NewSetlistService(mockUR, mockSLR)
 
-	setlists, err := slr.FetchAll(context.TODO())
+	setlists, err := slr.FetchAll(context.TODO(), nil)
 	assert.NoError(t, err)
 	assert.Equal(t, mockSetlists, setlists)
 }
@@ -99,7 +105,7 @@ func TestSetlistFetchAllErr(t *testing.T) {
 
 	slr := service.NewSetlistService(mockUR, mockSLR)
 
-	setlist, err := slr.FetchAll(context.TODO())
+	setlist, err := slr.FetchAll(context.TODO(), nil)
 	assert.ErrorAs(t, err, &expErr)
 	assert.Nil(t, setlist)
 }
@@ -112,11 +118,15 @@ func TestSetlistFetchByTimeframeCorrect(t *testing.T) {
 			ID:        1,
 			CreatorID: 1,
 			Name:      "Foo",
+			Deadline:  time.Now().Add(time.Hour),
+			Status:    domain.SetlistPublished,
 		},
 		{
 			ID:        2,
 			CreatorID: 1,
 			Name:      "Bar",
+			Deadline:  time.Now().Add(time.Hour),
+			Status:    domain.SetlistPublished,
 		},
 	}
 
@@ -132,7 +142,7 @@ func TestSetlistFetchByTimeframeCorrect(t *testing.T) {
 
 	slr := service.NewSetlistService(mockUR, mockSLR)
 
-	setlists, err := slr.FetchByTimeframe(context.TODO(), time1, time2)
+	setlists, err := slr.FetchByTimeframe(context.TODO(), time1, time2, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, mockSetlists, setlists)
 }
@@ -149,7 +159,7 @@ func TestSetlistFetchByTimeframeFromAfterTo(t *testing.T) {
 
 	slr := service.NewSetlistService(mockUR, mockSLR)
 
-	setlists, err := slr.FetchByTimeframe(context.TODO(), time1, time2)
+	setlists, err := slr.FetchByTimeframe(context.TODO(), time1, time2, nil)
 	assert.ErrorAs(t, err, &mockErr)
 	assert.Nil(t, setlists)
 	mockUR.AssertExpectations(t)
@@ -172,7 +182,7 @@ func TestSetlistFetchByTimeframeSetlistGetByTimeframeErr(t *testing.T) {
 
 	slr := service.NewSetlistService(mockUR, mockSLR)
 
-	setlists, err := slr.FetchByTimeframe(context.TODO(), time1, time2)
+	setlists, err := slr.FetchByTimeframe(context.TODO(), time1, time2, nil)
 	assert.ErrorAs(t, err, &mockErr)
 	assert.Nil(t, setlists)
 	mockUR.AssertExpectations(t)
@@ -301,7 +311,7 @@ func TestSetlistUpdateInvalidDeadline(t *testing.T) {
 
 	mockSLR.
 		On("GetByID", context.TODO(), mockSetlist.ID).
-		Return(mockSetlist, nil)
+		Return(&domain.Setlist{ID: 1, CreatorID: mockUser.ID, Deadline: time.Now().AddDate(0, 0, 1)}, nil)
 
 	sls := service.NewSetlistService(mockUR, mockSLR)
 
@@ -557,12 +567,12 @@ func TestSetlistRemoveCorrect(t *testing.T) {
 	mockSLR := &mocks.MockSetlistRepository{}
 
 	mockSLR.
-		On("Delete", context.TODO(), slid).
+		On("Delete", context.TODO(), slid, time.Time{}).
 		Return(nil)
 
 	sls := service.NewSetlistService(mockUR, mockSLR)
 
-	err := sls.Remove(context.TODO(), slid, mockUser)
+	err := sls.Remove(context.TODO(), slid, time.Time{}, mockUser)
 
 	assert.NoError(t, err)
 	mockUR.AssertExpectations(t)
@@ -592,7 +602,7 @@ func TestSetlistRemoveNotAuthorized(t *testing.T) {
 
 	sls := service.NewSetlistService(mockUR, mockSLR)
 
-	err := sls.Remove(context.TODO(), mockSetlist.ID, mockUser)
+	err := sls.Remove(context.TODO(), mockSetlist.ID, time.Time{}, mockUser)
 
 	assert.ErrorAs(t, err, &mockErr)
 	mockUR.AssertExpectations(t)
@@ -618,7 +628,7 @@ func TestSetlistSetlistGetByIDErr(t *testing.T) {
 
 	sls := service.NewSetlistService(mockUR, mockSLR)
 
-	err := sls.Remove(context.TODO(), slid, mockUser)
+	err := sls.Remove(context.TODO(), slid, time.Time{}, mockUser)
 
 	assert.ErrorAs(t, err, &mockErr)
 	mockUR.AssertExpectations(t)
@@ -639,14 +649,376 @@ func TestSetlistRemoveErr(t *testing.T) {
 	mockSLR := &mocks.MockSetlistRepository{}
 
 	mockSLR.
-		On("Delete", context.TODO(), slid).
+		On("Delete", context.TODO(), slid, time.Time{}).
 		Return(mockErr)
 
 	sls := service.NewSetlistService(mockUR, mockSLR)
 
-	err := sls.Remove(context.TODO(), slid, mockUser)
+	err := sls.Remove(context.TODO(), slid, time.Time{}, mockUser)
+
+	assert.ErrorAs(t, err, &mockErr)
+	mockUR.AssertExpectations(t)
+	mockSLR.AssertExpectations(t)
+}
+
+func TestSetlistRemoveChangedConcurrently(t *testing.T) {
+	t.Parallel()
+
+	slid := int64(1)
+	version := time.Now().Add(-time.Hour)
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.ADMIN,
+	}
+
+	mockErr := domain.NewPreconditionFailedErr("")
+	mockUR := &mocks.MockUserRepository{}
+	mockSLR := &mocks.MockSetlistRepository{}
+
+	mockSLR.
+		On("Delete", context.TODO(), slid, version).
+		Return(mockErr)
+
+	sls := service.NewSetlistService(mockUR, mockSLR)
+
+	err := sls.Remove(context.TODO(), slid, version, mockUser)
+
+	assert.ErrorAs(t, err, &mockErr)
+	mockUR.AssertExpectations(t)
+	mockSLR.AssertExpectations(t)
+}
+
+func TestSetlistFetchByIDDraft(t *testing.T) {
+	t.Parallel()
+
+	mockSetlist := &domain.Setlist{
+		ID:        1,
+		CreatorID: 2,
+		Name:      "Foo",
+		Deadline:  time.Now().Add(time.Hour),
+		Status:    domain.SetlistDraft,
+	}
+
+	t.Run("Correct creator", func(t *testing.T) {
+		t.Parallel()
+
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(&domain.Setlist{ID: 1, CreatorID: 2, Status: domain.SetlistDraft}, nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlist, err := sls.FetchByID(context.TODO(), mockSetlist.ID, &domain.User{ID: 2, Permission: domain.MEMBER})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SetlistDraft, setlist.Status)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Fail other member", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), mockSetlist.ID).
+			Return(&domain.Setlist{ID: 1, CreatorID: 2, Status: domain.SetlistDraft}, nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlist, err := sls.FetchByID(context.TODO(), mockSetlist.ID, &domain.User{ID: 1, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, setlist)
+		mockSLR.AssertExpectations(t)
+	})
+}
+
+func TestSetlistFetchAllStatus(t *testing.T) {
+	t.Parallel()
+
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	newSetlists := func() *[]domain.Setlist {
+		return &[]domain.Setlist{
+			{ID: 1, CreatorID: 2, Deadline: future, Status: domain.SetlistDraft},
+			{ID: 2, CreatorID: 2, Deadline: future, Status: domain.SetlistPublished},
+			{ID: 3, CreatorID: 2, Deadline: past, Status: domain.SetlistPublished},
+			{ID: 4, CreatorID: 2, Deadline: past, Status: domain.SetlistArchived},
+		}
+	}
+
+	t.Run("Correct anonymous", func(t *testing.T) {
+		t.Parallel()
+
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetAll", context.TODO()).
+			Return(newSetlists(), nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlists, err := sls.FetchAll(context.TODO(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, &[]domain.Setlist{
+			{ID: 2, CreatorID: 2, Deadline: future, Status: domain.SetlistPublished},
+			{ID: 3, CreatorID: 2, Deadline: past, Status: domain.SetlistLocked},
+			{ID: 4, CreatorID: 2, Deadline: past, Status: domain.SetlistArchived},
+		}, setlists)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Correct editor", func(t *testing.T) {
+		t.Parallel()
+
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetAll", context.TODO()).
+			Return(newSetlists(), nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlists, err := sls.FetchAll(context.TODO(), &domain.User{ID: 1, Permission: domain.EDITOR})
+		assert.NoError(t, err)
+		assert.Len(t, *setlists, 4)
+		assert.Equal(t, domain.SetlistDraft, (*setlists)[0].Status)
+		mockSLR.AssertExpectations(t)
+	})
+}
+
+func TestSetlistUpdateLocked(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+
+	mockSetlist := &domain.Setlist{
+		ID:        1,
+		CreatorID: mockUser.ID,
+		Deadline:  time.Now().Add(-time.Hour),
+		Name:      "Foobar",
+		Status:    domain.SetlistPublished,
+	}
+
+	mockErr := domain.NewNotAuthorizedErr("")
+	mockUR := &mocks.MockUserRepository{}
+	mockSLR := &mocks.MockSetlistRepository{}
+
+	mockSLR.
+		On("GetByID", context.TODO(), mockSetlist.ID).
+		Return(mockSetlist, nil)
+
+	sls := service.NewSetlistService(mockUR, mockSLR)
+
+	updatedSetlist, err := sls.Update(context.TODO(), &domain.Setlist{ID: 1, CreatorID: 1, Name: "Bar"}, mockUser)
+	assert.Nil(t, updatedSetlist)
+	assert.ErrorAs(t, err, &mockErr)
+	mockUR.AssertExpectations(t)
+	mockSLR.AssertExpectations(t)
+}
+
+func TestSetlistRemoveLocked(t *testing.T) {
+	t.Parallel()
 
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.EDITOR,
+	}
+
+	mockErr := domain.NewNotAuthorizedErr("")
+	mockUR := &mocks.MockUserRepository{}
+	mockSLR := &mocks.MockSetlistRepository{}
+
+	mockSLR.
+		On("GetByID", context.TODO(), int64(1)).
+		Return(&domain.Setlist{ID: 1, CreatorID: mockUser.ID, Status: domain.SetlistArchived}, nil)
+
+	sls := service.NewSetlistService(mockUR, mockSLR)
+
+	err := sls.Remove(context.TODO(), 1, time.Time{}, mockUser)
 	assert.ErrorAs(t, err, &mockErr)
 	mockUR.AssertExpectations(t)
 	mockSLR.AssertExpectations(t)
 }
+
+func TestSetlistPublish(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.MEMBER,
+	}
+	future := time.Now().Add(time.Hour)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(&domain.Setlist{ID: 1, CreatorID: mockUser.ID, Deadline: future, Status: domain.SetlistDraft}, nil)
+		mockSLR.
+			On("Update", context.TODO(), &domain.Setlist{ID: 1, Status: domain.SetlistPublished}).
+			Return(&domain.Setlist{ID: 1, CreatorID: mockUser.ID, Deadline: future, Status: domain.SetlistPublished}, nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlist, err := sls.Publish(context.TODO(), 1, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SetlistPublished, setlist.Status)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Fail not creator", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(&domain.Setlist{ID: 1, CreatorID: 2, Deadline: future, Status: domain.SetlistDraft}, nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlist, err := sls.Publish(context.TODO(), 1, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, setlist)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Fail already published", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(&domain.Setlist{ID: 1, CreatorID: mockUser.ID, Deadline: future, Status: domain.SetlistPublished}, nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlist, err := sls.Publish(context.TODO(), 1, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, setlist)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Fail deadline passed", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(&domain.Setlist{ID: 1, CreatorID: mockUser.ID, Deadline: time.Now().Add(-time.Hour), Status: domain.SetlistDraft}, nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlist, err := sls.Publish(context.TODO(), 1, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, setlist)
+		mockSLR.AssertExpectations(t)
+	})
+}
+
+func TestSetlistUnpublish(t *testing.T) {
+	t.Parallel()
+
+	past := time.Now().Add(-time.Hour)
+
+	t.Run("Correct admin locked", func(t *testing.T) {
+		t.Parallel()
+
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(&domain.Setlist{ID: 1, CreatorID: 2, Deadline: past, Status: domain.SetlistPublished}, nil)
+		mockSLR.
+			On("Update", context.TODO(), &domain.Setlist{ID: 1, Status: domain.SetlistDraft}).
+			Return(&domain.Setlist{ID: 1, CreatorID: 2, Deadline: past, Status: domain.SetlistDraft}, nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlist, err := sls.Unpublish(context.TODO(), 1, &domain.User{ID: 1, Permission: domain.ADMIN})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SetlistDraft, setlist.Status)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Fail creator locked", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(&domain.Setlist{ID: 1, CreatorID: 2, Deadline: past, Status: domain.SetlistPublished}, nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlist, err := sls.Unpublish(context.TODO(), 1, &domain.User{ID: 2, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, setlist)
+		mockSLR.AssertExpectations(t)
+	})
+}
+
+func TestSetlistArchive(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		ID:         1,
+		Permission: domain.MEMBER,
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		past := time.Now().Add(-time.Hour)
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(&domain.Setlist{ID: 1, CreatorID: mockUser.ID, Deadline: past, Status: domain.SetlistPublished}, nil)
+		mockSLR.
+			On("Update", context.TODO(), &domain.Setlist{ID: 1, Status: domain.SetlistArchived}).
+			Return(&domain.Setlist{ID: 1, CreatorID: mockUser.ID, Deadline: past, Status: domain.SetlistArchived}, nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlist, err := sls.Archive(context.TODO(), 1, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SetlistArchived, setlist.Status)
+		mockSLR.AssertExpectations(t)
+	})
+
+	t.Run("Fail before deadline", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSLR := &mocks.MockSetlistRepository{}
+
+		mockSLR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(&domain.Setlist{ID: 1, CreatorID: mockUser.ID, Deadline: time.Now().Add(time.Hour), Status: domain.SetlistPublished}, nil)
+
+		sls := service.NewSetlistService(&mocks.MockUserRepository{}, mockSLR)
+
+		setlist, err := sls.Archive(context.TODO(), 1, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, setlist)
+		mockSLR.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,370 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type templateMocks struct {
+	str  *mocks.MockSetlistTemplateRepository
+	slr  *mocks.MockSetlistRepository
+	sler *mocks.MockSetlistEntryRepository
+	slrr *mocks.MockSetlistRoleRepository
+	sr   *mocks.MockSongRepository
+	rr   *mocks.MockRoleRepository
+	urr  *mocks.MockUserRoleRepository
+	tx   *mocks.MockTransactor
+}
+
+func newTemplateMocks() *templateMocks {
+	return &templateMocks{
+		str:  &mocks.MockSetlistTemplateRepository{},
+		slr:  &mocks.MockSetlistRepository{},
+		sler: &mocks.MockSetlistEntryRepository{},
+		slrr: &mocks.MockSetlistRoleRepository{},
+		sr:   &mocks.MockSongRepository{},
+		rr:   &mocks.MockRoleRepository{},
+		urr:  &mocks.MockUserRoleRepository{},
+		tx:   &mocks.MockTransactor{},
+	}
+}
+
+func (tm *templateMocks) service() domain.SetlistTemplateService {
+	return service.NewSetlistTemplateService(tm.str, tm.slr, tm.sler, tm.slrr, tm.sr, tm.rr, tm.urr, tm.tx)
+}
+
+func (tm *templateMocks) assertExpectations(t *testing.T) {
+	t.Helper()
+
+	tm.str.AssertExpectations(t)
+	tm.slr.AssertExpectations(t)
+	tm.sler.AssertExpectations(t)
+	tm.slrr.AssertExpectations(t)
+	tm.sr.AssertExpectations(t)
+	tm.rr.AssertExpectations(t)
+	tm.urr.AssertExpectations(t)
+	tm.tx.AssertExpectations(t)
+}
+
+func TestSetlistTemplateStore(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.EDITOR}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTemplate := &domain.SetlistTemplate{
+			Name: " Sunday ",
+			Slots: []domain.TemplateSlot{
+				{Name: "Opening", Rank: 1},
+				{Type: domain.SongItem, SongID: 3, Rank: 2},
+				{Type: domain.ScriptureItem, Reference: "John 3:16", Rank: 3},
+			},
+			Roles: []domain.TemplateRole{{RoleID: 1}, {RoleID: 2, UserRoleID: 4}},
+		}
+		tm := newTemplateMocks()
+
+		tm.sr.On("GetByID", context.TODO(), int64(3)).Return(&domain.Song{ID: 3}, nil)
+		tm.rr.On("GetByID", context.TODO(), int64(1)).Return(&domain.Role{ID: 1}, nil)
+		tm.rr.On("GetByID", context.TODO(), int64(2)).Return(&domain.Role{ID: 2}, nil)
+		tm.urr.On("GetByID", context.TODO(), int64(4)).Return(&domain.UserRole{ID: 4, RoleID: 2}, nil)
+		tm.str.On("Create", context.TODO(), mockTemplate).Return(nil)
+
+		err := tm.service().Store(context.TODO(), mockTemplate, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, "Sunday", mockTemplate.Name)
+		assert.Equal(t, mockUser.ID, mockTemplate.CreatorID)
+		assert.Equal(t, domain.SongItem, mockTemplate.Slots[0].Type)
+		tm.assertExpectations(t)
+	})
+
+	t.Run("Fail invalid template", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		tm := newTemplateMocks()
+
+		err := tm.service().Store(context.TODO(), &domain.SetlistTemplate{Name: " "}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+
+		err = tm.service().Store(context.TODO(), &domain.SetlistTemplate{
+			Name:  "Sunday",
+			Slots: []domain.TemplateSlot{{Rank: 1}},
+		}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		tm.assertExpectations(t)
+	})
+
+	t.Run("Fail user role of other role", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		tm := newTemplateMocks()
+
+		tm.rr.On("GetByID", context.TODO(), int64(1)).Return(&domain.Role{ID: 1}, nil)
+		tm.urr.On("GetByID", context.TODO(), int64(4)).Return(&domain.UserRole{ID: 4, RoleID: 2}, nil)
+
+		err := tm.service().Store(context.TODO(), &domain.SetlistTemplate{
+			Name:  "Sunday",
+			Roles: []domain.TemplateRole{{RoleID: 1, UserRoleID: 4}},
+		}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		tm.assertExpectations(t)
+	})
+
+	t.Run("Fail member", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		tm := newTemplateMocks()
+
+		err := tm.service().Store(context.TODO(), &domain.SetlistTemplate{Name: "Sunday"}, &domain.User{ID: 1, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		tm.assertExpectations(t)
+	})
+}
+
+func TestSetlistTemplateUpdate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct creator", func(t *testing.T) {
+		t.Parallel()
+
+		mockTemplate := &domain.SetlistTemplate{ID: 1, Name: "Sunday"}
+		tm := newTemplateMocks()
+
+		tm.str.On("GetByID", context.TODO(), int64(1)).Return(&domain.SetlistTemplate{ID: 1, CreatorID: 2}, nil)
+		tm.str.On("Update", context.TODO(), mockTemplate).Return(nil)
+
+		err := tm.service().Update(context.TODO(), mockTemplate, &domain.User{ID: 2, Permission: domain.EDITOR})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), mockTemplate.CreatorID)
+		tm.assertExpectations(t)
+	})
+
+	t.Run("Fail not creator", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		tm := newTemplateMocks()
+
+		tm.str.On("GetByID", context.TODO(), int64(1)).Return(&domain.SetlistTemplate{ID: 1, CreatorID: 2}, nil)
+
+		err := tm.service().Update(
+			context.TODO(), &domain.SetlistTemplate{ID: 1, Name: "Sunday"}, &domain.User{ID: 3, Permission: domain.EDITOR},
+		)
+		assert.ErrorAs(t, err, &expErr)
+		tm.assertExpectations(t)
+	})
+}
+
+func TestSetlistTemplateRemove(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct admin", func(t *testing.T) {
+		t.Parallel()
+
+		tm := newTemplateMocks()
+
+		tm.str.On("GetByID", context.TODO(), int64(1)).Return(&domain.SetlistTemplate{ID: 1, CreatorID: 2}, nil)
+		tm.str.On("Delete", context.TODO(), int64(1)).Return(nil)
+
+		err := tm.service().Remove(context.TODO(), 1, &domain.User{ID: 3, Permission: domain.ADMIN})
+		assert.NoError(t, err)
+		tm.assertExpectations(t)
+	})
+
+	t.Run("Fail GetByID error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		tm := newTemplateMocks()
+
+		tm.str.On("GetByID", context.TODO(), int64(1)).Return(nil, expErr)
+
+		err := tm.service().Remove(context.TODO(), 1, &domain.User{ID: 3, Permission: domain.ADMIN})
+		assert.ErrorAs(t, err, &expErr)
+		tm.assertExpectations(t)
+	})
+}
+
+func TestSetlistTemplateInstantiate(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+	deadline := time.Now().Add(time.Hour * 24).Truncate(time.Minute)
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSetlist := &domain.Setlist{Deadline: deadline}
+		tm := newTemplateMocks()
+
+		tm.str.On("GetByID", context.TODO(), int64(1)).Return(&domain.SetlistTemplate{
+			ID:   1,
+			Name: "Sunday",
+			Slots: []domain.TemplateSlot{
+				{ID: 1, Name: "Opening", Type: domain.SongItem, Rank: 1},
+				{ID: 2, Type: domain.SongItem, SongID: 3, Transpose: 2, Rank: 2},
+			},
+			Roles: []domain.TemplateRole{{ID: 1, RoleID: 1}, {ID: 2, RoleID: 2, UserRoleID: 4}},
+		}, nil)
+		tm.tx.On("WithinTransaction", context.TODO()).Return(nil)
+		tm.slr.
+			On("Create", context.TODO(), &domain.Setlist{Name: "Sunday", CreatorID: 1, Deadline: deadline, Status: domain.SetlistDraft}).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				arg, ok := args.Get(1).(*domain.Setlist)
+				assert.True(t, ok)
+				arg.ID = 5
+			})
+		tm.sler.
+			On("CreateBatch", context.TODO(), &[]domain.SetlistEntry{
+				{Type: domain.TextItem, SetlistID: 5, Title: "Opening", Rank: 1},
+				{Type: domain.SongItem, SongID: 3, SetlistID: 5, Transpose: 2, Rank: 2},
+			}).
+			Return(nil)
+		tm.slrr.
+			On("Create", context.TODO(), &[]domain.SetlistRole{{SetlistID: 5, UserRoleID: 4}}).
+			Return(nil)
+
+		copied, err := tm.service().Instantiate(context.TODO(), 1, mockSetlist, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), copied.Setlist.ID)
+		assert.Equal(t, "Sunday", copied.Setlist.Name)
+		assert.Len(t, copied.Entries, 2)
+		assert.Len(t, copied.Roles, 1)
+		tm.assertExpectations(t)
+	})
+
+	t.Run("Fail CreateBatch error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		tm := newTemplateMocks()
+
+		tm.str.On("GetByID", context.TODO(), int64(1)).Return(&domain.SetlistTemplate{
+			ID:    1,
+			Name:  "Sunday",
+			Slots: []domain.TemplateSlot{{ID: 1, Name: "Opening", Type: domain.SongItem, Rank: 1}},
+		}, nil)
+		tm.tx.On("WithinTransaction", context.TODO()).Return(nil)
+		tm.slr.On("Create", context.TODO(), mock.AnythingOfType("*domain.Setlist")).Return(nil)
+		tm.sler.On("CreateBatch", context.TODO(), mock.AnythingOfType("*[]domain.SetlistEntry")).Return(expErr)
+
+		_, err := tm.service().Instantiate(context.TODO(), 1, &domain.Setlist{Deadline: deadline}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		tm.assertExpectations(t)
+	})
+
+	t.Run("Fail deadline in the past", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		tm := newTemplateMocks()
+
+		_, err := tm.service().Instantiate(
+			context.TODO(), 1, &domain.Setlist{Deadline: time.Now().Add(-time.Hour)}, mockUser,
+		)
+		assert.ErrorAs(t, err, &expErr)
+		tm.assertExpectations(t)
+	})
+
+	t.Run("Fail guest", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		tm := newTemplateMocks()
+
+		_, err := tm.service().Instantiate(
+			context.TODO(), 1, &domain.Setlist{Deadline: deadline}, &domain.User{ID: 1, Permission: domain.GUEST},
+		)
+		assert.ErrorAs(t, err, &expErr)
+		tm.assertExpectations(t)
+	})
+}
+
+func TestSetlistTemplateClone(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 2, Permission: domain.MEMBER}
+	deadline := time.Now().Add(time.Hour * 24).Truncate(time.Minute)
+	original := &domain.Setlist{ID: 1, Name: "Easter", CreatorID: 1, Deadline: time.Now(), Status: domain.SetlistPublished}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSetlist := &domain.Setlist{Name: "Easter evening", Deadline: deadline}
+		tm := newTemplateMocks()
+
+		tm.slr.On("GetByID", context.TODO(), int64(1)).Return(original, nil)
+		tm.sler.
+			On("GetBySetlist", context.TODO(), &[]domain.Setlist{*original}).
+			Return(&[]domain.SetlistEntry{
+				{ID: 1, SetlistID: 1, Type: domain.SongItem, SongID: 3, Rank: 1},
+				{ID: 2, SetlistID: 1, Type: domain.HeaderItem, Title: "Response", Rank: 2},
+			}, nil)
+		tm.slrr.
+			On("Get", context.TODO(), []int64{1}).
+			Return(&[]domain.SetlistRole{{ID: 1, SetlistID: 1, UserRoleID: 4}}, nil)
+		tm.tx.On("WithinTransaction", context.TODO()).Return(nil)
+		tm.slr.
+			On("Create", context.TODO(), &domain.Setlist{Name: "Easter evening", CreatorID: 2, Deadline: deadline, Status: domain.SetlistDraft}).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				arg, ok := args.Get(1).(*domain.Setlist)
+				assert.True(t, ok)
+				arg.ID = 6
+			})
+		tm.sler.
+			On("CreateBatch", context.TODO(), &[]domain.SetlistEntry{
+				{SetlistID: 6, Type: domain.SongItem, SongID: 3, Rank: 1},
+				{SetlistID: 6, Type: domain.HeaderItem, Title: "Response", Rank: 2},
+			}).
+			Return(nil)
+		tm.slrr.
+			On("Create", context.TODO(), &[]domain.SetlistRole{{SetlistID: 6, UserRoleID: 4}}).
+			Return(nil)
+
+		copied, err := tm.service().Clone(context.TODO(), 1, mockSetlist, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(6), copied.Setlist.ID)
+		assert.Equal(t, deadline, copied.Setlist.Deadline)
+		tm.assertExpectations(t)
+	})
+
+	t.Run("Fail GetByID error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		tm := newTemplateMocks()
+
+		tm.slr.On("GetByID", context.TODO(), int64(1)).Return(nil, expErr)
+
+		_, err := tm.service().Clone(context.TODO(), 1, &domain.Setlist{Deadline: deadline}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		tm.assertExpectations(t)
+	})
+
+	t.Run("Fail draft of someone else", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		draft := &domain.Setlist{ID: 1, Name: "Easter", CreatorID: 1, Deadline: deadline, Status: domain.SetlistDraft}
+		tm := newTemplateMocks()
+
+		tm.slr.On("GetByID", context.TODO(), int64(1)).Return(draft, nil)
+
+		_, err := tm.service().Clone(context.TODO(), 1, &domain.Setlist{Deadline: deadline}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		tm.assertExpectations(t)
+	})
+}
@@ -2,11 +2,14 @@ package service_test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
 	"github.com/96Asch/mkvstage-server/backend/internal/service"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/datatypes"
@@ -25,46 +28,153 @@ func TestSongServiceFetch(t *testing.T) {
 	}
 
 	mockFilterOptions := &domain.SongFilterOptions{}
+	mockTagCounts := []domain.TagCount{{TagID: 1, Name: "grace", Count: 2}}
 
 	mockUR := &mocks.MockUserRepository{}
 	mockBR := &mocks.MockBundleRepository{}
+	mockSRR := &mocks.MockSongRevisionRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
 
 	t.Run("Correct", func(t *testing.T) {
 		t.Parallel()
 
 		mockSR := &mocks.MockSongRepository{}
 
+		mockSR.
+			On("Count", context.TODO(), mockFilterOptions).
+			Return(int64(2), nil)
 		mockSR.
 			On("Get", context.TODO(), mockFilterOptions).
 			Return(mockSongs, nil)
+		mockSR.
+			On("CountTags", context.TODO(), mockFilterOptions).
+			Return(mockTagCounts, nil)
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 
-		songs, err := ss.Fetch(context.TODO(), mockFilterOptions)
+		page, err := ss.Fetch(context.TODO(), mockFilterOptions)
 
 		assert.NoError(t, err)
-		assert.Equal(t, mockSongs, songs)
+		assert.Equal(t, &domain.SongPage{Songs: mockSongs, Total: 2, TagCounts: mockTagCounts}, page)
 		mockSR.AssertExpectations(t)
 		mockBR.AssertExpectations(t)
 		mockUR.AssertExpectations(t)
 	})
 
-	t.Run("fail Song Get error", func(t *testing.T) {
+	t.Run("Correct next page", func(t *testing.T) {
+		t.Parallel()
+
+		options := &domain.SongFilterOptions{Sort: domain.SongSortTitle, Limit: 1}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("Count", context.TODO(), options).
+			Return(int64(2), nil)
+		mockSR.
+			On("Get", context.TODO(), &domain.SongFilterOptions{Sort: domain.SongSortTitle, Limit: 2}).
+			Return(mockSongs, nil)
+		mockSR.
+			On("CountTags", context.TODO(), options).
+			Return(mockTagCounts, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		page, err := ss.Fetch(context.TODO(), options)
+
+		assert.NoError(t, err)
+		assert.Equal(t, mockSongs[:1], page.Songs)
+		assert.Equal(t, int64(2), page.Total)
+		assert.Equal(t, 1, options.Limit)
+
+		cursor, err := util.DecodeSongCursor(page.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.SongCursor{ID: 1, Value: "Foobar"}, cursor)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid options", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSR := &mocks.MockSongRepository{}
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		for _, options := range []*domain.SongFilterOptions{
+			{Sort: "foo"},
+			{MinBpm: 120, MaxBpm: 60},
+			{Limit: -1},
+		} {
+			page, err := ss.Fetch(context.TODO(), options)
+			assert.ErrorAs(t, err, &expErr)
+			assert.Nil(t, page)
+		}
+
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail Song Count error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("Count", context.TODO(), mockFilterOptions).
+			Return(int64(0), expErr)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		page, err := ss.Fetch(context.TODO(), mockFilterOptions)
+
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, page)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail Song CountTags error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("Count", context.TODO(), mockFilterOptions).
+			Return(int64(2), nil)
+		mockSR.
+			On("Get", context.TODO(), mockFilterOptions).
+			Return(mockSongs, nil)
+		mockSR.
+			On("CountTags", context.TODO(), mockFilterOptions).
+			Return(nil, expErr)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		page, err := ss.Fetch(context.TODO(), mockFilterOptions)
+
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, page)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail Song Get error", func(t *testing.T) {
 		t.Parallel()
 
 		expErr := domain.NewInternalErr()
 		mockSR := &mocks.MockSongRepository{}
 
+		mockSR.
+			On("Count", context.TODO(), mockFilterOptions).
+			Return(int64(2), nil)
 		mockSR.
 			On("Get", context.TODO(), mockFilterOptions).
 			Return(nil, expErr)
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 
-		songs, err := ss.Fetch(context.TODO(), mockFilterOptions)
+		page, err := ss.Fetch(context.TODO(), mockFilterOptions)
 
 		assert.ErrorAs(t, err, &expErr)
-		assert.Nil(t, songs)
+		assert.Nil(t, page)
 		mockSR.AssertExpectations(t)
 		mockBR.AssertExpectations(t)
 		mockUR.AssertExpectations(t)
@@ -99,6 +209,8 @@ func TestSongServiceStore(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 		mockSong := &domain.Song{
 			CreatorID:  mockUser.ID,
 			Title:      "Foo",
@@ -119,14 +231,25 @@ func TestSongServiceStore(t *testing.T) {
 				assert.True(t, ok)
 				arg.ID = 1
 			})
+		mockSRR.
+			On("Create", context.TODO(), mock.AnythingOfType("*domain.SongRevision")).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				arg, ok := args.Get(1).(*domain.SongRevision)
+				assert.True(t, ok)
+				assert.Equal(t, int64(1), arg.SongID)
+				assert.Equal(t, mockUser.ID, arg.EditorID)
+				assert.Equal(t, mockSong.ChordSheet, arg.ChordSheet)
+			})
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
 		err := ss.Store(ctx, mockSong, mockUser)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, mockSong.ID)
 		mockSR.AssertExpectations(t)
+		mockSRR.AssertExpectations(t)
 	})
 
 	t.Run("Fail invalid permission", func(t *testing.T) {
@@ -136,9 +259,11 @@ func TestSongServiceStore(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 		mockUser := &domain.User{ID: 1, Permission: domain.GUEST}
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
 		mockUser.Permission = domain.GUEST
@@ -154,9 +279,11 @@ func TestSongServiceStore(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 		mockSong := &domain.Song{Key: "R"}
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
 		err := ss.Store(ctx, mockSong, mockUser)
@@ -172,13 +299,43 @@ func TestSongServiceStore(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 		mockSong := &domain.Song{Key: "A", ChordSheet: datatypes.JSON([]byte(`{"`))}
 
 		mockUR.
 			On("GetByID", context.TODO(), mockSong.CreatorID).
 			Return(mockUser, nil)
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+		ctx := context.TODO()
+
+		err := ss.Store(ctx, mockSong, mockUser)
+		assert.ErrorAs(t, err, &mockErr)
+		assert.Empty(t, mockSong.ID)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail malformed chord", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewBadRequestErr("")
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSong := &domain.Song{
+			Key:        "A",
+			CreatorID:  mockUser.ID,
+			ChordSheet: datatypes.JSON([]byte(`[{"tag":"Verse","text":"[A]Foo [Hm]bar"}]`)),
+		}
+
+		mockUR.
+			On("GetByID", context.TODO(), mockSong.CreatorID).
+			Return(mockUser, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
 		err := ss.Store(ctx, mockSong, mockUser)
@@ -187,6 +344,35 @@ func TestSongServiceStore(t *testing.T) {
 		mockSR.AssertExpectations(t)
 	})
 
+	t.Run("Fail invalid CCLI number", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewBadRequestErr("")
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		for _, number := range []string{"12a", "0123", "12345678901"} {
+			mockSong := &domain.Song{
+				Key:        "A",
+				CreatorID:  mockUser.ID,
+				ChordSheet: datatypes.JSON([]byte(`{"Verse":"[A]Foo"}`)),
+				CCLINumber: number,
+			}
+
+			err := ss.Store(context.TODO(), mockSong, mockUser)
+			assert.ErrorAs(t, err, &mockErr, number)
+			assert.Empty(t, mockSong.ID)
+		}
+
+		mockSR.AssertExpectations(t)
+		mockBR.AssertExpectations(t)
+	})
+
 	t.Run("Fail Bundle GetByID error", func(t *testing.T) {
 		t.Parallel()
 
@@ -194,12 +380,14 @@ func TestSongServiceStore(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 
 		mockBR.
 			On("GetByID", context.TODO(), mockSong.BundleID).
 			Return(nil, mockErr)
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
 		err := ss.Store(ctx, mockSong, mockUser)
@@ -237,6 +425,8 @@ func TestSongServiceUpdate(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 
 		mockBR.
 			On("GetByID", context.TODO(), mockSong.BundleID).
@@ -248,8 +438,20 @@ func TestSongServiceUpdate(t *testing.T) {
 		mockSR.
 			On("Update", context.TODO(), mockSong).
 			Return(nil)
+		mockSRR.
+			On("GetBySong", context.TODO(), mockSong.ID).
+			Return([]domain.SongRevision{{ID: 1, SongID: mockSong.ID}}, nil)
+		mockSRR.
+			On("Create", context.TODO(), mock.AnythingOfType("*domain.SongRevision")).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				arg, ok := args.Get(1).(*domain.SongRevision)
+				assert.True(t, ok)
+				assert.Equal(t, mockSong.ID, arg.SongID)
+				assert.Equal(t, mockUser.ID, arg.EditorID)
+			})
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
 		err := ss.Update(ctx, mockSong, mockUser)
@@ -258,6 +460,97 @@ func TestSongServiceUpdate(t *testing.T) {
 		mockSR.AssertExpectations(t)
 		mockUR.AssertExpectations(t)
 		mockBR.AssertExpectations(t)
+		mockSRR.AssertExpectations(t)
+	})
+
+	t.Run("Correct records baseline", func(t *testing.T) {
+		t.Parallel()
+
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		currentSong := &domain.Song{
+			ID:         mockSong.ID,
+			CreatorID:  3,
+			Title:      "Old",
+			Key:        "G",
+			ChordSheet: datatypes.JSON([]byte(`{"Verse" : "Old"}`)),
+		}
+
+		mockBR.
+			On("GetByID", context.TODO(), mockSong.BundleID).
+			Return(mockBundle, nil)
+		mockUR.
+			On("GetByID", context.TODO(), mockSong.CreatorID).
+			Return(mockUser, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(currentSong, nil)
+		mockSR.
+			On("Update", context.TODO(), mockSong).
+			Return(nil)
+		mockSRR.
+			On("GetBySong", context.TODO(), mockSong.ID).
+			Return([]domain.SongRevision{}, nil)
+
+		revisions := make([]*domain.SongRevision, 0)
+
+		mockSRR.
+			On("Create", context.TODO(), mock.AnythingOfType("*domain.SongRevision")).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				arg, ok := args.Get(1).(*domain.SongRevision)
+				assert.True(t, ok)
+				revisions = append(revisions, arg)
+			})
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		err := ss.Update(context.TODO(), mockSong, mockUser)
+		assert.NoError(t, err)
+		assert.Len(t, revisions, 2)
+		assert.Equal(t, "Old", revisions[0].Title)
+		assert.Equal(t, currentSong.CreatorID, revisions[0].EditorID)
+		assert.Equal(t, "Foo", revisions[1].Title)
+		assert.Equal(t, mockUser.ID, revisions[1].EditorID)
+		mockSR.AssertExpectations(t)
+		mockSRR.AssertExpectations(t)
+	})
+
+	t.Run("Fail changed concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewPreconditionFailedErr("")
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		staleSong := *mockSong
+		staleSong.UpdatedAt = time.Now().Add(-time.Hour)
+
+		mockBR.
+			On("GetByID", context.TODO(), mockSong.BundleID).
+			Return(mockBundle, nil)
+		mockUR.
+			On("GetByID", context.TODO(), mockSong.CreatorID).
+			Return(mockUser, nil)
+		mockSRR.
+			On("GetBySong", context.TODO(), mockSong.ID).
+			Return([]domain.SongRevision{{ID: 1, SongID: mockSong.ID}}, nil)
+		mockSR.
+			On("Update", context.TODO(), &staleSong).
+			Return(mockErr)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		err := ss.Update(context.TODO(), &staleSong, mockUser)
+		assert.ErrorAs(t, err, &mockErr)
+		mockSR.AssertExpectations(t)
+		mockSRR.AssertExpectations(t)
+		mockSRR.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
 	})
 
 	t.Run("Fail invalid permission not creator", func(t *testing.T) {
@@ -267,13 +560,15 @@ func TestSongServiceUpdate(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 		mockUser := &domain.User{ID: 2, Permission: domain.MEMBER}
 
 		mockSR.
 			On("GetByID", context.TODO(), mockSong.ID).
 			Return(mockSong, nil)
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
 		err := ss.Update(ctx, mockSong, mockUser)
@@ -290,13 +585,15 @@ func TestSongServiceUpdate(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 		mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
 
 		mockSR.
 			On("GetByID", context.TODO(), mockSong.ID).
 			Return(nil, mockErr)
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
 		err := ss.Update(ctx, mockSong, mockUser)
@@ -313,9 +610,11 @@ func TestSongServiceUpdate(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 		mockSong := &domain.Song{Key: "W"}
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
 		err := ss.Update(ctx, mockSong, mockUser)
@@ -326,6 +625,26 @@ func TestSongServiceUpdate(t *testing.T) {
 		mockBR.AssertExpectations(t)
 	})
 
+	t.Run("Fail license fields too long", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewBadRequestErr("")
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockSong := &domain.Song{Key: "A", CCLINumber: "22025", Copyright: strings.Repeat("a", 256)}
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		err := ss.Update(context.TODO(), mockSong, mockUser)
+		assert.ErrorAs(t, err, &mockErr)
+		mockSR.AssertExpectations(t)
+		mockUR.AssertExpectations(t)
+		mockBR.AssertExpectations(t)
+	})
+
 	t.Run("Fail User GetByID error", func(t *testing.T) {
 		t.Parallel()
 
@@ -333,6 +652,8 @@ func TestSongServiceUpdate(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 
 		mockBR.
 			On("GetByID", context.TODO(), mockSong.BundleID).
@@ -341,7 +662,7 @@ func TestSongServiceUpdate(t *testing.T) {
 			On("GetByID", context.TODO(), mockSong.CreatorID).
 			Return(nil, mockErr)
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
 		err := ss.Update(ctx, mockSong, mockUser)
@@ -357,9 +678,11 @@ func TestSongServiceUpdate(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 		mockSong := &domain.Song{Key: "A", ChordSheet: datatypes.JSON([]byte(``))}
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
 		err := ss.Update(ctx, mockSong, mockUser)
@@ -393,38 +716,67 @@ func TestSongServiceRemove(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 
 		mockSR.
 			On("GetByID", context.TODO(), mockSong.ID).
 			Return(mockSong, nil)
 		mockSR.
-			On("Delete", context.TODO(), mockSong.ID).
+			On("Delete", context.TODO(), mockSong.ID, time.Time{}).
 			Return(nil)
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
-		err := ss.Remove(ctx, mockSong.ID, mockUser)
+		err := ss.Remove(ctx, mockSong.ID, time.Time{}, mockUser)
 		assert.NoError(t, err)
 		mockSR.AssertExpectations(t)
 	})
 
+	t.Run("Fail changed concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewPreconditionFailedErr("")
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		version := time.Now().Add(-time.Hour)
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockSR.
+			On("Delete", context.TODO(), mockSong.ID, version).
+			Return(mockErr)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		err := ss.Remove(context.TODO(), mockSong.ID, version, mockUser)
+		assert.ErrorAs(t, err, &mockErr)
+		mockSR.AssertExpectations(t)
+	})
+
 	t.Run("Fail invalid permission", func(t *testing.T) {
 		t.Parallel()
 
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 		mockUser := &domain.User{ID: 2, Permission: domain.GUEST}
 
 		mockSR.
 			On("GetByID", context.TODO(), mockSong.ID).
 			Return(mockSong, nil)
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
-		err := ss.Remove(ctx, mockSong.ID, mockUser)
+		err := ss.Remove(ctx, mockSong.ID, time.Time{}, mockUser)
 		mockErr := domain.NewNotAuthorizedErr("")
 		assert.ErrorAs(t, err, &mockErr)
 		mockSR.AssertExpectations(t)
@@ -438,17 +790,863 @@ func TestSongServiceRemove(t *testing.T) {
 		mockUR := &mocks.MockUserRepository{}
 		mockSR := &mocks.MockSongRepository{}
 		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
 		mockUser := &domain.User{ID: 1, Permission: domain.GUEST}
 
 		mockSR.
 			On("GetByID", context.TODO(), mockSongID).
 			Return(nil, mockErr)
 
-		ss := service.NewSongService(mockUR, mockSR, mockBR)
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
 		ctx := context.TODO()
 
-		err := ss.Remove(ctx, mockSongID, mockUser)
+		err := ss.Remove(ctx, mockSongID, time.Time{}, mockUser)
+		assert.ErrorAs(t, err, &mockErr)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestSongServiceFetchSheet(t *testing.T) {
+	mockSong := &domain.Song{
+		ID:         1,
+		Title:      "Foo",
+		Key:        "Am",
+		Bpm:        120,
+		ChordSheet: datatypes.JSON([]byte(`{"Verse":"[Am]Foo [C/G]bar [E7]baz"}`)),
+	}
+
+	mockUR := &mocks.MockUserRepository{}
+	mockBR := &mocks.MockBundleRepository{}
+	mockSRR := &mocks.MockSongRevisionRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+		options := &domain.SheetOptions{Notation: domain.NashvilleNotation}
+
+		sheet, err := ss.FetchSheet(context.TODO(), mockSong.ID, options)
+		assert.NoError(t, err)
+		assert.Equal(t, "Am", sheet.Key)
+		assert.Equal(t, int16(0), sheet.Transpose)
+		assert.JSONEq(t, `{"Verse":"[1m]Foo [b3/b7]bar [57]baz"}`, sheet.ChordSheet.String())
+		assert.Equal(t, []domain.ChordSheetSection{
+			{
+				Tag: "Verse",
+				Lines: []domain.ChordSheetLine{
+					{
+						Lyrics: "Foo bar baz",
+						Chords: []domain.ChordPosition{
+							{Offset: 0, Symbol: "1m"},
+							{Offset: 4, Symbol: "b3/b7"},
+							{Offset: 8, Symbol: "57"},
+						},
+					},
+				},
+			},
+		}, sheet.Sections)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail Song GetByID error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("", "")
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(nil, expErr)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		sheet, err := ss.FetchSheet(context.TODO(), mockSong.ID, nil)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, sheet)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestSongServiceFetchRevisions(t *testing.T) {
+	mockSong := &domain.Song{ID: 1, Key: "A"}
+	mockRevisions := []domain.SongRevision{
+		{ID: 1, SongID: 1, EditorID: 1, Title: "Foo"},
+		{ID: 2, SongID: 1, EditorID: 2, Title: "Bar"},
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockSRR.
+			On("GetBySong", context.TODO(), mockSong.ID).
+			Return(mockRevisions, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		revisions, err := ss.FetchRevisions(context.TODO(), mockSong.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, mockRevisions, revisions)
+		mockSR.AssertExpectations(t)
+		mockSRR.AssertExpectations(t)
+	})
+
+	t.Run("Fail Song GetByID error", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewRecordNotFoundErr("", "")
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(nil, mockErr)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		revisions, err := ss.FetchRevisions(context.TODO(), mockSong.ID)
 		assert.ErrorAs(t, err, &mockErr)
+		assert.Nil(t, revisions)
 		mockSR.AssertExpectations(t)
+		mockSRR.AssertExpectations(t)
+	})
+}
+
+func TestSongServiceFetchRevisionDiff(t *testing.T) {
+	fromRevision := &domain.SongRevision{
+		ID:         1,
+		SongID:     1,
+		Title:      "Foo",
+		Key:        "G",
+		Bpm:        120,
+		Duration:   240,
+		ChordSheet: datatypes.JSON([]byte(`{"Verse": "[G]Foo", "Chorus": "[C]Bar", "Bridge": "[D]Baz"}`)),
+	}
+	toRevision := &domain.SongRevision{
+		ID:         3,
+		SongID:     1,
+		Title:      "Foo",
+		Key:        "A",
+		Bpm:        120,
+		ChordSheet: datatypes.JSON([]byte(`{"Verse": "[A]Foo", "Chorus": "[C]Bar", "Outro": "[E]End"}`)),
+		CCLINumber: "22025",
+		Authors:    "John Newton",
+		Language:   "en",
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSRR.
+			On("GetByID", context.TODO(), fromRevision.ID).
+			Return(fromRevision, nil)
+		mockSRR.
+			On("GetByID", context.TODO(), toRevision.ID).
+			Return(toRevision, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		diff, err := ss.FetchRevisionDiff(context.TODO(), 1, fromRevision.ID, toRevision.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.SongRevisionDiff{
+			SongID: 1,
+			FromID: fromRevision.ID,
+			ToID:   toRevision.ID,
+			Fields: []domain.FieldDiff{
+				{Field: "key", From: "G", To: "A"},
+				{Field: "duration", From: "240", To: "0"},
+				{Field: "ccli_number", From: "", To: "22025"},
+				{Field: "authors", From: "", To: "John Newton"},
+				{Field: "language", From: "", To: "en"},
+			},
+			Sections: []domain.SectionDiff{
+				{Tag: "Verse", Status: domain.DiffChanged, From: "[G]Foo", To: "[A]Foo"},
+				{Tag: "Chorus", Status: domain.DiffUnchanged},
+				{Tag: "Outro", Status: domain.DiffAdded, To: "[E]End"},
+				{Tag: "Bridge", Status: domain.DiffRemoved, From: "[D]Baz"},
+			},
+		}, diff)
+		mockSRR.AssertExpectations(t)
+	})
+
+	t.Run("Fail revision of other song", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewRecordNotFoundErr("", "")
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSRR.
+			On("GetByID", context.TODO(), fromRevision.ID).
+			Return(fromRevision, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		diff, err := ss.FetchRevisionDiff(context.TODO(), 2, fromRevision.ID, toRevision.ID)
+		assert.ErrorAs(t, err, &mockErr)
+		assert.Nil(t, diff)
+		mockSRR.AssertExpectations(t)
+	})
+}
+
+func TestSongServiceRevert(t *testing.T) {
+	mockCreator := &domain.User{ID: 1, Permission: domain.MEMBER}
+	mockSong := &domain.Song{
+		ID:         1,
+		BundleID:   1,
+		CreatorID:  mockCreator.ID,
+		Title:      "Foo",
+		Subtitle:   "Broken",
+		Key:        "A",
+		Bpm:        120,
+		Duration:   300,
+		ChordSheet: datatypes.JSON([]byte(`{"Verse": "[A]Broken"}`)),
+		CCLINumber: "1234",
+		Copyright:  "Broken",
+		Language:   "en",
+	}
+	mockRevision := &domain.SongRevision{
+		ID:         2,
+		SongID:     1,
+		BundleID:   1,
+		Title:      "Foo",
+		Key:        "G",
+		Bpm:        90,
+		Duration:   240,
+		ChordSheet: datatypes.JSON([]byte(`{"Verse": "[G]Foo"}`)),
+		Authors:    "John Newton",
+		Publisher:  "Public Domain",
+		Language:   "nl",
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		expSong := &domain.Song{
+			ID:         mockSong.ID,
+			BundleID:   mockRevision.BundleID,
+			CreatorID:  mockSong.CreatorID,
+			Title:      mockRevision.Title,
+			Key:        mockRevision.Key,
+			Bpm:        mockRevision.Bpm,
+			Duration:   mockRevision.Duration,
+			ChordSheet: mockRevision.ChordSheet,
+			Authors:    mockRevision.Authors,
+			Publisher:  mockRevision.Publisher,
+			Language:   mockRevision.Language,
+		}
+		expRevision := &domain.SongRevision{
+			SongID:     mockSong.ID,
+			EditorID:   mockCreator.ID,
+			BundleID:   mockRevision.BundleID,
+			Title:      mockRevision.Title,
+			Key:        mockRevision.Key,
+			Bpm:        mockRevision.Bpm,
+			Duration:   mockRevision.Duration,
+			ChordSheet: mockRevision.ChordSheet,
+			Authors:    mockRevision.Authors,
+			Publisher:  mockRevision.Publisher,
+			Language:   mockRevision.Language,
+		}
+
+		mockSRR.
+			On("GetByID", context.TODO(), mockRevision.ID).
+			Return(mockRevision, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockBR.
+			On("GetByID", context.TODO(), mockSong.BundleID).
+			Return(&domain.Bundle{ID: 1}, nil)
+		mockUR.
+			On("GetByID", context.TODO(), mockSong.CreatorID).
+			Return(mockCreator, nil)
+		mockSRR.
+			On("GetBySong", context.TODO(), mockSong.ID).
+			Return([]domain.SongRevision{*mockRevision}, nil)
+		mockSR.
+			On("Update", context.TODO(), expSong).
+			Return(nil)
+		mockSRR.
+			On("Create", context.TODO(), expRevision).
+			Return(nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		song, err := ss.Revert(context.TODO(), mockSong.ID, mockRevision.ID, mockCreator)
+		assert.NoError(t, err)
+		assert.Equal(t, expSong, song)
+		mockSR.AssertExpectations(t)
+		mockUR.AssertExpectations(t)
+		mockBR.AssertExpectations(t)
+		mockSRR.AssertExpectations(t)
+	})
+
+	t.Run("Fail not creator nor editor", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewNotAuthorizedErr("")
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockUser := &domain.User{ID: 2, Permission: domain.MEMBER}
+
+		mockSRR.
+			On("GetByID", context.TODO(), mockRevision.ID).
+			Return(mockRevision, nil)
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		song, err := ss.Revert(context.TODO(), mockSong.ID, mockRevision.ID, mockUser)
+		assert.ErrorAs(t, err, &mockErr)
+		assert.Nil(t, song)
+		mockSR.AssertExpectations(t)
+		mockSRR.AssertExpectations(t)
+	})
+
+	t.Run("Fail revision GetByID error", func(t *testing.T) {
+		t.Parallel()
+
+		mockErr := domain.NewRecordNotFoundErr("", "")
+		mockUR := &mocks.MockUserRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSRR.
+			On("GetByID", context.TODO(), mockRevision.ID).
+			Return(nil, mockErr)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		song, err := ss.Revert(context.TODO(), mockSong.ID, mockRevision.ID, mockCreator)
+		assert.ErrorAs(t, err, &mockErr)
+		assert.Nil(t, song)
+		mockSRR.AssertExpectations(t)
+	})
+}
+
+func TestSongServiceSearch(t *testing.T) {
+	mockSongs := &[]domain.Song{
+		{
+			ID:         1,
+			Title:      "Amazing Grace",
+			ChordSheet: datatypes.JSON([]byte(`{"Verse":"[G]Amazing grace, how [C]sweet the sound"}`)),
+		},
+		{
+			ID:         2,
+			Title:      "Foo",
+			ChordSheet: datatypes.JSON([]byte(`{"Verse":"[D]Bar"}`)),
+		},
+	}
+
+	mockUR := &mocks.MockUserRepository{}
+	mockBR := &mocks.MockBundleRepository{}
+	mockSRR := &mocks.MockSongRevisionRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetAll", context.TODO()).
+			Return(mockSongs, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		results, err := ss.Search(context.TODO(), "sweet", 10)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, int64(1), results[0].Song.ID)
+		assert.Equal(t, "Amazing grace, how sweet the sound", results[0].Snippet)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Correct index kept up to date", func(t *testing.T) {
+		t.Parallel()
+
+		mockEditor := &domain.User{ID: 1, Permission: domain.EDITOR}
+		mockSR := &mocks.MockSongRepository{}
+		mockBR := &mocks.MockBundleRepository{}
+		mockSRR := &mocks.MockSongRevisionRepository{}
+		mockSong := &domain.Song{
+			BundleID:   1,
+			CreatorID:  mockEditor.ID,
+			Title:      "Sweet Hour",
+			Key:        "C",
+			ChordSheet: datatypes.JSON([]byte(`{"Verse":"[C]Prayer"}`)),
+		}
+
+		mockSR.
+			On("GetAll", context.TODO()).
+			Return(mockSongs, nil).
+			Once()
+		mockSR.
+			On("Delete", context.TODO(), int64(1), time.Time{}).
+			Return(nil)
+		mockSR.
+			On("Create", context.TODO(), mockSong).
+			Return(nil)
+		mockBR.
+			On("GetByID", context.TODO(), mockSong.BundleID).
+			Return(&domain.Bundle{ID: 1}, nil)
+		mockSRR.
+			On("Create", context.TODO(), mock.AnythingOfType("*domain.SongRevision")).
+			Return(nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		results, err := ss.Search(context.TODO(), "sweet", 10)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+
+		assert.NoError(t, ss.Remove(context.TODO(), 1, time.Time{}, mockEditor))
+		assert.NoError(t, ss.Store(context.TODO(), mockSong, mockEditor))
+
+		results, err = ss.Search(context.TODO(), "sweet", 10)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, "Sweet Hour", results[0].Song.Title)
+		mockSR.AssertExpectations(t)
+		mockBR.AssertExpectations(t)
+		mockSRR.AssertExpectations(t)
+	})
+
+	t.Run("Fail empty query", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSR := &mocks.MockSongRepository{}
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		results, err := ss.Search(context.TODO(), "  ", 10)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, results)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail GetAll error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetAll", context.TODO()).
+			Return(nil, expErr)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		results, err := ss.Search(context.TODO(), "grace", 10)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, results)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestSongServiceFetchDuplicates(t *testing.T) {
+	t.Parallel()
+
+	mockUR := &mocks.MockUserRepository{}
+	mockBR := &mocks.MockBundleRepository{}
+	mockSRR := &mocks.MockSongRevisionRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSongs := &[]domain.Song{
+			{ID: 1, Title: "Amazing Grace"},
+			{ID: 2, Title: "Oceans"},
+			{ID: 3, Title: "Amazing grace "},
+		}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetAll", context.TODO()).
+			Return(mockSongs, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		duplicates, err := ss.FetchDuplicates(context.TODO(), 0.8)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.DuplicateGroup{
+			{Songs: []domain.Song{(*mockSongs)[0], (*mockSongs)[2]}, Similarity: 1},
+		}, duplicates)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid threshold", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSR := &mocks.MockSongRepository{}
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		_, err := ss.FetchDuplicates(context.TODO(), 1.5)
+		assert.ErrorAs(t, err, &expErr)
+
+		_, err = ss.FetchDuplicates(context.TODO(), 0)
+		assert.ErrorAs(t, err, &expErr)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestSongServiceMerge(t *testing.T) {
+	t.Parallel()
+
+	mockUR := &mocks.MockUserRepository{}
+	mockBR := &mocks.MockBundleRepository{}
+	mockSRR := &mocks.MockSongRevisionRepository{}
+	mockSTR := &mocks.MockSongTranslationRepository{}
+	mockAdmin := &domain.User{ID: 1, Permission: domain.ADMIN}
+	mockSong := &domain.Song{ID: 1, Title: "Amazing Grace"}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(mockSong, nil)
+		mockSR.
+			On("GetByID", context.TODO(), int64(2)).
+			Return(&domain.Song{ID: 2}, nil)
+		mockSR.
+			On("GetByID", context.TODO(), int64(3)).
+			Return(&domain.Song{ID: 3}, nil)
+		mockSR.
+			On("Merge", context.TODO(), int64(1), []int64{2, 3}).
+			Return(nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		song, err := ss.Merge(context.TODO(), 1, []int64{2, 3, 2}, mockAdmin)
+		assert.NoError(t, err)
+		assert.Equal(t, mockSong, song)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail not admin", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockSR := &mocks.MockSongRepository{}
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		_, err := ss.Merge(context.TODO(), 1, []int64{2}, &domain.User{ID: 1, Permission: domain.EDITOR})
+		assert.ErrorAs(t, err, &expErr)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid duplicates", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSR := &mocks.MockSongRepository{}
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		_, err := ss.Merge(context.TODO(), 1, []int64{}, mockAdmin)
+		assert.ErrorAs(t, err, &expErr)
+
+		_, err = ss.Merge(context.TODO(), 1, []int64{2, 1}, mockAdmin)
+		assert.ErrorAs(t, err, &expErr)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail duplicate not found", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "2")
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(mockSong, nil)
+		mockSR.
+			On("GetByID", context.TODO(), int64(2)).
+			Return(nil, expErr)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		_, err := ss.Merge(context.TODO(), 1, []int64{2}, mockAdmin)
+		assert.ErrorAs(t, err, &expErr)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestSongServiceFetchSheetTranslated(t *testing.T) {
+	t.Parallel()
+
+	mockSong := &domain.Song{
+		ID:         1,
+		Title:      "Amazing Grace",
+		Key:        "G",
+		Language:   "en",
+		ChordSheet: datatypes.JSON([]byte(`[{"tag": "Chorus", "text": "[G]Free"}]`)),
+	}
+	mockTranslations := []domain.SongTranslation{
+		{ID: 1, SongID: 1, Language: "nl", Title: "Genade zo groot", Lyrics: datatypes.JSON([]byte(`{"Chorus": "Vrij"}`))},
+	}
+
+	mockUR := &mocks.MockUserRepository{}
+	mockBR := &mocks.MockBundleRepository{}
+	mockSRR := &mocks.MockSongRevisionRepository{}
+
+	t.Run("Correct translation", func(t *testing.T) {
+		t.Parallel()
+
+		mockSR := &mocks.MockSongRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockSTR.
+			On("GetBySong", context.TODO(), mockSong.ID).
+			Return(mockTranslations, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+		options := &domain.SheetOptions{Notation: domain.LetterNotation, Languages: []string{"NL", "en"}}
+
+		sheet, err := ss.FetchSheet(context.TODO(), mockSong.ID, options)
+		assert.NoError(t, err)
+		assert.Equal(t, "Genade zo groot", sheet.Title)
+		assert.Equal(t, []string{"nl", "en"}, sheet.Languages)
+		assert.JSONEq(t, `[{"tag": "Chorus", "text": "[G]Vrij"}]`, sheet.ChordSheet.String())
+		assert.Equal(t, []domain.ChordSheetLine{
+			{
+				Lyrics:       "Vrij",
+				Chords:       []domain.ChordPosition{{Offset: 0, Symbol: "G"}},
+				Translations: map[string]string{"en": "Free"},
+			},
+		}, sheet.Sections[0].Lines)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Correct original language", func(t *testing.T) {
+		t.Parallel()
+
+		mockSR := &mocks.MockSongRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+		options := &domain.SheetOptions{Notation: domain.LetterNotation, Languages: []string{"en"}}
+
+		sheet, err := ss.FetchSheet(context.TODO(), mockSong.ID, options)
+		assert.NoError(t, err)
+		assert.Equal(t, "Amazing Grace", sheet.Title)
+		assert.JSONEq(t, mockSong.ChordSheet.String(), sheet.ChordSheet.String())
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail missing translation", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("", "")
+		mockSR := &mocks.MockSongRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockSTR.
+			On("GetBySong", context.TODO(), mockSong.ID).
+			Return(mockTranslations, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+		options := &domain.SheetOptions{Notation: domain.LetterNotation, Languages: []string{"de"}}
+
+		_, err := ss.FetchSheet(context.TODO(), mockSong.ID, options)
+		assert.ErrorAs(t, err, &expErr)
+		mockSTR.AssertExpectations(t)
+	})
+}
+
+func TestSongServiceStoreTranslation(t *testing.T) {
+	t.Parallel()
+
+	mockSong := &domain.Song{
+		ID:         1,
+		CreatorID:  1,
+		Language:   "en",
+		ChordSheet: datatypes.JSON([]byte(`[{"tag": "Chorus", "text": "[G]Free\nGone"}]`)),
+	}
+
+	mockUR := &mocks.MockUserRepository{}
+	mockBR := &mocks.MockBundleRepository{}
+	mockSRR := &mocks.MockSongRevisionRepository{}
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSR := &mocks.MockSongRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+		mockTranslation := &domain.SongTranslation{
+			SongID:   1,
+			Language: "NL",
+			Title:    " Genade ",
+			Lyrics:   datatypes.JSON([]byte(`{"Chorus": "Vrij\nWeg"}`)),
+		}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockSTR.
+			On("Save", context.TODO(), mockTranslation).
+			Return(nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		err := ss.StoreTranslation(context.TODO(), mockTranslation, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, "nl", mockTranslation.Language)
+		assert.Equal(t, "Genade", mockTranslation.Title)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail not creator", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockSR := &mocks.MockSongRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		err := ss.StoreTranslation(context.TODO(), &domain.SongTranslation{SongID: 1, Language: "nl"}, &domain.User{ID: 2, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid translation", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSR := &mocks.MockSongRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		err := ss.StoreTranslation(context.TODO(), &domain.SongTranslation{
+			SongID:   1,
+			Language: "en",
+			Lyrics:   datatypes.JSON([]byte(`{"Chorus": "Free\nGone"}`)),
+		}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+
+		err = ss.StoreTranslation(context.TODO(), &domain.SongTranslation{
+			SongID:   1,
+			Language: "nl",
+			Lyrics:   datatypes.JSON([]byte(`{"Chorus": "Vrij"}`)),
+		}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		mockSTR.AssertExpectations(t)
+	})
+}
+
+func TestSongServiceRemoveTranslation(t *testing.T) {
+	t.Parallel()
+
+	mockSong := &domain.Song{ID: 1, CreatorID: 1}
+	mockUR := &mocks.MockUserRepository{}
+	mockBR := &mocks.MockBundleRepository{}
+	mockSRR := &mocks.MockSongRevisionRepository{}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSR := &mocks.MockSongRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockSTR.
+			On("Delete", context.TODO(), mockSong.ID, "nl").
+			Return(nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		err := ss.RemoveTranslation(context.TODO(), mockSong.ID, "NL", &domain.User{ID: 2, Permission: domain.EDITOR})
+		assert.NoError(t, err)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail not creator", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockSR := &mocks.MockSongRepository{}
+		mockSTR := &mocks.MockSongTranslationRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		ss := service.NewSongService(mockUR, mockSR, mockBR, mockSRR, mockSTR)
+
+		err := ss.RemoveTranslation(context.TODO(), mockSong.ID, "nl", &domain.User{ID: 2, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		mockSTR.AssertExpectations(t)
 	})
 }
@@ -0,0 +1,354 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStatsServiceFetchSongStats(t *testing.T) {
+	t.Parallel()
+
+	first := time.Date(2023, time.January, 1, 10, 0, 0, 0, time.UTC)
+	last := time.Date(2023, time.May, 7, 10, 0, 0, 0, time.UTC)
+	mockSong := &domain.Song{ID: 1, Title: "Foo"}
+	isSongPlays := mock.MatchedBy(func(options *domain.PlayFilterOptions) bool {
+		return len(options.SongIDs) == 1 && options.SongIDs[0] == mockSong.ID && !options.To.IsZero()
+	})
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockSTR.
+			On("GetPlayCounts", context.TODO(), isSongPlays).
+			Return([]domain.SongStats{
+				{SongID: 1, Title: "Foo", PlayCount: 4, FirstPlayed: &first, LastPlayed: &last},
+			}, nil)
+		mockSTR.
+			On("GetTransposeCounts", context.TODO(), isSongPlays).
+			Return([]domain.TransposeCount{
+				{SongID: 1, Transpose: -2, Count: 2},
+				{SongID: 1, Transpose: 0, Count: 1},
+				{SongID: 1, Transpose: 2, Count: 2},
+			}, nil)
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		stats, err := sts.FetchSongStats(context.TODO(), mockSong.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.SongStats{
+			SongID:          1,
+			Title:           "Foo",
+			PlayCount:       4,
+			FirstPlayed:     &first,
+			LastPlayed:      &last,
+			CommonTranspose: -2,
+		}, stats)
+		mockSR.AssertExpectations(t)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Correct never played", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockSTR.
+			On("GetPlayCounts", context.TODO(), isSongPlays).
+			Return([]domain.SongStats{}, nil)
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		stats, err := sts.FetchSongStats(context.TODO(), mockSong.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.SongStats{SongID: 1, Title: "Foo"}, stats)
+		mockSR.AssertExpectations(t)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail Song GetByID error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(nil, expErr)
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		stats, err := sts.FetchSongStats(context.TODO(), mockSong.ID)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, stats)
+		mockSR.AssertExpectations(t)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail GetTransposeCounts error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockSTR.
+			On("GetPlayCounts", context.TODO(), isSongPlays).
+			Return([]domain.SongStats{{SongID: 1, Title: "Foo", PlayCount: 1}}, nil)
+		mockSTR.
+			On("GetTransposeCounts", context.TODO(), isSongPlays).
+			Return(nil, expErr)
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		stats, err := sts.FetchSongStats(context.TODO(), mockSong.ID)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, stats)
+		mockSR.AssertExpectations(t)
+		mockSTR.AssertExpectations(t)
+	})
+}
+
+func TestStatsServiceFetchTopSongs(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC)
+	mockStats := []domain.SongStats{
+		{SongID: 2, Title: "Bar", PlayCount: 3},
+		{SongID: 1, Title: "Foo", PlayCount: 1},
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSTR.
+			On("GetPlayCounts", context.TODO(), &domain.PlayFilterOptions{From: from, To: to, Limit: 2}).
+			Return(append([]domain.SongStats{}, mockStats...), nil)
+		mockSTR.
+			On("GetTransposeCounts", context.TODO(), &domain.PlayFilterOptions{SongIDs: []int64{2, 1}, From: from, To: to}).
+			Return([]domain.TransposeCount{
+				{SongID: 2, Transpose: 3, Count: 1},
+				{SongID: 2, Transpose: -1, Count: 2},
+				{SongID: 1, Transpose: 0, Count: 1},
+			}, nil)
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		stats, err := sts.FetchTopSongs(context.TODO(), from, to, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.SongStats{
+			{SongID: 2, Title: "Bar", PlayCount: 3, CommonTranspose: -1},
+			{SongID: 1, Title: "Foo", PlayCount: 1},
+		}, stats)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Correct future period ends now", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSTR.
+			On("GetPlayCounts", context.TODO(), mock.MatchedBy(func(options *domain.PlayFilterOptions) bool {
+				return !options.To.IsZero() && !options.To.After(time.Now())
+			})).
+			Return([]domain.SongStats{}, nil)
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		stats, err := sts.FetchTopSongs(context.TODO(), from, time.Time{}, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, stats)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid period", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		stats, err := sts.FetchTopSongs(context.TODO(), to, from, 10)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, stats)
+
+		stats, err = sts.FetchTopSongs(context.TODO(), from, to, 0)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, stats)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail GetPlayCounts error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSTR.
+			On("GetPlayCounts", context.TODO(), &domain.PlayFilterOptions{From: from, To: to, Limit: 10}).
+			Return(nil, expErr)
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		stats, err := sts.FetchTopSongs(context.TODO(), from, to, 10)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, stats)
+		mockSTR.AssertExpectations(t)
+	})
+}
+
+func TestStatsServiceFetchCCLIReport(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
+	mockOptions := &domain.PlayFilterOptions{From: from, To: to}
+	mockPlays := []domain.SongStats{
+		{SongID: 2, Title: "Bar", PlayCount: 3},
+		{SongID: 1, Title: "Foo", PlayCount: 1},
+	}
+	mockSongs := []domain.Song{
+		{ID: 1, Title: "Foo", CCLINumber: "22025", Authors: "John Newton", Copyright: "Public Domain"},
+		{ID: 2, Title: "Bar"},
+	}
+	mockEditor := &domain.User{ID: 1, Permission: domain.EDITOR}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSTR.
+			On("GetPlayCounts", context.TODO(), mockOptions).
+			Return(mockPlays, nil)
+		mockSR.
+			On("Get", context.TODO(), &domain.SongFilterOptions{IDs: []int64{2, 1}}).
+			Return(mockSongs, nil)
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		report, err := sts.FetchCCLIReport(context.TODO(), from, to, mockEditor)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.CCLIReport{
+			From: from,
+			To:   to,
+			Rows: []domain.CCLIReportRow{
+				{SongID: 2, Title: "Bar", TimesUsed: 3, MissingCCLINumber: true},
+				{
+					SongID:     1,
+					CCLINumber: "22025",
+					Title:      "Foo",
+					Authors:    "John Newton",
+					Copyright:  "Public Domain",
+					TimesUsed:  1,
+				},
+			},
+		}, report)
+		mockSTR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Correct no plays", func(t *testing.T) {
+		t.Parallel()
+
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSTR.
+			On("GetPlayCounts", context.TODO(), mockOptions).
+			Return([]domain.SongStats{}, nil)
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		report, err := sts.FetchCCLIReport(context.TODO(), from, to, mockEditor)
+		assert.NoError(t, err)
+		assert.Empty(t, report.Rows)
+		mockSTR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail not editor", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+		mockMember := &domain.User{ID: 2, Permission: domain.MEMBER}
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		report, err := sts.FetchCCLIReport(context.TODO(), from, to, mockMember)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, report)
+		mockSTR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid period", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		report, err := sts.FetchCCLIReport(context.TODO(), to, from, mockEditor)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, report)
+		mockSTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail Song Get error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		mockSTR := &mocks.MockStatsRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSTR.
+			On("GetPlayCounts", context.TODO(), mockOptions).
+			Return(mockPlays, nil)
+		mockSR.
+			On("Get", context.TODO(), &domain.SongFilterOptions{IDs: []int64{2, 1}}).
+			Return(nil, expErr)
+
+		sts := service.NewStatsService(mockSTR, mockSR)
+
+		report, err := sts.FetchCCLIReport(context.TODO(), from, to, mockEditor)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, report)
+		mockSTR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,303 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/domain/mocks"
+	"github.com/96Asch/mkvstage-server/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagServiceStore(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+
+	t.Run("Correct default category", func(t *testing.T) {
+		t.Parallel()
+
+		mockTag := &domain.Tag{Name: " grace "}
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockTR.
+			On("Create", context.TODO(), &domain.Tag{Name: "grace", Category: domain.TagTheme}).
+			Return(nil)
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		err := ts.Store(context.TODO(), mockTag, mockUser)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.TagTheme, mockTag.Category)
+		mockTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail invalid tag", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewBadRequestErr("")
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		err := ts.Store(context.TODO(), &domain.Tag{Name: "  "}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+
+		err = ts.Store(context.TODO(), &domain.Tag{Name: "grace", Category: "mood"}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+
+		err = ts.Store(context.TODO(), &domain.Tag{Name: strings.Repeat("a", 65)}, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		mockTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail guest", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		err := ts.Store(context.TODO(), &domain.Tag{Name: "grace"}, &domain.User{Permission: domain.GUEST})
+		assert.ErrorAs(t, err, &expErr)
+		mockTR.AssertExpectations(t)
+	})
+}
+
+func TestTagServiceUpdate(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+	mockTag := &domain.Tag{ID: 1, Name: "Advent", Category: domain.TagSeason}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockTR.
+			On("GetByID", context.TODO(), mockTag.ID).
+			Return(&domain.Tag{ID: 1, Name: "advent", Category: domain.TagSeason}, nil)
+		mockTR.
+			On("Update", context.TODO(), mockTag).
+			Return(nil)
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		err := ts.Update(context.TODO(), mockTag, mockUser)
+		assert.NoError(t, err)
+		mockTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail GetByID error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "1")
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockTR.
+			On("GetByID", context.TODO(), mockTag.ID).
+			Return(nil, expErr)
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		err := ts.Update(context.TODO(), mockTag, mockUser)
+		assert.ErrorAs(t, err, &expErr)
+		mockTR.AssertExpectations(t)
+	})
+}
+
+func TestTagServiceRemove(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockTR.
+			On("GetByID", context.TODO(), int64(1)).
+			Return(&domain.Tag{ID: 1}, nil)
+		mockTR.
+			On("Delete", context.TODO(), int64(1)).
+			Return(nil)
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		err := ts.Remove(context.TODO(), 1, &domain.User{ID: 1, Permission: domain.EDITOR})
+		assert.NoError(t, err)
+		mockTR.AssertExpectations(t)
+	})
+
+	t.Run("Fail not editor", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		err := ts.Remove(context.TODO(), 1, &domain.User{ID: 1, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		mockTR.AssertExpectations(t)
+	})
+}
+
+func TestTagServiceFetchBySong(t *testing.T) {
+	t.Parallel()
+
+	mockTags := []domain.Tag{{ID: 1, Name: "grace", Category: domain.TagTheme}}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), int64(3)).
+			Return(&domain.Song{ID: 3}, nil)
+		mockTR.
+			On("GetBySong", context.TODO(), int64(3)).
+			Return(mockTags, nil)
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		tags, err := ts.FetchBySong(context.TODO(), 3)
+		assert.NoError(t, err)
+		assert.Equal(t, mockTags, tags)
+		mockTR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail Song GetByID error", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("id", "3")
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), int64(3)).
+			Return(nil, expErr)
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		tags, err := ts.FetchBySong(context.TODO(), 3)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, tags)
+		mockTR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+}
+
+func TestTagServiceSetSongTags(t *testing.T) {
+	t.Parallel()
+
+	mockCreator := &domain.User{ID: 1, Permission: domain.MEMBER}
+	mockSong := &domain.Song{ID: 3, CreatorID: mockCreator.ID}
+	mockTags := []domain.Tag{
+		{ID: 2, Name: "Advent", Category: domain.TagSeason},
+		{ID: 1, Name: "grace", Category: domain.TagTheme},
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockTR.
+			On("GetByIDs", context.TODO(), []int64{1, 2}).
+			Return(mockTags, nil)
+		mockTR.
+			On("SetSongTags", context.TODO(), mockSong.ID, []int64{1, 2}).
+			Return(nil)
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		tags, err := ts.SetSongTags(context.TODO(), mockSong.ID, []int64{1, 2, 1}, mockCreator)
+		assert.NoError(t, err)
+		assert.Equal(t, mockTags, tags)
+		mockTR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Correct clear tags", func(t *testing.T) {
+		t.Parallel()
+
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockTR.
+			On("SetSongTags", context.TODO(), mockSong.ID, []int64{}).
+			Return(nil)
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		tags, err := ts.SetSongTags(context.TODO(), mockSong.ID, []int64{}, &domain.User{ID: 2, Permission: domain.EDITOR})
+		assert.NoError(t, err)
+		assert.Empty(t, tags)
+		mockTR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail not creator nor editor", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewNotAuthorizedErr("")
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		tags, err := ts.SetSongTags(context.TODO(), mockSong.ID, []int64{1}, &domain.User{ID: 2, Permission: domain.MEMBER})
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, tags)
+		mockTR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+
+	t.Run("Fail unknown tag", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewRecordNotFoundErr("", "")
+		mockTR := &mocks.MockTagRepository{}
+		mockSR := &mocks.MockSongRepository{}
+
+		mockSR.
+			On("GetByID", context.TODO(), mockSong.ID).
+			Return(mockSong, nil)
+		mockTR.
+			On("GetByIDs", context.TODO(), []int64{1, 9}).
+			Return(mockTags[1:], nil)
+
+		ts := service.NewTagService(mockTR, mockSR)
+
+		tags, err := ts.SetSongTags(context.TODO(), mockSong.ID, []int64{1, 9}, mockCreator)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, tags)
+		mockTR.AssertExpectations(t)
+		mockSR.AssertExpectations(t)
+	})
+}
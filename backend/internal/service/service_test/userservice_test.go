@@ -32,7 +32,7 @@ func TestFetchByIDUserCorrect(t *testing.T) {
 		On("GetByID", context.TODO(), mockUser.ID).
 		Return(mockUser, nil)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	US := service.NewUserService(mockUR, mockRR, mockURR, &mocks.MockTransactor{})
 
 	user, err := US.FetchByID(context.TODO(), mockUser.ID)
 	assert.NoError(t, err)
@@ -90,7 +90,7 @@ func TestFetchAllUserCorrect(t *testing.T) {
 		On("GetAll", context.TODO()).
 		Return(mockUsers, nil)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	US := service.NewUserService(mockUR, mockRR, mockURR, &mocks.MockTransactor{})
 
 	users, err := US.FetchAll(context.TODO())
 	assert.NoError(t, err)
@@ -111,7 +111,7 @@ func TestFetchAllUserInternalErr(t *testing.T) {
 		On("GetAll", context.TODO()).
 		Return(nil, expectedErr)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	US := service.NewUserService(mockUR, mockRR, mockURR, &mocks.MockTransactor{})
 
 	users, err := US.FetchAll(context.TODO())
 	assert.ErrorIs(t, expectedErr, err)
@@ -158,6 +158,7 @@ func TestStoreUserCorrect(t *testing.T) {
 
 	mockUR := &mocks.MockUserRepository{}
 	mockURR := &mocks.MockUserRoleRepository{}
+	mockTX := &mocks.MockTransactor{}
 	mockRR := &mocks.MockRoleRepository{}
 
 	mockUR.
@@ -175,7 +176,11 @@ func TestStoreUserCorrect(t *testing.T) {
 		On("GetAll", context.TODO()).
 		Return(mockRoles, nil)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	mockTX.
+		On("WithinTransaction", context.TODO()).
+		Return(nil)
+
+	US := service.NewUserService(mockUR, mockRR, mockURR, mockTX)
 
 	err := US.Store(context.TODO(), mockUser)
 	assert.NoError(t, err)
@@ -193,6 +198,7 @@ func TestStoreUserCorrect(t *testing.T) {
 	mockUR.AssertExpectations(t)
 	mockRR.AssertExpectations(t)
 	mockURR.AssertExpectations(t)
+	mockTX.AssertExpectations(t)
 }
 
 func TestStoreUserCreateErr(t *testing.T) {
@@ -210,18 +216,24 @@ func TestStoreUserCreateErr(t *testing.T) {
 	mockErr := domain.NewInternalErr()
 	mockUR := &mocks.MockUserRepository{}
 	mockURR := &mocks.MockUserRoleRepository{}
+	mockTX := &mocks.MockTransactor{}
 	mockRR := &mocks.MockRoleRepository{}
 
 	mockUR.
 		On("Create", context.TODO(), mockUser).
 		Return(mockErr)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	mockTX.
+		On("WithinTransaction", context.TODO()).
+		Return(nil)
+
+	US := service.NewUserService(mockUR, mockRR, mockURR, mockTX)
 
 	err := US.Store(context.TODO(), mockUser)
 	assert.ErrorAs(t, err, &mockErr)
 	mockUR.AssertExpectations(t)
 	mockURR.AssertExpectations(t)
+	mockTX.AssertExpectations(t)
 	mockRR.AssertExpectations(t)
 }
 
@@ -240,6 +252,7 @@ func TestStoreUserGetAllRoleErr(t *testing.T) {
 	mockErr := domain.NewInternalErr()
 	mockUR := &mocks.MockUserRepository{}
 	mockURR := &mocks.MockUserRoleRepository{}
+	mockTX := &mocks.MockTransactor{}
 	mockRR := &mocks.MockRoleRepository{}
 
 	mockUR.
@@ -254,13 +267,18 @@ func TestStoreUserGetAllRoleErr(t *testing.T) {
 		On("GetAll", context.TODO()).
 		Return(nil, mockErr)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	mockTX.
+		On("WithinTransaction", context.TODO()).
+		Return(nil)
+
+	US := service.NewUserService(mockUR, mockRR, mockURR, mockTX)
 
 	err := US.Store(context.TODO(), mockUser)
 	assert.ErrorAs(t, err, &mockErr)
 	mockUR.AssertExpectations(t)
 	mockRR.AssertExpectations(t)
 	mockURR.AssertExpectations(t)
+	mockTX.AssertExpectations(t)
 }
 
 func TestStoreUserCreateBatchURErr(t *testing.T) {
@@ -302,6 +320,7 @@ func TestStoreUserCreateBatchURErr(t *testing.T) {
 	mockErr := domain.NewInternalErr()
 	mockUR := &mocks.MockUserRepository{}
 	mockURR := &mocks.MockUserRoleRepository{}
+	mockTX := &mocks.MockTransactor{}
 	mockRR := &mocks.MockRoleRepository{}
 
 	mockUR.
@@ -319,13 +338,18 @@ func TestStoreUserCreateBatchURErr(t *testing.T) {
 		On("GetAll", context.TODO()).
 		Return(mockRoles, nil)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	mockTX.
+		On("WithinTransaction", context.TODO()).
+		Return(nil)
+
+	US := service.NewUserService(mockUR, mockRR, mockURR, mockTX)
 
 	err := US.Store(context.TODO(), mockUser)
 	assert.ErrorAs(t, err, &mockErr)
 	mockUR.AssertExpectations(t)
 	mockRR.AssertExpectations(t)
 	mockURR.AssertExpectations(t)
+	mockTX.AssertExpectations(t)
 	mockRR.AssertExpectations(t)
 }
 
@@ -350,7 +374,7 @@ func TestUpdateUserCorrect(t *testing.T) {
 		On("Update", context.TODO(), mockUser).
 		Return(nil)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	US := service.NewUserService(mockUR, mockRR, mockURR, &mocks.MockTransactor{})
 
 	err := US.Update(context.TODO(), mockUser)
 	assert.NoError(t, err)
@@ -392,7 +416,7 @@ func TestUpdateUserZeroID(t *testing.T) {
 		On("Update", context.TODO(), mockUser).
 		Return(nil)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	US := service.NewUserService(mockUR, mockRR, mockURR, &mocks.MockTransactor{})
 
 	err := US.Update(context.TODO(), mockUser)
 	expectedErr := domain.NewBadRequestErr("")
@@ -424,7 +448,7 @@ func TestDeleteUserCorrectOnlyUser(t *testing.T) {
 		On("DeleteByUID", context.TODO(), mockUser.ID).
 		Return(nil)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	US := service.NewUserService(mockUR, mockRR, mockURR, &mocks.MockTransactor{})
 
 	deletedID, err := US.Remove(context.TODO(), mockUser, 0)
 	assert.NoError(t, err)
@@ -457,7 +481,7 @@ func TestDeleteUserCorrectOtherUser(t *testing.T) {
 		On("DeleteByUID", context.TODO(), otherID).
 		Return(nil)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	US := service.NewUserService(mockUR, mockRR, mockURR, &mocks.MockTransactor{})
 
 	deletedID, err := US.Remove(context.TODO(), mockUser, otherID)
 	assert.NoError(t, err)
@@ -480,7 +504,7 @@ func TestDeleteUserNotAuthorized(t *testing.T) {
 	mockURR := &mocks.MockUserRoleRepository{}
 	mockRR := &mocks.MockRoleRepository{}
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	US := service.NewUserService(mockUR, mockRR, mockURR, &mocks.MockTransactor{})
 
 	_, err := US.Remove(context.TODO(), mockUser, otherID)
 	expectedErr := domain.NewNotAuthorizedErr("")
@@ -508,7 +532,7 @@ func TestDeleteUserNoRecord(t *testing.T) {
 		On("GetByID", context.TODO(), otherID).
 		Return(nil, expectedErr)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	US := service.NewUserService(mockUR, mockRR, mockURR, &mocks.MockTransactor{})
 
 	_, err := US.Remove(context.TODO(), mockUser, otherID)
 	assert.ErrorAs(t, err, &expectedErr)
@@ -537,7 +561,7 @@ func TestDeleteUserInternalErr(t *testing.T) {
 		On("Delete", context.TODO(), otherID).
 		Return(expectedErr)
 
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	US := service.NewUserService(mockUR, mockRR, mockURR, &mocks.MockTransactor{})
 
 	_, err := US.Remove(context.TODO(), mockUser, otherID)
 	assert.ErrorAs(t, err, &expectedErr)
@@ -565,10 +589,42 @@ func TestDeleteUserDeleteUserRoleErr(t *testing.T) {
 	mockURR.On("DeleteByUID", context.TODO(), mockUser.ID).Return(mockErr)
 
 	mockRR := &mocks.MockRoleRepository{}
-	US := service.NewUserService(mockUR, mockRR, mockURR)
+	US := service.NewUserService(mockUR, mockRR, mockURR, &mocks.MockTransactor{})
 	_, err := US.Remove(ctx, mockUser, 0)
 
 	assert.ErrorAs(t, err, &mockErr)
 	mockUR.AssertExpectations(t)
 	mockURR.AssertExpectations(t)
 }
+
+func TestStoreUserTransactionErr(t *testing.T) {
+	t.Parallel()
+
+	mockUser := &domain.User{
+		FirstName: "Foo",
+		LastName:  "Bar",
+		Email:     "Foo@Bar.com",
+
+		Permission:   domain.MEMBER,
+		ProfileColor: "FFFFFF",
+	}
+
+	mockErr := domain.NewInternalErr()
+	mockUR := &mocks.MockUserRepository{}
+	mockURR := &mocks.MockUserRoleRepository{}
+	mockTX := &mocks.MockTransactor{}
+	mockRR := &mocks.MockRoleRepository{}
+
+	mockTX.
+		On("WithinTransaction", context.TODO()).
+		Return(mockErr)
+
+	US := service.NewUserService(mockUR, mockRR, mockURR, mockTX)
+
+	err := US.Store(context.TODO(), mockUser)
+	assert.ErrorAs(t, err, &mockErr)
+	mockUR.AssertExpectations(t)
+	mockRR.AssertExpectations(t)
+	mockURR.AssertExpectations(t)
+	mockTX.AssertExpectations(t)
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/util"
@@ -12,17 +13,58 @@ type setlistEntryService struct {
 	sler domain.SetlistEntryRepository
 	slr  domain.SetlistRepository
 	sr   domain.SongRepository
+	str  domain.SongTranslationRepository
 }
 
 //revive:disable:unexported-return
-func NewSetlistEntryService(sler domain.SetlistEntryRepository, slr domain.SetlistRepository, sr domain.SongRepository) *setlistEntryService {
+func NewSetlistEntryService(
+	sler domain.SetlistEntryRepository,
+	slr domain.SetlistRepository,
+	sr domain.SongRepository,
+	str domain.SongTranslationRepository,
+) *setlistEntryService {
 	return &setlistEntryService{
 		sler: sler,
 		slr:  slr,
 		sr:   sr,
+		str:  str,
 	}
 }
 
+// validateItem normalizes the entry and checks that a song item refers to an
+// existing song and has a transpose, arrangement and languages that fit it.
+func (ses setlistEntryService) validateItem(ctx context.Context, entry *domain.SetlistEntry) error {
+	if err := util.NormalizeSetlistItem(entry); err != nil {
+		return domain.NewBadRequestErr(err.Error())
+	}
+
+	if !entry.IsSong() {
+		return nil
+	}
+
+	if !util.IsValidTranpose(entry.Transpose) {
+		return domain.NewBadRequestErr(fmt.Sprintf("Transpose must be between %d and %d", util.TransposeMin, util.TransposeMax))
+	}
+
+	song, err := ses.sr.GetByID(ctx, entry.SongID)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	if err := util.ValidateArrangement(entry.Arrangement, song.ChordSheet); err != nil {
+		return domain.NewBadRequestErr(err.Error())
+	}
+
+	languages, err := util.ParseLanguages(entry.Languages)
+	if err != nil {
+		return err
+	}
+
+	entry.Languages = strings.Join(languages, ",")
+
+	return nil
+}
+
 func (ses setlistEntryService) StoreBatch(ctx context.Context, setlistEntries *[]domain.SetlistEntry, principal *domain.User) error {
 	if principal == nil {
 		return domain.NewInternalErr()
@@ -42,13 +84,9 @@ func (ses setlistEntryService) StoreBatch(ctx context.Context, setlistEntries *[
 
 	setlistID := (*setlistEntries)[0].SetlistID
 
-	for _, entry := range *setlistEntries {
-		if !util.IsValidTranpose(entry.Transpose) {
-			return domain.NewBadRequestErr(fmt.Sprintf("Transpose must be between %d and %d", util.TransposeMin, util.TransposeMax))
-		}
-
-		if _, err := ses.sr.GetByID(ctx, entry.SongID); err != nil {
-			return domain.FromError(err)
+	for idx, entry := range *setlistEntries {
+		if err := ses.validateItem(ctx, &(*setlistEntries)[idx]); err != nil {
+			return err
 		}
 
 		if setlistID != entry.SetlistID {
@@ -56,11 +94,16 @@ func (ses setlistEntryService) StoreBatch(ctx context.Context, setlistEntries *[
 		}
 	}
 
-	if _, err := ses.slr.GetByID(ctx, setlistID); err != nil {
+	setlist, err := ses.slr.GetByID(ctx, setlistID)
+	if err != nil {
 		return domain.FromError(err)
 	}
 
-	err := ses.sler.CreateBatch(ctx, setlistEntries)
+	if err := checkLocked(setlist, principal); err != nil {
+		return err
+	}
+
+	err = ses.sler.CreateBatch(ctx, setlistEntries)
 
 	if err != nil {
 		return domain.FromError(err)
@@ -86,15 +129,7 @@ func (ses setlistEntryService) FetchAll(ctx context.Context) (*[]domain.SetlistE
 		return nil, domain.FromError(err)
 	}
 
-	var minRank int64
-
-	for _, entry := range *setlistEntries {
-		if minRank > entry.Rank {
-			return nil, domain.NewInternalErr()
-		}
-
-		minRank = entry.Rank
-	}
+	util.SortEntries(*setlistEntries)
 
 	return setlistEntries, nil
 }
@@ -114,17 +149,183 @@ func (ses setlistEntryService) FetchBySetlist(ctx context.Context, setlists *[]d
 		return nil, domain.FromError(err)
 	}
 
-	var minRank int64
+	util.SortEntries(*setlistEntries)
+
+	return setlistEntries, nil
+}
+
+func (ses setlistEntryService) fetchEntrySong(ctx context.Context, setlistID, entryID int64) (*domain.SetlistEntry, *domain.Song, error) {
+	entry, err := ses.sler.GetByID(ctx, entryID)
+	if err != nil {
+		return nil, nil, domain.FromError(err)
+	}
+
+	if entry.SetlistID != setlistID {
+		return nil, nil, domain.NewRecordNotFoundErr("setlist_id", fmt.Sprint(setlistID))
+	}
+
+	if !entry.IsSong() {
+		return nil, nil, domain.NewBadRequestErr(fmt.Sprintf("Entry %d is a %s item, not a song", entry.ID, entry.Type))
+	}
+
+	song, err := ses.sr.GetByID(ctx, entry.SongID)
+	if err != nil {
+		return nil, nil, domain.FromError(err)
+	}
+
+	return entry, song, nil
+}
+
+// entrySheet renders the song of the entry, in the languages picked
+// for the entry unless the options ask for other languages.
+func (ses setlistEntryService) entrySheet(
+	ctx context.Context,
+	entry *domain.SetlistEntry,
+	song *domain.Song,
+	options *domain.SheetOptions,
+) (*domain.Sheet, error) {
+	if options == nil {
+		options = &domain.SheetOptions{Notation: domain.LetterNotation}
+	}
+
+	if len(options.Languages) == 0 && entry.Languages != "" {
+		languages, err := util.ParseLanguages(entry.Languages)
+		if err != nil {
+			return nil, err
+		}
+
+		entryOptions := *options
+		entryOptions.Languages = languages
+		options = &entryOptions
+	}
+
+	translations, err := fetchSheetTranslations(ctx, ses.str, song, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSheet(song, entry.Transpose, options, translations)
+}
+
+func (ses setlistEntryService) FetchSheet(ctx context.Context, setlistID, entryID int64, options *domain.SheetOptions) (*domain.Sheet, error) {
+	entry, song, err := ses.fetchEntrySong(ctx, setlistID, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ses.entrySheet(ctx, entry, song, options)
+}
+
+func (ses setlistEntryService) FetchArrangement(
+	ctx context.Context,
+	setlistID, entryID int64,
+	options *domain.SheetOptions,
+) (*domain.ExpandedArrangement, error) {
+	entry, song, err := ses.fetchEntrySong(ctx, setlistID, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet, err := ses.entrySheet(ctx, entry, song, options)
+	if err != nil {
+		return nil, err
+	}
+
+	sections, err := util.ExpandArrangement(entry.Arrangement, sheet.ChordSheet)
+	if err != nil {
+		return nil, domain.NewBadRequestErr(err.Error())
+	}
+
+	texts := make([]string, len(sections))
+	for idx, section := range sections {
+		texts[idx] = section.Text
+	}
+
+	return &domain.ExpandedArrangement{
+		EntryID:  entry.ID,
+		SongID:   song.ID,
+		Key:      sheet.Key,
+		Sections: sections,
+		Text:     strings.Join(texts, "\n\n"),
+	}, nil
+}
+
+// FetchAnalysis looks up the songs of the ranked entries of the setlist and
+// analyzes the transitions between them.
+func (ses setlistEntryService) FetchAnalysis(ctx context.Context, setlistID int64) (*domain.SetlistAnalysis, error) {
+	setlist, err := ses.slr.GetByID(ctx, setlistID)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	entries, err := ses.sler.GetBySetlist(ctx, &[]domain.Setlist{*setlist})
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	songs := make(map[int64]domain.Song)
+
+	for _, entry := range *entries {
+		if _, exists := songs[entry.SongID]; exists || !entry.IsSong() {
+			continue
+		}
+
+		song, err := ses.sr.GetByID(ctx, entry.SongID)
+		if err != nil {
+			return nil, domain.FromError(err)
+		}
+
+		songs[entry.SongID] = *song
+	}
+
+	return util.AnalyzeSetlist(setlist.ID, *entries, songs), nil
+}
+
+// FetchTimings plans the entries of every setlist among the given entries,
+// the timings are returned by setlist id.
+func (ses setlistEntryService) FetchTimings(
+	ctx context.Context,
+	setlistEntries *[]domain.SetlistEntry,
+) (map[int64]domain.SetlistTiming, error) {
+	if setlistEntries == nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	timings := make(map[int64]domain.SetlistTiming)
+
+	if len(*setlistEntries) == 0 {
+		return timings, nil
+	}
+
+	bySetlist := make(map[int64][]domain.SetlistEntry)
+	songIDs := make([]int64, 0, len(*setlistEntries))
 
 	for _, entry := range *setlistEntries {
-		if minRank > entry.Rank {
-			return nil, domain.NewInternalErr()
+		bySetlist[entry.SetlistID] = append(bySetlist[entry.SetlistID], entry)
+
+		if entry.IsSong() {
+			songIDs = append(songIDs, entry.SongID)
+		}
+	}
+
+	songs := make(map[int64]domain.Song)
+
+	if len(songIDs) > 0 {
+		retrievedSongs, err := ses.sr.Get(ctx, &domain.SongFilterOptions{IDs: songIDs})
+		if err != nil {
+			return nil, domain.FromError(err)
 		}
 
-		minRank = entry.Rank
+		for _, song := range retrievedSongs {
+			songs[song.ID] = song
+		}
 	}
 
-	return setlistEntries, nil
+	for setlistID, entries := range bySetlist {
+		timings[setlistID] = util.PlanSetlist(setlistID, entries, songs)
+	}
+
+	return timings, nil
 }
 
 func (ses setlistEntryService) UpdateBatch(ctx context.Context, setlistEntries *[]domain.SetlistEntry, principal *domain.User) error {
@@ -140,19 +341,19 @@ func (ses setlistEntryService) UpdateBatch(ctx context.Context, setlistEntries *
 		return domain.NewInternalErr()
 	}
 
-	setlistID := (*setlistEntries)[0].SetlistID
+	if len(*setlistEntries) <= 0 {
+		return nil
+	}
 
-	for _, entry := range *setlistEntries {
-		if !util.IsValidTranpose(entry.Transpose) {
-			return domain.NewBadRequestErr(fmt.Sprintf("Transpose must be between %d and %d", util.TransposeMin, util.TransposeMax))
-		}
+	setlistID := (*setlistEntries)[0].SetlistID
 
-		if _, err := ses.sr.GetByID(ctx, entry.SongID); err != nil {
-			return domain.FromError(err)
+	for idx, entry := range *setlistEntries {
+		if err := ses.validateItem(ctx, &(*setlistEntries)[idx]); err != nil {
+			return err
 		}
 
-		if _, err := ses.sler.GetByID(ctx, entry.ID); err != nil {
-			return domain.FromError(err)
+		if err := ses.checkEntryOf(ctx, setlistID, entry.ID); err != nil {
+			return err
 		}
 
 		if setlistID != entry.SetlistID {
@@ -160,11 +361,16 @@ func (ses setlistEntryService) UpdateBatch(ctx context.Context, setlistEntries *
 		}
 	}
 
-	if _, err := ses.slr.GetByID(ctx, setlistID); err != nil {
+	setlist, err := ses.slr.GetByID(ctx, setlistID)
+	if err != nil {
 		return domain.FromError(err)
 	}
 
-	err := ses.sler.UpdateBatch(ctx, setlistEntries)
+	if err := checkLocked(setlist, principal); err != nil {
+		return err
+	}
+
+	err = ses.sler.UpdateBatch(ctx, setlistEntries)
 
 	if err != nil {
 		return domain.FromError(err)
@@ -173,6 +379,82 @@ func (ses setlistEntryService) UpdateBatch(ctx context.Context, setlistEntries *
 	return nil
 }
 
+// Reorder puts the entries of the setlist in the order of the given entry ids.
+// Only the entries whose rank changes are saved, in a single transaction.
+func (ses setlistEntryService) Reorder(
+	ctx context.Context,
+	setlistID int64,
+	order []int64,
+	principal *domain.User,
+) (*[]domain.SetlistEntry, error) {
+	if principal == nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	if !principal.HasClearance(domain.EDITOR) {
+		return nil, domain.NewNotAuthorizedErr("Invalid authorization")
+	}
+
+	setlist, err := ses.slr.GetByID(ctx, setlistID)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if err := checkLocked(setlist, principal); err != nil {
+		return nil, err
+	}
+
+	setlistEntries, err := ses.sler.GetBySetlist(ctx, &[]domain.Setlist{*setlist})
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	util.SortEntries(*setlistEntries)
+
+	reordered, changed, err := util.RerankEntries(*setlistEntries, order)
+	if err != nil {
+		return nil, domain.NewBadRequestErr(err.Error())
+	}
+
+	if len(changed) > 0 {
+		if err := ses.sler.UpdateRanks(ctx, &changed); err != nil {
+			return nil, domain.FromError(err)
+		}
+	}
+
+	return &reordered, nil
+}
+
+// checkEntryOf checks that the stored entry belongs to the setlist, so entries of
+// other setlists cannot be changed through it.
+func (ses setlistEntryService) checkEntryOf(ctx context.Context, setlistID, entryID int64) error {
+	entry, err := ses.sler.GetByID(ctx, entryID)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	if entry.SetlistID != setlistID {
+		return domain.NewBadRequestErr(fmt.Sprintf("entry %d is not part of setlist %d", entryID, setlistID))
+	}
+
+	return nil
+}
+
+// checkRemovable checks against the stored setlist that the principal is an admin or its creator
+// and that the setlist is not locked.
+func (ses setlistEntryService) checkRemovable(ctx context.Context, setlistID int64, principal *domain.User) error {
+	setlist, err := ses.slr.GetByID(ctx, setlistID)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.ADMIN) && setlist.CreatorID != principal.ID {
+		return domain.NewNotAuthorizedErr("Invalid authorization")
+	}
+
+	return checkLocked(setlist, principal)
+}
+
 func (ses setlistEntryService) RemoveBatch(ctx context.Context, setlist *domain.Setlist, ids []int64, principal *domain.User) error {
 	if principal == nil {
 		return domain.NewInternalErr()
@@ -182,22 +464,20 @@ func (ses setlistEntryService) RemoveBatch(ctx context.Context, setlist *domain.
 		return domain.NewInternalErr()
 	}
 
-	if !principal.HasClearance(domain.ADMIN) {
-		if setlist.CreatorID != principal.ID {
-			return domain.NewNotAuthorizedErr("Invalid authorization")
-		}
-	}
-
 	if len(ids) <= 0 {
 		return nil
 	}
 
 	for _, id := range ids {
-		if _, err := ses.sler.GetByID(ctx, id); err != nil {
-			return domain.FromError(err)
+		if err := ses.checkEntryOf(ctx, setlist.ID, id); err != nil {
+			return err
 		}
 	}
 
+	if err := ses.checkRemovable(ctx, setlist.ID, principal); err != nil {
+		return err
+	}
+
 	err := ses.sler.DeleteBatch(ctx, ids)
 
 	if err != nil {
@@ -216,10 +496,8 @@ func (ses setlistEntryService) RemoveBySetlist(ctx context.Context, setlist *dom
 		return domain.NewInternalErr()
 	}
 
-	if !principal.HasClearance(domain.ADMIN) {
-		if setlist.CreatorID != principal.ID {
-			return domain.NewNotAuthorizedErr("Invalid authorization")
-		}
+	if err := ses.checkRemovable(ctx, setlist.ID, principal); err != nil {
+		return err
 	}
 
 	setlists := &[]domain.Setlist{*setlist}
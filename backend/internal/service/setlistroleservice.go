@@ -74,11 +74,18 @@ func (slrs setlistRoleService) Store(ctx context.Context, setlistRoles *[]domain
 		setlistIDs[idx] = setlistRole.SetlistID
 	}
 
-	if _, err := slrs.slr.GetByIDs(ctx, setlistIDs); err != nil {
+	setlists, err := slrs.slr.GetByIDs(ctx, setlistIDs)
+	if err != nil {
 		return domain.FromError(err)
 	}
 
-	err := slrs.slrr.Create(ctx, setlistRoles)
+	for idx := range *setlists {
+		if err := checkLocked(&(*setlists)[idx], principal); err != nil {
+			return err
+		}
+	}
+
+	err = slrs.slrr.Create(ctx, setlistRoles)
 
 	if err != nil {
 		return domain.FromError(err)
@@ -120,6 +127,23 @@ func (slrs setlistRoleService) Remove(ctx context.Context, setlistRoleIDs []int6
 				return domain.NewNotAuthorizedErr("Cannot change the Setlist Role of someone else")
 			}
 		}
+
+		setlistIDs := make([]int64, len(*retrievedSetlistRoles))
+
+		for idx, setlistRole := range *retrievedSetlistRoles {
+			setlistIDs[idx] = setlistRole.SetlistID
+		}
+
+		setlists, err := slrs.slr.GetByIDs(ctx, setlistIDs)
+		if err != nil {
+			return domain.FromError(err)
+		}
+
+		for idx := range *setlists {
+			if err := checkLocked(&(*setlists)[idx], principal); err != nil {
+				return err
+			}
+		}
 	}
 
 	err := slrs.slrr.Delete(ctx, setlistRoleIDs)
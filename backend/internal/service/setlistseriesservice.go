@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+)
+
+// MaxSeriesHorizon is how far ahead occurrences can be previewed or materialized,
+// MaxSeriesOccurrences is the most occurrences handled at once.
+const (
+	MaxSeriesHorizon     = 366 * 24 * time.Hour
+	MaxSeriesOccurrences = 100
+)
+
+type setlistSeriesService struct {
+	ssr  domain.SetlistSeriesRepository
+	str  domain.SetlistTemplateRepository
+	slr  domain.SetlistRepository
+	sler domain.SetlistEntryRepository
+	slrr domain.SetlistRoleRepository
+	tx   domain.Transactor
+}
+
+//revive:disable:unexported-return
+func NewSetlistSeriesService(
+	ssr domain.SetlistSeriesRepository,
+	str domain.SetlistTemplateRepository,
+	slr domain.SetlistRepository,
+	sler domain.SetlistEntryRepository,
+	slrr domain.SetlistRoleRepository,
+	tx domain.Transactor,
+) *setlistSeriesService {
+	return &setlistSeriesService{
+		ssr:  ssr,
+		str:  str,
+		slr:  slr,
+		sler: sler,
+		slrr: slrr,
+		tx:   tx,
+	}
+}
+
+// schedule parses the rule and timezone of the series.
+func schedule(series *domain.SetlistSeries) (*domain.Recurrence, *time.Location, error) {
+	recurrence, err := util.ParseRecurrence(series.Rule)
+	if err != nil {
+		return nil, nil, domain.NewBadRequestErr(err.Error())
+	}
+
+	loc, err := time.LoadLocation(series.Timezone)
+	if err != nil {
+		return nil, nil, domain.NewBadRequestErr(fmt.Sprintf("%s is not a valid timezone", series.Timezone))
+	}
+
+	return recurrence, loc, nil
+}
+
+func (sss setlistSeriesService) validate(ctx context.Context, series *domain.SetlistSeries) error {
+	series.Name = strings.TrimSpace(series.Name)
+	series.Rule = strings.ToUpper(strings.TrimSpace(series.Rule))
+	series.Timezone = strings.TrimSpace(series.Timezone)
+
+	if series.Name == "" {
+		return domain.NewBadRequestErr("series name cannot be empty")
+	}
+
+	if series.Start.IsZero() {
+		return domain.NewBadRequestErr("series start cannot be empty")
+	}
+
+	if _, _, err := schedule(series); err != nil {
+		return err
+	}
+
+	if series.TemplateID != 0 {
+		if _, err := sss.str.GetByID(ctx, series.TemplateID); err != nil {
+			return domain.FromError(err)
+		}
+	}
+
+	return nil
+}
+
+func (sss setlistSeriesService) FetchByID(ctx context.Context, sid int64) (*domain.SetlistSeries, error) {
+	series, err := sss.ssr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return series, nil
+}
+
+func (sss setlistSeriesService) FetchAll(ctx context.Context) (*[]domain.SetlistSeries, error) {
+	series, err := sss.ssr.GetAll(ctx)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return series, nil
+}
+
+func (sss setlistSeriesService) Store(ctx context.Context, series *domain.SetlistSeries, principal *domain.User) error {
+	if !principal.HasClearance(domain.EDITOR) {
+		return domain.NewNotAuthorizedErr("not authorized to create setlist series")
+	}
+
+	series.CreatorID = principal.ID
+
+	if err := sss.validate(ctx, series); err != nil {
+		return err
+	}
+
+	if err := sss.ssr.Create(ctx, series); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
+
+func (sss setlistSeriesService) Update(ctx context.Context, series *domain.SetlistSeries, principal *domain.User) error {
+	current, err := sss.ssr.GetByID(ctx, series.ID)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.ADMIN) && current.CreatorID != principal.ID {
+		return domain.NewNotAuthorizedErr("user is neither an admin nor creator of the setlist series")
+	}
+
+	series.CreatorID = current.CreatorID
+
+	if err := sss.validate(ctx, series); err != nil {
+		return err
+	}
+
+	if err := sss.ssr.Update(ctx, series); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
+
+func (sss setlistSeriesService) Remove(ctx context.Context, sid int64, principal *domain.User) error {
+	current, err := sss.ssr.GetByID(ctx, sid)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.ADMIN) && current.CreatorID != principal.ID {
+		return domain.NewNotAuthorizedErr("user is neither an admin nor creator of the setlist series")
+	}
+
+	if err := sss.ssr.Delete(ctx, sid); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
+
+// occurrences returns the occurrences of the series between from and to, the
+// occurrences that were materialized or skipped are taken from the repository.
+func (sss setlistSeriesService) occurrences(
+	ctx context.Context,
+	series *domain.SetlistSeries,
+	from time.Time,
+	to time.Time,
+) ([]domain.SeriesOccurrence, error) {
+	recurrence, loc, err := schedule(series)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := util.Occurrences(recurrence, series.Start, loc, from, to, MaxSeriesOccurrences)
+	if len(dates) == 0 {
+		return []domain.SeriesOccurrence{}, nil
+	}
+
+	recorded, err := sss.ssr.GetOccurrences(ctx, series.ID, dates[0].UTC(), dates[len(dates)-1].UTC())
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	byDate := make(map[int64]domain.SeriesOccurrence, len(*recorded))
+	for _, occurrence := range *recorded {
+		byDate[occurrence.Date.Unix()] = occurrence
+	}
+
+	occurrences := make([]domain.SeriesOccurrence, len(dates))
+
+	for idx, date := range dates {
+		occurrence, exists := byDate[date.Unix()]
+		if !exists {
+			occurrence = domain.SeriesOccurrence{SetlistSeriesID: series.ID}
+		}
+
+		occurrence.Date = date
+		occurrences[idx] = occurrence
+	}
+
+	return occurrences, nil
+}
+
+func checkHorizon(from time.Time, to time.Time) error {
+	if to.Before(from) {
+		return domain.NewBadRequestErr(fmt.Sprintf("%s must be later than %s", to.String(), from.String()))
+	}
+
+	if to.Sub(from) > MaxSeriesHorizon {
+		return domain.NewBadRequestErr(fmt.Sprintf("series can only be planned %s ahead", MaxSeriesHorizon.String()))
+	}
+
+	return nil
+}
+
+// Preview returns the occurrences of the series between from and to, from defaults to now
+// and to defaults to 90 days after from.
+func (sss setlistSeriesService) Preview(
+	ctx context.Context,
+	sid int64,
+	from time.Time,
+	to time.Time,
+) (*[]domain.SeriesOccurrence, error) {
+	if from.IsZero() {
+		from = time.Now()
+	}
+
+	if to.IsZero() {
+		to = from.AddDate(0, 0, 90)
+	}
+
+	if err := checkHorizon(from, to); err != nil {
+		return nil, err
+	}
+
+	series, err := sss.ssr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	occurrences, err := sss.occurrences(ctx, series, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &occurrences, nil
+}
+
+// Materialize creates a setlist for every upcoming occurrence of the series until the given
+// time that has no setlist yet and is not skipped. The deadline of the setlist is the time of
+// the occurrence and it is created from the template of the series if it has one.
+func (sss setlistSeriesService) Materialize(
+	ctx context.Context,
+	sid int64,
+	until time.Time,
+	principal *domain.User,
+) (*[]domain.Setlist, error) {
+	if !principal.HasClearance(domain.EDITOR) {
+		return nil, domain.NewNotAuthorizedErr("not authorized to materialize setlist series")
+	}
+
+	now := time.Now()
+	if err := checkHorizon(now, until); err != nil {
+		return nil, err
+	}
+
+	series, err := sss.ssr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	template := &domain.SetlistTemplate{}
+
+	if series.TemplateID != 0 {
+		template, err = sss.str.GetByID(ctx, series.TemplateID)
+		if err != nil {
+			return nil, domain.FromError(err)
+		}
+	}
+
+	occurrences, err := sss.occurrences(ctx, series, now, until)
+	if err != nil {
+		return nil, err
+	}
+
+	setlists := make([]domain.Setlist, 0, len(occurrences))
+
+	for _, occurrence := range occurrences {
+		if occurrence.ID != 0 {
+			continue
+		}
+
+		setlist := &domain.Setlist{
+			Name:     fmt.Sprintf("%s %s", series.Name, occurrence.Date.Format("2006-01-02")),
+			Deadline: occurrence.Date,
+		}
+		entries, roles := templateCopy(template)
+
+		var copied *domain.CopiedSetlist
+
+		err := sss.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+
+			copied, err = storeSetlistCopy(ctx, sss.tx, sss.slr, sss.sler, sss.slrr, setlist, entries, roles, principal)
+			if err != nil {
+				return err
+			}
+
+			occurrence.Date = occurrence.Date.UTC()
+			occurrence.SetlistID = copied.Setlist.ID
+
+			if err := sss.ssr.CreateOccurrence(ctx, &occurrence); err != nil {
+				return domain.FromError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		setlists = append(setlists, copied.Setlist)
+	}
+
+	return &setlists, nil
+}
+
+// occurrence returns the occurrence of the series at date, it fails when
+// the rule of the series has no occurrence at that time.
+func (sss setlistSeriesService) occurrence(
+	ctx context.Context,
+	sid int64,
+	date time.Time,
+	principal *domain.User,
+) (*domain.SeriesOccurrence, error) {
+	if !principal.HasClearance(domain.EDITOR) {
+		return nil, domain.NewNotAuthorizedErr("not authorized to change setlist series")
+	}
+
+	series, err := sss.ssr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	occurrences, err := sss.occurrences(ctx, series, date, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(occurrences) == 0 {
+		return nil, domain.NewBadRequestErr(fmt.Sprintf("series %d has no occurrence at %s", sid, date.String()))
+	}
+
+	return &occurrences[0], nil
+}
+
+func (sss setlistSeriesService) Skip(ctx context.Context, sid int64, date time.Time, principal *domain.User) error {
+	occurrence, err := sss.occurrence(ctx, sid, date, principal)
+	if err != nil {
+		return err
+	}
+
+	if occurrence.SetlistID != 0 {
+		return domain.NewBadRequestErr(fmt.Sprintf("occurrence already has setlist %d", occurrence.SetlistID))
+	}
+
+	if occurrence.Skipped {
+		return nil
+	}
+
+	occurrence.Date = occurrence.Date.UTC()
+	occurrence.Skipped = true
+
+	if err := sss.ssr.CreateOccurrence(ctx, occurrence); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
+
+func (sss setlistSeriesService) Unskip(ctx context.Context, sid int64, date time.Time, principal *domain.User) error {
+	occurrence, err := sss.occurrence(ctx, sid, date, principal)
+	if err != nil {
+		return err
+	}
+
+	if !occurrence.Skipped {
+		return domain.NewBadRequestErr(fmt.Sprintf("occurrence at %s is not skipped", date.String()))
+	}
+
+	if err := sss.ssr.DeleteOccurrence(ctx, sid, occurrence.Date.UTC()); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
@@ -34,6 +34,8 @@ func (ss setlistService) Store(ctx context.Context, setlist *domain.Setlist, pri
 		return domain.FromError(err)
 	}
 
+	setlist.Status = domain.SetlistDraft
+
 	err := ss.slr.Create(ctx, setlist)
 	if err != nil {
 		return domain.FromError(err)
@@ -42,25 +44,57 @@ func (ss setlistService) Store(ctx context.Context, setlist *domain.Setlist, pri
 	return nil
 }
 
-func (ss setlistService) FetchByID(ctx context.Context, sid int64) (*domain.Setlist, error) {
+// visible sets the current status of the setlists and
+// leaves out those that the principal cannot see.
+func visible(setlists *[]domain.Setlist, principal *domain.User) *[]domain.Setlist {
+	now := time.Now()
+	visibleSetlists := make([]domain.Setlist, 0, len(*setlists))
+
+	for _, setlist := range *setlists {
+		setlist.Status = setlist.StatusAt(now)
+
+		if setlist.IsVisibleTo(principal) {
+			visibleSetlists = append(visibleSetlists, setlist)
+		}
+	}
+
+	return &visibleSetlists
+}
+
+// checkLocked fails when the setlist is locked or archived and the principal is not an admin.
+func checkLocked(setlist *domain.Setlist, principal *domain.User) error {
+	if setlist.IsLocked(time.Now()) && !principal.HasClearance(domain.ADMIN) {
+		return domain.NewNotAuthorizedErr(fmt.Sprintf("setlist %d is %s", setlist.ID, setlist.StatusAt(time.Now())))
+	}
+
+	return nil
+}
+
+func (ss setlistService) FetchByID(ctx context.Context, sid int64, principal *domain.User) (*domain.Setlist, error) {
 	setlist, err := ss.slr.GetByID(ctx, sid)
 	if err != nil {
 		return nil, domain.FromError(err)
 	}
 
+	if !setlist.IsVisibleTo(principal) {
+		return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(sid))
+	}
+
+	setlist.Status = setlist.StatusAt(time.Now())
+
 	return setlist, nil
 }
 
-func (ss setlistService) FetchAll(ctx context.Context) (*[]domain.Setlist, error) {
+func (ss setlistService) FetchAll(ctx context.Context, principal *domain.User) (*[]domain.Setlist, error) {
 	setlists, err := ss.slr.GetAll(ctx)
 	if err != nil {
 		return nil, domain.FromError(err)
 	}
 
-	return setlists, nil
+	return visible(setlists, principal), nil
 }
 
-func (ss setlistService) Fetch(ctx context.Context, from time.Time, to time.Time) (*[]domain.Setlist, error) {
+func (ss setlistService) Fetch(ctx context.Context, from time.Time, to time.Time, principal *domain.User) (*[]domain.Setlist, error) {
 	if from.After(to) {
 		return nil, domain.NewBadRequestErr("From field cannot be after To field.")
 	}
@@ -70,10 +104,15 @@ func (ss setlistService) Fetch(ctx context.Context, from time.Time, to time.Time
 		return nil, domain.FromError(err)
 	}
 
-	return setlists, nil
+	return visible(setlists, principal), nil
 }
 
-func (ss setlistService) FetchByTimeframe(ctx context.Context, from time.Time, to time.Time) (*[]domain.Setlist, error) {
+func (ss setlistService) FetchByTimeframe(
+	ctx context.Context,
+	from time.Time,
+	to time.Time,
+	principal *domain.User,
+) (*[]domain.Setlist, error) {
 	if from.After(to) {
 		return nil, domain.NewBadRequestErr("From field cannot be after To field.")
 	}
@@ -83,7 +122,7 @@ func (ss setlistService) FetchByTimeframe(ctx context.Context, from time.Time, t
 		return nil, domain.FromError(err)
 	}
 
-	return setlists, nil
+	return visible(setlists, principal), nil
 }
 
 func (ss setlistService) Update(ctx context.Context, setlist *domain.Setlist, principal *domain.User) (*domain.Setlist, error) {
@@ -98,7 +137,11 @@ func (ss setlistService) Update(ctx context.Context, setlist *domain.Setlist, pr
 		}
 	}
 
-	if setlist.Deadline.Before(time.Now()) {
+	if err := checkLocked(currentSetlist, principal); err != nil {
+		return nil, err
+	}
+
+	if !setlist.Deadline.Equal(currentSetlist.Deadline) && setlist.Deadline.Before(time.Now()) {
 		return nil, domain.NewBadRequestErr(fmt.Sprintf("%s must be later than %s", setlist.Deadline.String(), time.Now().String()))
 	}
 
@@ -106,15 +149,82 @@ func (ss setlistService) Update(ctx context.Context, setlist *domain.Setlist, pr
 		return nil, domain.FromError(err)
 	}
 
+	setlist.Status = ""
+
 	updatedSetlist, err := ss.slr.Update(ctx, setlist)
 	if err != nil {
 		return nil, domain.FromError(err)
 	}
 
+	updatedSetlist.Status = updatedSetlist.StatusAt(time.Now())
+
+	return updatedSetlist, nil
+}
+
+// transition moves the setlist to the given status when its current status is one of from,
+// only admins and the creator of the setlist can change its status. Setlists cannot be
+// published after their deadline.
+func (ss setlistService) transition(
+	ctx context.Context,
+	sid int64,
+	principal *domain.User,
+	status domain.SetlistStatus,
+	from ...domain.SetlistStatus,
+) (*domain.Setlist, error) {
+	currentSetlist, err := ss.slr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.ADMIN) && currentSetlist.CreatorID != principal.ID {
+		return nil, domain.NewNotAuthorizedErr("User is neither an admin nor creator of the setlist")
+	}
+
+	current := currentSetlist.StatusAt(time.Now())
+	allowed := false
+
+	for _, fromStatus := range from {
+		allowed = allowed || current == fromStatus
+	}
+
+	if !allowed {
+		return nil, domain.NewBadRequestErr(fmt.Sprintf("a %s setlist cannot become %s", current, status))
+	}
+
+	if status == domain.SetlistPublished && !currentSetlist.Deadline.After(time.Now()) {
+		return nil, domain.NewBadRequestErr("setlists cannot be published after their deadline")
+	}
+
+	updatedSetlist, err := ss.slr.Update(ctx, &domain.Setlist{ID: sid, Status: status})
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	updatedSetlist.Status = updatedSetlist.StatusAt(time.Now())
+
 	return updatedSetlist, nil
 }
 
-func (ss setlistService) Remove(ctx context.Context, sid int64, principal *domain.User) error {
+// Publish makes a draft visible to everyone.
+func (ss setlistService) Publish(ctx context.Context, sid int64, principal *domain.User) (*domain.Setlist, error) {
+	return ss.transition(ctx, sid, principal, domain.SetlistPublished, domain.SetlistDraft)
+}
+
+// Unpublish turns a published setlist back into a draft, locked setlists can only be unpublished by admins.
+func (ss setlistService) Unpublish(ctx context.Context, sid int64, principal *domain.User) (*domain.Setlist, error) {
+	if principal.HasClearance(domain.ADMIN) {
+		return ss.transition(ctx, sid, principal, domain.SetlistDraft, domain.SetlistPublished, domain.SetlistLocked)
+	}
+
+	return ss.transition(ctx, sid, principal, domain.SetlistDraft, domain.SetlistPublished)
+}
+
+// Archive archives a setlist after its deadline has passed.
+func (ss setlistService) Archive(ctx context.Context, sid int64, principal *domain.User) (*domain.Setlist, error) {
+	return ss.transition(ctx, sid, principal, domain.SetlistArchived, domain.SetlistLocked)
+}
+
+func (ss setlistService) Remove(ctx context.Context, sid int64, version time.Time, principal *domain.User) error {
 	if !principal.HasClearance(domain.ADMIN) {
 		currentSetlist, err := ss.slr.GetByID(ctx, sid)
 		if err != nil {
@@ -124,9 +234,13 @@ func (ss setlistService) Remove(ctx context.Context, sid int64, principal *domai
 		if currentSetlist.CreatorID != principal.ID {
 			return domain.NewNotAuthorizedErr("User is neither an editor nor creator of the setlist")
 		}
+
+		if err := checkLocked(currentSetlist, principal); err != nil {
+			return err
+		}
 	}
 
-	err := ss.slr.Delete(ctx, sid)
+	err := ss.slr.Delete(ctx, sid, version)
 	if err != nil {
 		return domain.FromError(err)
 	}
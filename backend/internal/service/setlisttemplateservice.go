@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+)
+
+type setlistTemplateService struct {
+	str  domain.SetlistTemplateRepository
+	slr  domain.SetlistRepository
+	sler domain.SetlistEntryRepository
+	slrr domain.SetlistRoleRepository
+	sr   domain.SongRepository
+	rr   domain.RoleRepository
+	urr  domain.UserRoleRepository
+	tx   domain.Transactor
+}
+
+//revive:disable:unexported-return
+func NewSetlistTemplateService(
+	str domain.SetlistTemplateRepository,
+	slr domain.SetlistRepository,
+	sler domain.SetlistEntryRepository,
+	slrr domain.SetlistRoleRepository,
+	sr domain.SongRepository,
+	rr domain.RoleRepository,
+	urr domain.UserRoleRepository,
+	tx domain.Transactor,
+) *setlistTemplateService {
+	return &setlistTemplateService{
+		str:  str,
+		slr:  slr,
+		sler: sler,
+		slrr: slrr,
+		sr:   sr,
+		rr:   rr,
+		urr:  urr,
+		tx:   tx,
+	}
+}
+
+func (sts setlistTemplateService) validate(ctx context.Context, template *domain.SetlistTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+
+	if template.Name == "" {
+		return domain.NewBadRequestErr("template name cannot be empty")
+	}
+
+	for idx := range template.Slots {
+		slot := &template.Slots[idx]
+
+		if err := util.NormalizeTemplateSlot(slot); err != nil {
+			return domain.NewBadRequestErr(err.Error())
+		}
+
+		if slot.Type != domain.SongItem || slot.IsPlaceholder() {
+			continue
+		}
+
+		if !util.IsValidTranpose(slot.Transpose) {
+			return domain.NewBadRequestErr(fmt.Sprintf("Transpose must be between %d and %d", util.TransposeMin, util.TransposeMax))
+		}
+
+		if _, err := sts.sr.GetByID(ctx, slot.SongID); err != nil {
+			return domain.FromError(err)
+		}
+	}
+
+	for _, role := range template.Roles {
+		if _, err := sts.rr.GetByID(ctx, role.RoleID); err != nil {
+			return domain.FromError(err)
+		}
+
+		if role.UserRoleID == 0 {
+			continue
+		}
+
+		userrole, err := sts.urr.GetByID(ctx, role.UserRoleID)
+		if err != nil {
+			return domain.FromError(err)
+		}
+
+		if userrole.RoleID != role.RoleID {
+			return domain.NewBadRequestErr(fmt.Sprintf("user role %d does not fill role %d", role.UserRoleID, role.RoleID))
+		}
+	}
+
+	return nil
+}
+
+func (sts setlistTemplateService) FetchByID(ctx context.Context, tid int64) (*domain.SetlistTemplate, error) {
+	template, err := sts.str.GetByID(ctx, tid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return template, nil
+}
+
+func (sts setlistTemplateService) FetchAll(ctx context.Context) (*[]domain.SetlistTemplate, error) {
+	templates, err := sts.str.GetAll(ctx)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return templates, nil
+}
+
+func (sts setlistTemplateService) Store(ctx context.Context, template *domain.SetlistTemplate, principal *domain.User) error {
+	if !principal.HasClearance(domain.EDITOR) {
+		return domain.NewNotAuthorizedErr("not authorized to create setlist templates")
+	}
+
+	template.CreatorID = principal.ID
+
+	if err := sts.validate(ctx, template); err != nil {
+		return err
+	}
+
+	if err := sts.str.Create(ctx, template); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
+
+func (sts setlistTemplateService) Update(ctx context.Context, template *domain.SetlistTemplate, principal *domain.User) error {
+	current, err := sts.str.GetByID(ctx, template.ID)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.ADMIN) && current.CreatorID != principal.ID {
+		return domain.NewNotAuthorizedErr("user is neither an admin nor creator of the setlist template")
+	}
+
+	template.CreatorID = current.CreatorID
+
+	if err := sts.validate(ctx, template); err != nil {
+		return err
+	}
+
+	if err := sts.str.Update(ctx, template); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
+
+func (sts setlistTemplateService) Remove(ctx context.Context, tid int64, principal *domain.User) error {
+	current, err := sts.str.GetByID(ctx, tid)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.ADMIN) && current.CreatorID != principal.ID {
+		return domain.NewNotAuthorizedErr("user is neither an admin nor creator of the setlist template")
+	}
+
+	if err := sts.str.Delete(ctx, tid); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
+
+// storeSetlistCopy stores the setlist for the principal and copies the entries and
+// role assignments into it, the entries and roles are stored as new records in a single unit of work.
+func storeSetlistCopy(
+	ctx context.Context,
+	tx domain.Transactor,
+	slr domain.SetlistRepository,
+	sler domain.SetlistEntryRepository,
+	slrr domain.SetlistRoleRepository,
+	setlist *domain.Setlist,
+	entries []domain.SetlistEntry,
+	roles []domain.SetlistRole,
+	principal *domain.User,
+) (*domain.CopiedSetlist, error) {
+	setlist.ID = 0
+	setlist.CreatorID = principal.ID
+	setlist.Status = domain.SetlistDraft
+
+	err := tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := slr.Create(ctx, setlist); err != nil {
+			return domain.FromError(err)
+		}
+
+		for idx := range entries {
+			entries[idx].ID = 0
+			entries[idx].SetlistID = setlist.ID
+		}
+
+		for idx := range roles {
+			roles[idx].ID = 0
+			roles[idx].SetlistID = setlist.ID
+		}
+
+		if len(entries) > 0 {
+			if err := sler.CreateBatch(ctx, &entries); err != nil {
+				return domain.FromError(err)
+			}
+		}
+
+		if len(roles) > 0 {
+			if err := slrr.Create(ctx, &roles); err != nil {
+				return domain.FromError(err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.CopiedSetlist{
+		Setlist: *setlist,
+		Entries: entries,
+		Roles:   roles,
+	}, nil
+}
+
+// templateCopy returns an entry for every slot of the template
+// and the role assignments of the roles that have a default.
+func templateCopy(template *domain.SetlistTemplate) ([]domain.SetlistEntry, []domain.SetlistRole) {
+	entries := make([]domain.SetlistEntry, len(template.Slots))
+	for idx := range template.Slots {
+		entries[idx] = util.SlotEntry(&template.Slots[idx], 0)
+	}
+
+	roles := make([]domain.SetlistRole, 0, len(template.Roles))
+
+	for _, role := range template.Roles {
+		if role.UserRoleID != 0 {
+			roles = append(roles, domain.SetlistRole{UserRoleID: role.UserRoleID})
+		}
+	}
+
+	return entries, roles
+}
+
+func checkCopy(setlist *domain.Setlist, principal *domain.User) error {
+	if principal == nil || !principal.HasClearance(domain.MEMBER) {
+		return domain.NewNotAuthorizedErr("Not authorized to create setlists")
+	}
+
+	if setlist.Deadline.Before(time.Now()) {
+		return domain.NewBadRequestErr(fmt.Sprintf("%s must be later than %s", setlist.Deadline.String(), time.Now().String()))
+	}
+
+	return nil
+}
+
+// Instantiate creates the setlist with an entry for every slot of the template and
+// assigns the default user roles, the setlist is named after the template unless named.
+func (sts setlistTemplateService) Instantiate(
+	ctx context.Context,
+	tid int64,
+	setlist *domain.Setlist,
+	principal *domain.User,
+) (*domain.CopiedSetlist, error) {
+	if err := checkCopy(setlist, principal); err != nil {
+		return nil, err
+	}
+
+	template, err := sts.str.GetByID(ctx, tid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if strings.TrimSpace(setlist.Name) == "" {
+		setlist.Name = template.Name
+	}
+
+	entries, roles := templateCopy(template)
+
+	return storeSetlistCopy(ctx, sts.tx, sts.slr, sts.sler, sts.slrr, setlist, entries, roles, principal)
+}
+
+// Clone creates the setlist with copies of the entries and role assignments of the
+// setlist with id sid, the setlist is named after the original unless named.
+func (sts setlistTemplateService) Clone(
+	ctx context.Context,
+	sid int64,
+	setlist *domain.Setlist,
+	principal *domain.User,
+) (*domain.CopiedSetlist, error) {
+	if err := checkCopy(setlist, principal); err != nil {
+		return nil, err
+	}
+
+	original, err := sts.slr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if !original.IsVisibleTo(principal) {
+		return nil, domain.NewRecordNotFoundErr("id", fmt.Sprint(sid))
+	}
+
+	if strings.TrimSpace(setlist.Name) == "" {
+		setlist.Name = original.Name
+	}
+
+	entries, err := sts.sler.GetBySetlist(ctx, &[]domain.Setlist{*original})
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	roles, err := sts.slrr.Get(ctx, []int64{original.ID})
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	copiedRoles := make([]domain.SetlistRole, 0)
+	if roles != nil {
+		copiedRoles = append(copiedRoles, *roles...)
+	}
+
+	copiedEntries := append([]domain.SetlistEntry{}, *entries...)
+
+	return storeSetlistCopy(ctx, sts.tx, sts.slr, sts.sler, sts.slrr, setlist, copiedEntries, copiedRoles, principal)
+}
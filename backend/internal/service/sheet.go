@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"gorm.io/datatypes"
+)
+
+// fetchSheetTranslations fetches the translations of the song
+// when the sheet is asked in other languages than the song is written in.
+func fetchSheetTranslations(
+	ctx context.Context,
+	str domain.SongTranslationRepository,
+	song *domain.Song,
+	options *domain.SheetOptions,
+) ([]domain.SongTranslation, error) {
+	if options == nil {
+		return nil, nil
+	}
+
+	for _, lang := range options.Languages {
+		if lang == song.Language {
+			continue
+		}
+
+		translations, err := str.GetBySong(ctx, song.ID)
+		if err != nil {
+			return nil, domain.FromError(err)
+		}
+
+		return translations, nil
+	}
+
+	return nil, nil
+}
+
+// localizeChordSheet returns the title and chord sheet of the song in the language.
+func localizeChordSheet(
+	song *domain.Song,
+	chordsheet datatypes.JSON,
+	lang string,
+	translations []domain.SongTranslation,
+) (string, datatypes.JSON, error) {
+	if lang == song.Language {
+		return song.Title, chordsheet, nil
+	}
+
+	for _, translation := range translations {
+		if translation.Language != lang {
+			continue
+		}
+
+		translated, err := util.TranslateChordSheet(chordsheet, translation.Lyrics)
+		if err != nil {
+			return "", nil, domain.NewBadRequestErr(err.Error())
+		}
+
+		if translation.Title == "" {
+			return song.Title, translated, nil
+		}
+
+		return translation.Title, translated, nil
+	}
+
+	return "", nil, domain.NewRecordNotFoundErr("language", lang)
+}
+
+func newSheet(
+	song *domain.Song,
+	transpose int16,
+	options *domain.SheetOptions,
+	translations []domain.SongTranslation,
+) (*domain.Sheet, error) {
+	if options == nil {
+		options = &domain.SheetOptions{Notation: domain.LetterNotation}
+	}
+
+	if !options.Notation.IsValid() {
+		return nil, domain.NewBadRequestErr(fmt.Sprintf("%s is not a valid notation", options.Notation))
+	}
+
+	key, err := util.TransposeKey(song.Key, int(transpose))
+	if err != nil {
+		return nil, domain.NewBadRequestErr(err.Error())
+	}
+
+	chordsheet, err := util.TransposeChordSheet(song.ChordSheet, int(transpose), util.PrefersFlats(key))
+	if err != nil {
+		return nil, domain.NewBadRequestErr(err.Error())
+	}
+
+	if options.Notation == domain.NashvilleNotation {
+		chordsheet, err = util.NashvilleChordSheet(chordsheet, key)
+		if err != nil {
+			return nil, domain.NewBadRequestErr(err.Error())
+		}
+	}
+
+	title := song.Title
+
+	var languages []string
+
+	localized := make([]datatypes.JSON, 0, len(options.Languages))
+
+	for idx, tag := range options.Languages {
+		lang, err := util.ParseLanguage(tag)
+		if err != nil {
+			return nil, err
+		}
+
+		localizedTitle, localizedSheet, err := localizeChordSheet(song, chordsheet, lang, translations)
+		if err != nil {
+			return nil, err
+		}
+
+		if idx == 0 {
+			title = localizedTitle
+		}
+
+		languages = append(languages, lang)
+		localized = append(localized, localizedSheet)
+	}
+
+	if len(localized) > 0 {
+		chordsheet = localized[0]
+	}
+
+	document, err := util.ParseChordSheet(chordsheet)
+	if err != nil {
+		return nil, domain.NewBadRequestErr(err.Error())
+	}
+
+	for idx := 1; idx < len(localized); idx++ {
+		if err := util.AddTranslatedLines(document, languages[idx], localized[idx]); err != nil {
+			return nil, domain.NewBadRequestErr(err.Error())
+		}
+	}
+
+	return &domain.Sheet{
+		SongID:      song.ID,
+		Title:       title,
+		Subtitle:    song.Subtitle,
+		OriginalKey: song.Key,
+		Key:         key,
+		Transpose:   transpose,
+		Bpm:         song.Bpm,
+		Notation:    options.Notation,
+		ChordSheet:  chordsheet,
+		Sections:    document.Sections,
+		Languages:   languages,
+	}, nil
+}
@@ -2,26 +2,131 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/util"
 )
 
+// songSearch holds the search index of the songs. It is built on the first
+// search and kept up to date by the song service afterwards.
+type songSearch struct {
+	mu    sync.Mutex
+	index *util.SearchIndex
+}
+
+func (search *songSearch) load(ctx context.Context, sr domain.SongRepository) (*util.SearchIndex, error) {
+	search.mu.Lock()
+	defer search.mu.Unlock()
+
+	if search.index != nil {
+		return search.index, nil
+	}
+
+	songs, err := sr.GetAll(ctx)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	search.index = util.NewSearchIndex(*songs)
+
+	return search.index, nil
+}
+
+func (search *songSearch) put(song *domain.Song) {
+	search.mu.Lock()
+	defer search.mu.Unlock()
+
+	if search.index != nil {
+		search.index.Put(*song)
+	}
+}
+
+func (search *songSearch) remove(sids ...int64) {
+	search.mu.Lock()
+	defer search.mu.Unlock()
+
+	if search.index != nil {
+		search.index.Remove(sids...)
+	}
+}
+
 type songService struct {
-	ur domain.UserRepository
-	sr domain.SongRepository
-	br domain.BundleRepository
+	ur     domain.UserRepository
+	sr     domain.SongRepository
+	br     domain.BundleRepository
+	srr    domain.SongRevisionRepository
+	str    domain.SongTranslationRepository
+	search *songSearch
 }
 
 //revive:disable:unexported-return
-func NewSongService(ur domain.UserRepository, sr domain.SongRepository, br domain.BundleRepository) *songService {
+func NewSongService(
+	ur domain.UserRepository,
+	sr domain.SongRepository,
+	br domain.BundleRepository,
+	srr domain.SongRevisionRepository,
+	str domain.SongTranslationRepository,
+) *songService {
 	return &songService{
-		ur: ur,
-		sr: sr,
-		br: br,
+		ur:     ur,
+		sr:     sr,
+		br:     br,
+		srr:    srr,
+		str:    str,
+		search: &songSearch{},
+	}
+}
+
+func newSongRevision(song *domain.Song, editorID int64) *domain.SongRevision {
+	return &domain.SongRevision{
+		SongID:     song.ID,
+		EditorID:   editorID,
+		BundleID:   song.BundleID,
+		Title:      song.Title,
+		Subtitle:   song.Subtitle,
+		Key:        song.Key,
+		Bpm:        song.Bpm,
+		Duration:   song.Duration,
+		ChordSheet: song.ChordSheet,
+		CCLINumber: song.CCLINumber,
+		Authors:    song.Authors,
+		Copyright:  song.Copyright,
+		Publisher:  song.Publisher,
+		Language:   song.Language,
 	}
 }
 
+// recordBaseline stores the current state of a song that was created
+// before revisions were kept, so the first update does not lose it.
+func (ss songService) recordBaseline(ctx context.Context, sid int64) error {
+	revisions, err := ss.srr.GetBySong(ctx, sid)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	if len(revisions) > 0 {
+		return nil
+	}
+
+	currentSong, err := ss.sr.GetByID(ctx, sid)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	baseline := newSongRevision(currentSong, currentSong.CreatorID)
+	baseline.CreatedAt = currentSong.UpdatedAt
+
+	if err := ss.srr.Create(ctx, baseline); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
+
 func (ss songService) FetchByID(ctx context.Context, sid int64) (*domain.Song, error) {
 	song, err := ss.sr.GetByID(ctx, sid)
 	if err != nil {
@@ -40,13 +145,187 @@ func (ss songService) FetchAll(ctx context.Context) (*[]domain.Song, error) {
 	return songs, nil
 }
 
-func (ss songService) Fetch(ctx context.Context, options *domain.SongFilterOptions) ([]domain.Song, error) {
-	songs, err := ss.sr.Get(ctx, options)
+func (ss songService) Fetch(ctx context.Context, options *domain.SongFilterOptions) (*domain.SongPage, error) {
+	if !options.Sort.IsValid() {
+		return nil, domain.NewBadRequestErr(fmt.Sprintf("cannot sort songs by %s", options.Sort))
+	}
+
+	if options.MinBpm > 0 && options.MaxBpm > 0 && options.MinBpm > options.MaxBpm {
+		return nil, domain.NewBadRequestErr("minimum bpm cannot be higher than the maximum bpm")
+	}
+
+	if options.Limit < 0 {
+		return nil, domain.NewBadRequestErr("limit cannot be negative")
+	}
+
+	total, err := ss.sr.Count(ctx, options)
 	if err != nil {
 		return nil, domain.FromError(err)
 	}
 
-	return songs, nil
+	// Fetch one song more than requested to find out whether there is a next page.
+	query := *options
+	if query.Limit > 0 {
+		query.Limit++
+	}
+
+	songs, err := ss.sr.Get(ctx, &query)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	tagCounts, err := ss.sr.CountTags(ctx, options)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	page := &domain.SongPage{Songs: songs, Total: total, TagCounts: tagCounts}
+
+	if options.Limit > 0 && len(songs) > options.Limit {
+		page.Songs = songs[:options.Limit]
+		last := page.Songs[len(page.Songs)-1]
+		page.NextCursor = util.EncodeSongCursor(util.NewSongCursor(last, options.Sort))
+	}
+
+	return page, nil
+}
+
+func (ss songService) FetchSheet(ctx context.Context, sid int64, options *domain.SheetOptions) (*domain.Sheet, error) {
+	song, err := ss.sr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	translations, err := fetchSheetTranslations(ctx, ss.str, song, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSheet(song, 0, options, translations)
+}
+
+func (ss songService) Search(ctx context.Context, query string, limit int) ([]domain.SongSearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, domain.NewBadRequestErr("search query cannot be empty")
+	}
+
+	index, err := ss.search.load(ctx, ss.sr)
+	if err != nil {
+		return nil, err
+	}
+
+	return index.Search(query, limit), nil
+}
+
+func (ss songService) FetchRevisions(ctx context.Context, sid int64) ([]domain.SongRevision, error) {
+	if _, err := ss.sr.GetByID(ctx, sid); err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	revisions, err := ss.srr.GetBySong(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return revisions, nil
+}
+
+func (ss songService) fetchRevision(ctx context.Context, sid, revisionID int64) (*domain.SongRevision, error) {
+	revision, err := ss.srr.GetByID(ctx, revisionID)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if revision.SongID != sid {
+		return nil, domain.NewRecordNotFoundErr("song_id", fmt.Sprint(sid))
+	}
+
+	return revision, nil
+}
+
+func diffFields(from, to *domain.SongRevision) []domain.FieldDiff {
+	fields := []domain.FieldDiff{
+		{Field: "bundle_id", From: fmt.Sprint(from.BundleID), To: fmt.Sprint(to.BundleID)},
+		{Field: "title", From: from.Title, To: to.Title},
+		{Field: "subtitle", From: from.Subtitle, To: to.Subtitle},
+		{Field: "key", From: from.Key, To: to.Key},
+		{Field: "bpm", From: fmt.Sprint(from.Bpm), To: fmt.Sprint(to.Bpm)},
+		{Field: "duration", From: fmt.Sprint(from.Duration), To: fmt.Sprint(to.Duration)},
+		{Field: "ccli_number", From: from.CCLINumber, To: to.CCLINumber},
+		{Field: "authors", From: from.Authors, To: to.Authors},
+		{Field: "copyright", From: from.Copyright, To: to.Copyright},
+		{Field: "publisher", From: from.Publisher, To: to.Publisher},
+		{Field: "language", From: from.Language, To: to.Language},
+	}
+
+	changed := make([]domain.FieldDiff, 0, len(fields))
+
+	for _, field := range fields {
+		if field.From != field.To {
+			changed = append(changed, field)
+		}
+	}
+
+	return changed
+}
+
+func (ss songService) FetchRevisionDiff(ctx context.Context, sid, fromID, toID int64) (*domain.SongRevisionDiff, error) {
+	from, err := ss.fetchRevision(ctx, sid, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := ss.fetchRevision(ctx, sid, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	sections, err := util.DiffChordSheets(from.ChordSheet, to.ChordSheet)
+	if err != nil {
+		return nil, domain.NewInternalErr()
+	}
+
+	return &domain.SongRevisionDiff{
+		SongID:   sid,
+		FromID:   from.ID,
+		ToID:     to.ID,
+		Fields:   diffFields(from, to),
+		Sections: sections,
+	}, nil
+}
+
+// Revert saves the content of a revision as the current version of the song,
+// which is recorded as a new revision.
+func (ss songService) Revert(ctx context.Context, sid, revisionID int64, principal *domain.User) (*domain.Song, error) {
+	revision, err := ss.fetchRevision(ctx, sid, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentSong, err := ss.sr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	song := *currentSong
+	song.BundleID = revision.BundleID
+	song.Title = revision.Title
+	song.Subtitle = revision.Subtitle
+	song.Key = revision.Key
+	song.Bpm = revision.Bpm
+	song.Duration = revision.Duration
+	song.ChordSheet = revision.ChordSheet
+	song.CCLINumber = revision.CCLINumber
+	song.Authors = revision.Authors
+	song.Copyright = revision.Copyright
+	song.Publisher = revision.Publisher
+	song.Language = revision.Language
+
+	if err := ss.Update(ctx, &song, principal); err != nil {
+		return nil, err
+	}
+
+	return &song, nil
 }
 
 func (ss songService) Update(ctx context.Context, song *domain.Song, principal *domain.User) error {
@@ -65,6 +344,23 @@ func (ss songService) Update(ctx context.Context, song *domain.Song, principal *
 		return domain.NewBadRequestErr("invalid key")
 	}
 
+	if !song.IsValidCCLINumber() {
+		return domain.NewBadRequestErr("invalid CCLI song number")
+	}
+
+	if !song.HasValidLicenseFields() {
+		return domain.NewBadRequestErr("authors, copyright and publisher cannot be longer than 255 characters")
+	}
+
+	if song.Language != "" {
+		language, err := util.ParseLanguage(song.Language)
+		if err != nil {
+			return err
+		}
+
+		song.Language = language
+	}
+
 	if err := util.ValidateChordSheet(song.ChordSheet); err != nil {
 		return domain.NewBadRequestErr(err.Error())
 	}
@@ -77,11 +373,21 @@ func (ss songService) Update(ctx context.Context, song *domain.Song, principal *
 		return domain.FromError(err)
 	}
 
+	if err := ss.recordBaseline(ctx, song.ID); err != nil {
+		return err
+	}
+
 	err := ss.sr.Update(ctx, song)
 	if err != nil {
 		return domain.FromError(err)
 	}
 
+	ss.search.put(song)
+
+	if err := ss.srr.Create(ctx, newSongRevision(song, principal.ID)); err != nil {
+		return domain.FromError(err)
+	}
+
 	return nil
 }
 
@@ -98,6 +404,23 @@ func (ss songService) Store(ctx context.Context, song *domain.Song, principal *d
 		return domain.NewBadRequestErr("invalid key")
 	}
 
+	if !song.IsValidCCLINumber() {
+		return domain.NewBadRequestErr("invalid CCLI song number")
+	}
+
+	if !song.HasValidLicenseFields() {
+		return domain.NewBadRequestErr("authors, copyright and publisher cannot be longer than 255 characters")
+	}
+
+	if song.Language != "" {
+		language, err := util.ParseLanguage(song.Language)
+		if err != nil {
+			return err
+		}
+
+		song.Language = language
+	}
+
 	if err := util.ValidateChordSheet(song.ChordSheet); err != nil {
 		return domain.NewBadRequestErr(err.Error())
 	}
@@ -111,10 +434,16 @@ func (ss songService) Store(ctx context.Context, song *domain.Song, principal *d
 		return domain.FromError(err)
 	}
 
+	ss.search.put(song)
+
+	if err := ss.srr.Create(ctx, newSongRevision(song, principal.ID)); err != nil {
+		return domain.FromError(err)
+	}
+
 	return nil
 }
 
-func (ss songService) Remove(ctx context.Context, sid int64, principal *domain.User) error {
+func (ss songService) Remove(ctx context.Context, sid int64, version time.Time, principal *domain.User) error {
 	if !principal.HasClearance(domain.EDITOR) {
 		currentSong, err := ss.sr.GetByID(ctx, sid)
 		if err != nil {
@@ -126,10 +455,138 @@ func (ss songService) Remove(ctx context.Context, sid int64, principal *domain.U
 		}
 	}
 
-	err := ss.sr.Delete(ctx, sid)
+	err := ss.sr.Delete(ctx, sid, version)
 	if err != nil {
 		return domain.FromError(err)
 	}
 
+	ss.search.remove(sid)
+
+	return nil
+}
+
+func (ss songService) FetchDuplicates(ctx context.Context, threshold float64) ([]domain.DuplicateGroup, error) {
+	if threshold <= 0 || threshold > 1 {
+		return nil, domain.NewBadRequestErr("threshold must be greater than 0 and at most 1")
+	}
+
+	songs, err := ss.sr.GetAll(ctx)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return util.FindDuplicates(*songs, threshold), nil
+}
+
+// Merge keeps the song and folds the duplicates into it,
+// every setlist that played a duplicate will play the song instead.
+func (ss songService) Merge(ctx context.Context, sid int64, duplicateIDs []int64, principal *domain.User) (*domain.Song, error) {
+	if !principal.HasClearance(domain.ADMIN) {
+		return nil, domain.NewNotAuthorizedErr("only admins can merge songs")
+	}
+
+	ids := make([]int64, 0, len(duplicateIDs))
+	seen := make(map[int64]bool)
+
+	for _, id := range duplicateIDs {
+		if id == sid {
+			return nil, domain.NewBadRequestErr("a song cannot be merged into itself")
+		}
+
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, domain.NewBadRequestErr("no songs to merge")
+	}
+
+	song, err := ss.sr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	for _, id := range ids {
+		if _, err := ss.sr.GetByID(ctx, id); err != nil {
+			return nil, domain.FromError(err)
+		}
+	}
+
+	if err := ss.sr.Merge(ctx, sid, ids); err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	ss.search.remove(ids...)
+
+	return song, nil
+}
+
+func (ss songService) FetchTranslations(ctx context.Context, sid int64) ([]domain.SongTranslation, error) {
+	if _, err := ss.sr.GetByID(ctx, sid); err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	translations, err := ss.str.GetBySong(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return translations, nil
+}
+
+func (ss songService) StoreTranslation(ctx context.Context, translation *domain.SongTranslation, principal *domain.User) error {
+	song, err := ss.sr.GetByID(ctx, translation.SongID)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.EDITOR) && song.CreatorID != principal.ID {
+		return domain.NewNotAuthorizedErr("user is neither an editor nor creator of the song")
+	}
+
+	language, err := util.ParseLanguage(translation.Language)
+	if err != nil {
+		return err
+	}
+
+	if language == song.Language {
+		return domain.NewBadRequestErr(fmt.Sprintf("song is already written in %s", language))
+	}
+
+	if err := util.ValidateTranslation(translation.Lyrics, song.ChordSheet); err != nil {
+		return domain.NewBadRequestErr(err.Error())
+	}
+
+	translation.Language = language
+	translation.Title = strings.TrimSpace(translation.Title)
+
+	if err := ss.str.Save(ctx, translation); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
+
+func (ss songService) RemoveTranslation(ctx context.Context, sid int64, language string, principal *domain.User) error {
+	song, err := ss.sr.GetByID(ctx, sid)
+	if err != nil {
+		return domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.EDITOR) && song.CreatorID != principal.ID {
+		return domain.NewNotAuthorizedErr("user is neither an editor nor creator of the song")
+	}
+
+	language, err = util.ParseLanguage(language)
+	if err != nil {
+		return err
+	}
+
+	if err := ss.str.Delete(ctx, sid, language); err != nil {
+		return domain.FromError(err)
+	}
+
 	return nil
 }
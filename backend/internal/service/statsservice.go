@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+type statsService struct {
+	str domain.StatsRepository
+	sr  domain.SongRepository
+}
+
+//revive:disable:unexported-return
+func NewStatsService(str domain.StatsRepository, sr domain.SongRepository) *statsService {
+	return &statsService{
+		str: str,
+		sr:  sr,
+	}
+}
+
+func abs16(value int16) int16 {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
+
+// isMoreCommon prefers the transpose played most often,
+// ties go to the transpose closest to the original key.
+func isMoreCommon(count, best domain.TransposeCount) bool {
+	if count.Count != best.Count {
+		return count.Count > best.Count
+	}
+
+	if abs16(count.Transpose) != abs16(best.Transpose) {
+		return abs16(count.Transpose) < abs16(best.Transpose)
+	}
+
+	return count.Transpose < best.Transpose
+}
+
+func (sts statsService) fetchStats(ctx context.Context, options *domain.PlayFilterOptions) ([]domain.SongStats, error) {
+	stats, err := sts.str.GetPlayCounts(ctx, options)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if len(stats) == 0 {
+		return []domain.SongStats{}, nil
+	}
+
+	songIDs := make([]int64, len(stats))
+	for idx, stat := range stats {
+		songIDs[idx] = stat.SongID
+	}
+
+	counts, err := sts.str.GetTransposeCounts(ctx, &domain.PlayFilterOptions{
+		SongIDs: songIDs,
+		From:    options.From,
+		To:      options.To,
+	})
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	common := make(map[int64]domain.TransposeCount)
+
+	for _, count := range counts {
+		if best, exists := common[count.SongID]; !exists || isMoreCommon(count, best) {
+			common[count.SongID] = count
+		}
+	}
+
+	for idx := range stats {
+		stats[idx].CommonTranspose = common[stats[idx].SongID].Transpose
+	}
+
+	return stats, nil
+}
+
+func (sts statsService) FetchSongStats(ctx context.Context, sid int64) (*domain.SongStats, error) {
+	song, err := sts.sr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	stats, err := sts.fetchStats(ctx, &domain.PlayFilterOptions{
+		SongIDs: []int64{song.ID},
+		To:      time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stats) == 0 {
+		return &domain.SongStats{SongID: song.ID, Title: song.Title}, nil
+	}
+
+	return &stats[0], nil
+}
+
+// playedPeriod ends the period now when it has no end or ends in the future,
+// setlists that are still planned have not been played yet.
+func playedPeriod(from, to time.Time) (time.Time, error) {
+	if now := time.Now(); to.IsZero() || to.After(now) {
+		to = now
+	}
+
+	if from.After(to) {
+		return time.Time{}, domain.NewBadRequestErr("From field cannot be after To field.")
+	}
+
+	return to, nil
+}
+
+func (sts statsService) FetchTopSongs(ctx context.Context, from, to time.Time, limit int) ([]domain.SongStats, error) {
+	to, err := playedPeriod(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		return nil, domain.NewBadRequestErr("limit must be positive")
+	}
+
+	return sts.fetchStats(ctx, &domain.PlayFilterOptions{
+		From:  from,
+		To:    to,
+		Limit: limit,
+	})
+}
+
+func (sts statsService) FetchCCLIReport(
+	ctx context.Context,
+	from, to time.Time,
+	principal *domain.User,
+) (*domain.CCLIReport, error) {
+	if !principal.HasClearance(domain.EDITOR) {
+		return nil, domain.NewNotAuthorizedErr("only editors can fetch the CCLI report")
+	}
+
+	to, err := playedPeriod(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	plays, err := sts.str.GetPlayCounts(ctx, &domain.PlayFilterOptions{From: from, To: to})
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	report := &domain.CCLIReport{From: from, To: to, Rows: make([]domain.CCLIReportRow, 0, len(plays))}
+
+	if len(plays) == 0 {
+		return report, nil
+	}
+
+	songIDs := make([]int64, len(plays))
+	for idx, play := range plays {
+		songIDs[idx] = play.SongID
+	}
+
+	songs, err := sts.sr.Get(ctx, &domain.SongFilterOptions{IDs: songIDs})
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	songMap := make(map[int64]domain.Song, len(songs))
+	for _, song := range songs {
+		songMap[song.ID] = song
+	}
+
+	for _, play := range plays {
+		song, exists := songMap[play.SongID]
+		if !exists {
+			continue
+		}
+
+		report.Rows = append(report.Rows, domain.CCLIReportRow{
+			SongID:            song.ID,
+			CCLINumber:        song.CCLINumber,
+			Title:             song.Title,
+			Authors:           song.Authors,
+			Copyright:         song.Copyright,
+			Publisher:         song.Publisher,
+			TimesUsed:         play.PlayCount,
+			MissingCCLINumber: song.CCLINumber == "",
+		})
+	}
+
+	return report, nil
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+type tagService struct {
+	tr domain.TagRepository
+	sr domain.SongRepository
+}
+
+//revive:disable:unexported-return
+func NewTagService(tr domain.TagRepository, sr domain.SongRepository) *tagService {
+	return &tagService{
+		tr: tr,
+		sr: sr,
+	}
+}
+
+func validateTag(tag *domain.Tag) error {
+	tag.Name = strings.TrimSpace(tag.Name)
+
+	if tag.Name == "" {
+		return domain.NewBadRequestErr("tag name cannot be empty")
+	}
+
+	if !tag.HasValidName() {
+		return domain.NewBadRequestErr("tag name cannot be longer than 64 characters")
+	}
+
+	if tag.Category == "" {
+		tag.Category = domain.TagTheme
+	}
+
+	if !tag.Category.IsValid() {
+		return domain.NewBadRequestErr(fmt.Sprintf("%s is not a valid tag category", tag.Category))
+	}
+
+	return nil
+}
+
+func (ts tagService) FetchByID(ctx context.Context, tid int64) (*domain.Tag, error) {
+	tag, err := ts.tr.GetByID(ctx, tid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return tag, nil
+}
+
+func (ts tagService) FetchAll(ctx context.Context) (*[]domain.Tag, error) {
+	tags, err := ts.tr.GetAll(ctx)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return tags, nil
+}
+
+func (ts tagService) FetchBySong(ctx context.Context, sid int64) ([]domain.Tag, error) {
+	if _, err := ts.sr.GetByID(ctx, sid); err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	tags, err := ts.tr.GetBySong(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return tags, nil
+}
+
+func (ts tagService) SetSongTags(ctx context.Context, sid int64, tagIDs []int64, principal *domain.User) ([]domain.Tag, error) {
+	song, err := ts.sr.GetByID(ctx, sid)
+	if err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	if !principal.HasClearance(domain.EDITOR) && song.CreatorID != principal.ID {
+		return nil, domain.NewNotAuthorizedErr("user is neither an editor nor creator of the song")
+	}
+
+	uniqueIDs := make([]int64, 0, len(tagIDs))
+	seen := make(map[int64]bool, len(tagIDs))
+
+	for _, tagID := range tagIDs {
+		if !seen[tagID] {
+			seen[tagID] = true
+			uniqueIDs = append(uniqueIDs, tagID)
+		}
+	}
+
+	tags := []domain.Tag{}
+
+	if len(uniqueIDs) > 0 {
+		tags, err = ts.tr.GetByIDs(ctx, uniqueIDs)
+		if err != nil {
+			return nil, domain.FromError(err)
+		}
+
+		found := make(map[int64]bool, len(tags))
+		for _, tag := range tags {
+			found[tag.ID] = true
+		}
+
+		for _, tagID := range uniqueIDs {
+			if !found[tagID] {
+				return nil, domain.NewRecordNotFoundErr("tag_id", fmt.Sprint(tagID))
+			}
+		}
+	}
+
+	if err := ts.tr.SetSongTags(ctx, sid, uniqueIDs); err != nil {
+		return nil, domain.FromError(err)
+	}
+
+	return tags, nil
+}
+
+func (ts tagService) Store(ctx context.Context, tag *domain.Tag, principal *domain.User) error {
+	if !principal.HasClearance(domain.MEMBER) {
+		return domain.NewNotAuthorizedErr("not authorized to create tags")
+	}
+
+	if err := validateTag(tag); err != nil {
+		return err
+	}
+
+	if err := ts.tr.Create(ctx, tag); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
+
+func (ts tagService) Update(ctx context.Context, tag *domain.Tag, principal *domain.User) error {
+	if !principal.HasClearance(domain.MEMBER) {
+		return domain.NewNotAuthorizedErr("not authorized to update tags")
+	}
+
+	if err := validateTag(tag); err != nil {
+		return err
+	}
+
+	if _, err := ts.tr.GetByID(ctx, tag.ID); err != nil {
+		return domain.FromError(err)
+	}
+
+	if err := ts.tr.Update(ctx, tag); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
+
+func (ts tagService) Remove(ctx context.Context, tid int64, principal *domain.User) error {
+	if !principal.HasClearance(domain.EDITOR) {
+		return domain.NewNotAuthorizedErr("only editors can remove tags")
+	}
+
+	if _, err := ts.tr.GetByID(ctx, tid); err != nil {
+		return domain.FromError(err)
+	}
+
+	if err := ts.tr.Delete(ctx, tid); err != nil {
+		return domain.FromError(err)
+	}
+
+	return nil
+}
@@ -10,14 +10,21 @@ type userService struct {
 	ur  domain.UserRepository
 	rr  domain.RoleRepository
 	urr domain.UserRoleRepository
+	tx  domain.Transactor
 }
 
 //revive:disable:unexported-return
-func NewUserService(ur domain.UserRepository, rr domain.RoleRepository, urr domain.UserRoleRepository) domain.UserService {
+func NewUserService(
+	ur domain.UserRepository,
+	rr domain.RoleRepository,
+	urr domain.UserRoleRepository,
+	tx domain.Transactor,
+) domain.UserService {
 	return &userService{
 		ur:  ur,
 		rr:  rr,
 		urr: urr,
+		tx:  tx,
 	}
 }
 
@@ -48,31 +55,34 @@ func (us *userService) FetchAll(ctx context.Context) (*[]domain.User, error) {
 	return users, nil
 }
 
+// Store creates the user and gives them every role, both or neither are saved.
 func (us userService) Store(ctx context.Context, user *domain.User) error {
-	err := us.ur.Create(ctx, user)
-	if err != nil {
-		return domain.FromError(err)
-	}
+	return us.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := us.ur.Create(ctx, user)
+		if err != nil {
+			return domain.FromError(err)
+		}
 
-	currentRoles, err := us.rr.GetAll(ctx)
-	if err != nil {
-		return domain.FromError(err)
-	}
+		currentRoles, err := us.rr.GetAll(ctx)
+		if err != nil {
+			return domain.FromError(err)
+		}
 
-	userroles := make([]domain.UserRole, len(*currentRoles))
-	for idx, role := range *currentRoles {
-		userroles[idx] = domain.UserRole{
-			UserID: user.ID,
-			RoleID: role.ID,
+		userroles := make([]domain.UserRole, len(*currentRoles))
+		for idx, role := range *currentRoles {
+			userroles[idx] = domain.UserRole{
+				UserID: user.ID,
+				RoleID: role.ID,
+			}
 		}
-	}
 
-	err = us.urr.CreateBatch(ctx, &userroles)
-	if err != nil {
-		return domain.FromError(err)
-	}
+		err = us.urr.CreateBatch(ctx, &userroles)
+		if err != nil {
+			return domain.FromError(err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (us userService) Update(ctx context.Context, user *domain.User) error {
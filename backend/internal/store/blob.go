@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+var blobKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+type localBlobStore struct {
+	root string
+}
+
+// NewLocalBlobStore keeps blobs as files in the root directory, creating it when needed.
+//
+//revive:disable:unexported-return
+func NewLocalBlobStore(root string) (*localBlobStore, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, domain.NewInitializationErr(err.Error())
+	}
+
+	return &localBlobStore{root: root}, nil
+}
+
+func (bs localBlobStore) path(key string) (string, error) {
+	if !blobKeyPattern.MatchString(key) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return filepath.Join(bs.root, key), nil
+}
+
+// Put writes the content to a temporary file first,
+// so a failed upload never leaves a partial blob behind.
+func (bs localBlobStore) Put(ctx context.Context, key string, content io.Reader) error {
+	path, err := bs.path(key)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(bs.root, ".upload-*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func (bs localBlobStore) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	path, err := bs.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Open(path)
+}
+
+func (bs localBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := bs.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
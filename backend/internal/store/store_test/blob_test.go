@@ -0,0 +1,56 @@
+package store_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	t.Parallel()
+
+	root := filepath.Join(t.TempDir(), "attachments")
+
+	bs, err := store.NewLocalBlobStore(root)
+	assert.NoError(t, err)
+
+	err = bs.Put(context.TODO(), "abc123", strings.NewReader("foo"))
+	assert.NoError(t, err)
+
+	content, err := bs.Open(context.TODO(), "abc123")
+	assert.NoError(t, err)
+
+	data, err := io.ReadAll(content)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", string(data))
+	assert.NoError(t, content.Close())
+
+	entries, err := os.ReadDir(root)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	assert.NoError(t, bs.Delete(context.TODO(), "abc123"))
+	assert.NoError(t, bs.Delete(context.TODO(), "abc123"))
+
+	_, err = bs.Open(context.TODO(), "abc123")
+	assert.Error(t, err)
+}
+
+func TestLocalBlobStoreInvalidKey(t *testing.T) {
+	t.Parallel()
+
+	bs, err := store.NewLocalBlobStore(t.TempDir())
+	assert.NoError(t, err)
+
+	assert.Error(t, bs.Put(context.TODO(), "../escape", strings.NewReader("foo")))
+
+	_, err = bs.Open(context.TODO(), "a/b")
+	assert.Error(t, err)
+	assert.Error(t, bs.Delete(context.TODO(), ""))
+}
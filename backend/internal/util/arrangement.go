@@ -0,0 +1,116 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"gorm.io/datatypes"
+)
+
+const maxArrangementRepeat = 16
+
+// repeatPattern matches a trailing repeat such as "Chorus x2" or "Chorus (x2)".
+var repeatPattern = regexp.MustCompile(`^(.*?)\s*\(?\s*[xX×]\s*(\d+)\s*\)?$`)
+
+// ParseArrangementItem splits an arrangement item into the section
+// it refers to and the number of times the section is played.
+func ParseArrangementItem(item string) (string, int, error) {
+	item = strings.TrimSpace(item)
+
+	match := repeatPattern.FindStringSubmatch(item)
+	if match == nil {
+		return item, 1, nil
+	}
+
+	repeat, err := strconv.Atoi(match[2])
+	if err != nil || repeat < 1 || repeat > maxArrangementRepeat {
+		return "", 0, fmt.Errorf("%s must be repeated between 1 and %d times", match[1], maxArrangementRepeat)
+	}
+
+	return match[1], repeat, nil
+}
+
+// ParseArrangement reads the arrangement of a setlist entry,
+// an empty arrangement results in an empty list.
+func ParseArrangement(arrangement datatypes.JSON) ([]string, error) {
+	items := make([]string, 0)
+
+	if len(strings.TrimSpace(string(arrangement))) == 0 {
+		return items, nil
+	}
+
+	if err := json.Unmarshal(arrangement, &items); err != nil {
+		return nil, fmt.Errorf("arrangement must be a list of sections")
+	}
+
+	if items == nil {
+		items = make([]string, 0)
+	}
+
+	return items, nil
+}
+
+// ExpandArrangement returns the sections of the chord sheet in the order they
+// are played, with repeated sections included as many times as they are repeated.
+// Without an arrangement every section is played once in the order of the chord sheet.
+func ExpandArrangement(arrangement, chordsheet datatypes.JSON) ([]domain.ArrangedSection, error) {
+	items, err := ParseArrangement(arrangement)
+	if err != nil {
+		return nil, err
+	}
+
+	sections, err := readChordSheet(chordsheet)
+	if err != nil {
+		return nil, err
+	}
+
+	expanded := make([]domain.ArrangedSection, 0, len(sections))
+
+	if len(items) == 0 {
+		for _, section := range sections {
+			expanded = append(expanded, domain.ArrangedSection{Tag: section.Tag, Text: section.Text})
+		}
+
+		return expanded, nil
+	}
+
+	for _, item := range items {
+		tag, repeat, err := ParseArrangementItem(item)
+		if err != nil {
+			return nil, err
+		}
+
+		section := findSection(sections, tag)
+		if section == nil {
+			return nil, fmt.Errorf("song has no section %s", tag)
+		}
+
+		for i := 0; i < repeat; i++ {
+			expanded = append(expanded, domain.ArrangedSection{Tag: section.Tag, Text: section.Text})
+		}
+	}
+
+	return expanded, nil
+}
+
+// ValidateArrangement checks that every item of the arrangement
+// refers to a section of the chord sheet.
+func ValidateArrangement(arrangement, chordsheet datatypes.JSON) error {
+	_, err := ExpandArrangement(arrangement, chordsheet)
+
+	return err
+}
+
+func findSection(sections []chordSheetSection, tag string) *chordSheetSection {
+	for idx := range sections {
+		if strings.EqualFold(sections[idx].Tag, tag) {
+			return &sections[idx]
+		}
+	}
+
+	return nil
+}
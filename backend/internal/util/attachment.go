@@ -0,0 +1,67 @@
+package util
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+// SniffLength is the number of leading bytes needed to sniff the content type.
+const SniffLength = 512
+
+var allowedAttachmentTypes = map[string]bool{
+	"application/pdf": true,
+	"application/ogg": true,
+	"image/png":       true,
+	"image/jpeg":      true,
+	"video/mp4":       true,
+}
+
+var audioExtensions = map[string]string{
+	".mp3":  "audio/mpeg",
+	".m4a":  "audio/mp4",
+	".aac":  "audio/aac",
+	".flac": "audio/flac",
+	".ogg":  "audio/ogg",
+	".opus": "audio/ogg",
+	".wav":  "audio/wave",
+}
+
+// SniffContentType detects the content type of an attachment from its leading bytes,
+// the file name is only consulted for audio formats the sniffer does not recognise.
+// Only audio, sheet music and practice video types are allowed.
+func SniffContentType(name string, head []byte) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return "", domain.NewBadRequestErr(err.Error())
+	}
+
+	if mediaType == "application/octet-stream" {
+		if audioType, exists := audioExtensions[strings.ToLower(filepath.Ext(name))]; exists {
+			mediaType = audioType
+		}
+	}
+
+	if !strings.HasPrefix(mediaType, "audio/") && !allowedAttachmentTypes[mediaType] {
+		return "", domain.NewBadRequestErr(fmt.Sprintf("content type %s is not allowed", mediaType))
+	}
+
+	return mediaType, nil
+}
+
+// NewStorageKey returns a random key to store the contents of an attachment under.
+func NewStorageKey() (string, error) {
+	key := make([]byte, 16)
+
+	if _, err := rand.Read(key); err != nil {
+		return "", domain.NewInternalErr()
+	}
+
+	return hex.EncodeToString(key), nil
+}
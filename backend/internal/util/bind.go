@@ -30,6 +30,24 @@ func BindNamedParams(ctx *gin.Context, names ...string) (map[string]int64, error
 	return idMap, nil
 }
 
+// BindNamedQueries reads the given integer query parameters, all of which are required.
+func BindNamedQueries(ctx *gin.Context, names ...string) (map[string]int64, error) {
+	idMap := make(map[string]int64, len(names))
+
+	for _, name := range names {
+		field := ctx.Query(name)
+
+		fieldID, err := strconv.Atoi(field)
+		if err != nil {
+			return map[string]int64{}, domain.NewBadRequestErr(fmt.Sprintf("Could not read %s", name))
+		}
+
+		idMap[name] = int64(fieldID)
+	}
+
+	return idMap, nil
+}
+
 func msgForTag(tag string) string {
 	switch tag {
 	case "required":
@@ -71,3 +89,48 @@ func BindModel(ctx *gin.Context, model interface{}) error {
 
 	return nil
 }
+
+// BindSheetOptions reads the rendering options of a chord sheet from the query,
+// the notation defaults to letter chords. The languages are a comma separated lang query.
+func BindSheetOptions(ctx *gin.Context) *domain.SheetOptions {
+	var languages []string
+
+	for _, lang := range strings.Split(ctx.Query("lang"), ",") {
+		if lang = strings.TrimSpace(lang); lang != "" {
+			languages = append(languages, lang)
+		}
+	}
+
+	return &domain.SheetOptions{
+		Notation:  domain.Notation(ctx.DefaultQuery("notation", string(domain.LetterNotation))),
+		Languages: languages,
+	}
+}
+
+// Principal returns the user set by AuthenticateUser, a missing user is an internal error
+// since the route requires authentication.
+func Principal(ctx *gin.Context) (*domain.User, error) {
+	val, exists := ctx.Get("user")
+	if !exists {
+		return nil, domain.NewInternalErr()
+	}
+
+	user, ok := val.(*domain.User)
+	if !ok {
+		return nil, domain.NewInternalErr()
+	}
+
+	return user, nil
+}
+
+// Identified returns the user set by IdentifyUser or nil for anonymous requests.
+func Identified(ctx *gin.Context) *domain.User {
+	val, exists := ctx.Get("user")
+	if !exists {
+		return nil
+	}
+
+	user, _ := val.(*domain.User)
+
+	return user
+}
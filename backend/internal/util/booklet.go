@@ -0,0 +1,136 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+const bookletTimeFormat = "Monday 2 January 2006 15:04"
+
+// chordOverLyrics lays out a line as a line of chords placed above
+// the lyric they belong to and the lyrics themselves. Lyrics are padded
+// when chords would otherwise run into each other.
+func chordOverLyrics(line domain.ChordSheetLine) (string, string) {
+	var chords, lyrics strings.Builder
+
+	text := []rune(line.Lyrics)
+	chordWidth, lyricWidth, consumed := 0, 0, 0
+
+	for _, chord := range line.Chords {
+		offset := chord.Offset
+		if offset > len(text) {
+			offset = len(text)
+		}
+
+		lyrics.WriteString(string(text[consumed:offset]))
+		lyricWidth += offset - consumed
+		consumed = offset
+
+		if chordWidth > 0 && chordWidth >= lyricWidth {
+			padding := chordWidth - lyricWidth + 1
+			lyrics.WriteString(strings.Repeat(" ", padding))
+			lyricWidth += padding
+		}
+
+		chords.WriteString(strings.Repeat(" ", lyricWidth-chordWidth))
+		chords.WriteString(chord.Symbol)
+		chordWidth = lyricWidth + len([]rune(chord.Symbol))
+	}
+
+	lyrics.WriteString(string(text[consumed:]))
+
+	return chords.String(), lyrics.String()
+}
+
+func writeChart(pdf *PDF, chart *domain.Chart) error {
+	sheet := chart.Sheet
+
+	pdf.AddPage()
+	pdf.Write(PDFFontBold, 18, sheet.Title)
+
+	if sheet.Subtitle != "" {
+		pdf.Write(PDFFontRegular, 12, sheet.Subtitle)
+	}
+
+	header := fmt.Sprintf("Key: %s | BPM: %d", sheet.Key, sheet.Bpm)
+	if sheet.Transpose != 0 {
+		header += fmt.Sprintf(" | Transposed %+d from %s", sheet.Transpose, sheet.OriginalKey)
+	}
+
+	pdf.Write(PDFFontRegular, 11, header)
+
+	if len(chart.Arrangement) > 0 {
+		pdf.Write(PDFFontRegular, 11, "Order: "+strings.Join(chart.Arrangement, " - "))
+	}
+
+	if chart.Notes != "" {
+		pdf.Write(PDFFontRegular, 11, "Notes: "+chart.Notes)
+	}
+
+	document, err := ParseChordSheet(sheet.ChordSheet)
+	if err != nil {
+		return err
+	}
+
+	for _, section := range document.Sections {
+		pdf.Space(10)
+		pdf.Ensure(40)
+		pdf.Write(PDFFontBold, 12, section.Tag)
+
+		for _, line := range section.Lines {
+			chords, lyrics := chordOverLyrics(line)
+
+			if chords != "" {
+				pdf.Ensure(25)
+				pdf.Write(PDFFontMonoBold, 10, chords)
+			}
+
+			pdf.Write(PDFFontMono, 10, lyrics)
+		}
+	}
+
+	return nil
+}
+
+// RenderBookletPDF renders a cover page for the setlist
+// followed by one chart per entry.
+func RenderBookletPDF(booklet *domain.Booklet) ([]byte, error) {
+	pdf := NewPDF()
+
+	pdf.AddPage()
+	pdf.Space(150)
+	pdf.Write(PDFFontBold, 28, booklet.Setlist.Name)
+	pdf.Write(PDFFontRegular, 14, booklet.Setlist.Deadline.Format(bookletTimeFormat))
+	pdf.Space(30)
+
+	if len(booklet.Assignments) > 0 {
+		pdf.Write(PDFFontBold, 14, "Team")
+
+		for _, userrole := range booklet.Assignments {
+			if userrole.User == nil || userrole.Role == nil {
+				continue
+			}
+
+			pdf.Write(PDFFontRegular, 12, fmt.Sprintf("%s: %s %s",
+				userrole.Role.Name, userrole.User.FirstName, userrole.User.LastName))
+		}
+
+		pdf.Space(20)
+	}
+
+	pdf.Write(PDFFontBold, 14, "Songs")
+
+	for idx, chart := range booklet.Charts {
+		pdf.Write(PDFFontRegular, 12, fmt.Sprintf("%d. %s (%s)", idx+1, chart.Sheet.Title, chart.Sheet.Key))
+	}
+
+	for idx := range booklet.Charts {
+		if err := writeChart(pdf, &booklet.Charts[idx]); err != nil {
+			return nil, err
+		}
+	}
+
+	return pdf.Bytes(), nil
+}
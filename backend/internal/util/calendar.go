@@ -0,0 +1,186 @@
+package util
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+const (
+	calendarTimeFormat = "20060102T150405Z"
+	calendarLineLength = 75
+	calendarProductID  = "-//mkvstage//setlists//EN"
+)
+
+var calendarTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// NewCalendarToken returns a random secret for the url of a personal calendar feed.
+func NewCalendarToken() (string, error) {
+	token := make([]byte, 32)
+
+	if _, err := rand.Read(token); err != nil {
+		return "", domain.NewInternalErr()
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// foldCalendarLine splits lines longer than 75 octets over multiple lines
+// that continue with a space, without splitting multi-byte characters.
+func foldCalendarLine(line string) string {
+	var folded strings.Builder
+
+	limit := calendarLineLength
+
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		folded.WriteString(line[:cut])
+		folded.WriteString("\r\n ")
+
+		line = line[cut:]
+		limit = calendarLineLength - 1
+	}
+
+	folded.WriteString(line)
+	folded.WriteString("\r\n")
+
+	return folded.String()
+}
+
+func writeCalendarLine(builder *strings.Builder, name, value string) {
+	builder.WriteString(foldCalendarLine(fmt.Sprintf("%s:%s", name, value)))
+}
+
+// RenderCalendar renders the calendar as an RFC 5545 iCalendar with a VEVENT for every event,
+// events that were never modified are stamped with the time the calendar was generated.
+func RenderCalendar(calendar *domain.Calendar) []byte {
+	var builder strings.Builder
+
+	writeCalendarLine(&builder, "BEGIN", "VCALENDAR")
+	writeCalendarLine(&builder, "VERSION", "2.0")
+	writeCalendarLine(&builder, "PRODID", calendarProductID)
+	writeCalendarLine(&builder, "CALSCALE", "GREGORIAN")
+	writeCalendarLine(&builder, "METHOD", "PUBLISH")
+	writeCalendarLine(&builder, "X-WR-CALNAME", calendarTextEscaper.Replace(calendar.Name))
+
+	for _, event := range calendar.Events {
+		writeCalendarLine(&builder, "BEGIN", "VEVENT")
+		writeCalendarLine(&builder, "UID", event.UID)
+
+		if event.Modified.IsZero() {
+			writeCalendarLine(&builder, "DTSTAMP", calendar.Generated.UTC().Format(calendarTimeFormat))
+		} else {
+			writeCalendarLine(&builder, "DTSTAMP", event.Modified.UTC().Format(calendarTimeFormat))
+			writeCalendarLine(&builder, "LAST-MODIFIED", event.Modified.UTC().Format(calendarTimeFormat))
+		}
+
+		writeCalendarLine(&builder, "DTSTART", event.Start.UTC().Format(calendarTimeFormat))
+
+		if event.Duration > 0 {
+			writeCalendarLine(&builder, "DURATION", fmt.Sprintf("PT%dS", int64(event.Duration.Seconds())))
+		}
+
+		writeCalendarLine(&builder, "SUMMARY", calendarTextEscaper.Replace(event.Summary))
+
+		if event.Description != "" {
+			writeCalendarLine(&builder, "DESCRIPTION", calendarTextEscaper.Replace(event.Description))
+		}
+
+		if event.Status != "" {
+			writeCalendarLine(&builder, "STATUS", string(event.Status))
+		}
+
+		writeCalendarLine(&builder, "END", "VEVENT")
+	}
+
+	writeCalendarLine(&builder, "END", "VCALENDAR")
+
+	return []byte(builder.String())
+}
+
+// SetlistEvent returns the event of the setlist starting at its deadline and lasting its
+// estimated run time. The description lists the songs in rank order and the assignments.
+func SetlistEvent(
+	setlist *domain.Setlist,
+	entries []domain.SetlistEntry,
+	songs map[int64]domain.Song,
+	assignments []domain.UserRole,
+) domain.CalendarEvent {
+	ranked := make([]domain.SetlistEntry, len(entries))
+	copy(ranked, entries)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Rank < ranked[j].Rank
+	})
+
+	lines := make([]string, 0)
+
+	for _, entry := range ranked {
+		song, exists := songs[entry.SongID]
+		if !entry.IsSong() || !exists {
+			continue
+		}
+
+		if len(lines) == 0 {
+			lines = append(lines, "Songs:")
+		}
+
+		key, err := TransposeKey(song.Key, int(entry.Transpose))
+		if err != nil {
+			key = song.Key
+		}
+
+		lines = append(lines, fmt.Sprintf("%d. %s (%s)", len(lines), song.Title, key))
+	}
+
+	roles := make([]string, 0, len(assignments))
+
+	for _, assignment := range assignments {
+		if assignment.Role == nil || assignment.User == nil {
+			continue
+		}
+
+		roles = append(roles, fmt.Sprintf(
+			"%s: %s %s", assignment.Role.Name, assignment.User.FirstName, assignment.User.LastName,
+		))
+	}
+
+	sort.Strings(roles)
+
+	if len(roles) > 0 {
+		lines = append(lines, "Roles:")
+		lines = append(lines, roles...)
+	}
+
+	status := domain.EventConfirmed
+	if setlist.StatusAt(time.Now()) == domain.SetlistDraft {
+		status = domain.EventTentative
+	}
+
+	runTime := PlanSetlist(setlist.ID, entries, songs).RunTime
+
+	return domain.CalendarEvent{
+		UID:         fmt.Sprintf("setlist-%d@mkvstage", setlist.ID),
+		Summary:     setlist.Name,
+		Description: strings.Join(lines, "\n"),
+		Status:      status,
+		Start:       setlist.Deadline,
+		Duration:    time.Duration(runTime) * time.Second,
+		Modified:    setlist.UpdatedAt,
+	}
+}
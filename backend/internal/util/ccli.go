@@ -0,0 +1,53 @@
+package util
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+var ccliReportHeader = []string{
+	"CCLI Song Number", "Title", "Authors", "Copyright", "Publisher", "Times Used", "Missing CCLI Number",
+}
+
+// WriteCCLIReport writes the report as CSV with a header row and one row per song.
+func WriteCCLIReport(report *domain.CCLIReport) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	writer := csv.NewWriter(&buffer)
+
+	if err := writer.Write(ccliReportHeader); err != nil {
+		return nil, err
+	}
+
+	for _, row := range report.Rows {
+		missing := ""
+		if row.MissingCCLINumber {
+			missing = "yes"
+		}
+
+		record := []string{
+			row.CCLINumber,
+			row.Title,
+			row.Authors,
+			row.Copyright,
+			row.Publisher,
+			strconv.FormatInt(row.TimesUsed, 10),
+			missing,
+		}
+
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
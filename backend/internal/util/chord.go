@@ -0,0 +1,224 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/datatypes"
+)
+
+const semitones = 12
+
+var sharpNotes = [semitones]string{"C", "C#", "D", "D#", "E", "F", "F#", "G", "G#", "A", "A#", "B"}
+var flatNotes = [semitones]string{"C", "Db", "D", "Eb", "E", "F", "Gb", "G", "Ab", "A", "Bb", "B"}
+
+// majorKeys and minorKeys hold the conventional spelling of every key,
+// indexed by the pitch class of the tonic.
+var majorKeys = [semitones]string{"C", "Db", "D", "Eb", "E", "F", "F#", "G", "Ab", "A", "Bb", "B"}
+var minorKeys = [semitones]string{"Cm", "C#m", "Dm", "Ebm", "Em", "Fm", "F#m", "Gm", "G#m", "Am", "Bbm", "Bm"}
+
+var flatKeys = []string{
+	"F", "Bb", "Eb", "Ab", "Db", "Gb",
+	"Dm", "Gm", "Cm", "Fm", "Bbm", "Ebm",
+}
+
+var noteValues = map[byte]int{'C': 0, 'D': 2, 'E': 4, 'F': 5, 'G': 7, 'A': 9, 'B': 11}
+
+var chordPattern = regexp.MustCompile(`^([A-G])([#b]?)([^/]*)(?:/([A-G])([#b]?))?$`)
+var qualityPattern = regexp.MustCompile(`^(maj|min|dim|aug|sus|add|alt|no|m|M|\+|°|ø|[#b]?(13|11|9|7|6|5|4|2)|\(|\)|,)*$`)
+var inlineChordPattern = regexp.MustCompile(`\[([^\]]*)\]`)
+
+// Chord is a parsed chord symbol such as "F#m7b5/E".
+// Root and Bass are pitch classes from 0 (C) to 11 (B),
+// Bass is -1 when the chord has no slash bass note.
+type Chord struct {
+	Root    int
+	Quality string
+	Bass    int
+}
+
+func pitchClass(note byte, accidental string) int {
+	value := noteValues[note]
+
+	switch accidental {
+	case "#":
+		value++
+	case "b":
+		value--
+	}
+
+	return (value + semitones) % semitones
+}
+
+func noteName(pitch int, flats bool) string {
+	if flats {
+		return flatNotes[pitch]
+	}
+
+	return sharpNotes[pitch]
+}
+
+func shift(pitch, amount int) int {
+	return ((pitch+amount)%semitones + semitones) % semitones
+}
+
+func ParseChord(symbol string) (*Chord, error) {
+	match := chordPattern.FindStringSubmatch(strings.TrimSpace(symbol))
+	if match == nil {
+		return nil, fmt.Errorf("%s is not a valid chord", symbol)
+	}
+
+	if !qualityPattern.MatchString(match[3]) {
+		return nil, fmt.Errorf("%s has an invalid chord quality", symbol)
+	}
+
+	chord := &Chord{
+		Root:    pitchClass(match[1][0], match[2]),
+		Quality: match[3],
+		Bass:    -1,
+	}
+
+	if match[4] != "" {
+		chord.Bass = pitchClass(match[4][0], match[5])
+	}
+
+	return chord, nil
+}
+
+// IsMinor reports whether the chord has a minor third,
+// a major seventh written as "maj" or "M" is not minor.
+func (chord Chord) IsMinor() bool {
+	if strings.HasPrefix(chord.Quality, "maj") {
+		return false
+	}
+
+	return strings.HasPrefix(chord.Quality, "m")
+}
+
+func (chord Chord) Transpose(amount int) Chord {
+	transposed := Chord{
+		Root:    shift(chord.Root, amount),
+		Quality: chord.Quality,
+		Bass:    -1,
+	}
+
+	if chord.Bass >= 0 {
+		transposed.Bass = shift(chord.Bass, amount)
+	}
+
+	return transposed
+}
+
+func (chord Chord) Format(flats bool) string {
+	symbol := noteName(chord.Root, flats) + chord.Quality
+
+	if chord.Bass >= 0 {
+		symbol += "/" + noteName(chord.Bass, flats)
+	}
+
+	return symbol
+}
+
+// PrefersFlats reports whether chords in the given key
+// are conventionally spelled with flats instead of sharps.
+func PrefersFlats(key string) bool {
+	for _, flatKey := range flatKeys {
+		if key == flatKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseKey(key string) (int, bool, error) {
+	chord, err := ParseChord(key)
+	if err != nil || chord.Bass >= 0 || (chord.Quality != "" && chord.Quality != "m") {
+		return 0, false, fmt.Errorf("%s is not a valid key", key)
+	}
+
+	return chord.Root, chord.IsMinor(), nil
+}
+
+// TransposeKey shifts the key by the given amount of semitones and
+// returns it in its conventional spelling, e.g. "G" + 3 becomes "Bb".
+func TransposeKey(key string, amount int) (string, error) {
+	root, minor, err := parseKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	if amount%semitones == 0 {
+		return key, nil
+	}
+
+	if minor {
+		return minorKeys[shift(root, amount)], nil
+	}
+
+	return majorKeys[shift(root, amount)], nil
+}
+
+// CompatibleKeys returns every spelling of the key and of its relative
+// minor or major, e.g. "G" gives G and Em, "C#" gives C#, Db, A#m and Bbm.
+func CompatibleKeys(key string) ([]string, error) {
+	root, minor, err := parseKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	relative := shift(root, -3)
+	if minor {
+		relative = shift(root, 3)
+	}
+
+	keys := make([]string, 0, 4)
+	spellings := func(pitch int, quality string) {
+		for _, name := range [...]string{sharpNotes[pitch], flatNotes[pitch]} {
+			if len(keys) == 0 || keys[len(keys)-1] != name+quality {
+				keys = append(keys, name+quality)
+			}
+		}
+	}
+
+	if minor {
+		spellings(root, "m")
+		spellings(relative, "")
+	} else {
+		spellings(root, "")
+		spellings(relative, "m")
+	}
+
+	return keys, nil
+}
+
+func TransposeChord(symbol string, amount int, flats bool) (string, error) {
+	chord, err := ParseChord(symbol)
+	if err != nil {
+		return "", err
+	}
+
+	return chord.Transpose(amount).Format(flats), nil
+}
+
+// TransposeText transposes every inline chord written as [G] in the text.
+// Bracketed annotations that are not chords, such as [x2], are left untouched.
+func TransposeText(text string, amount int, flats bool) string {
+	return inlineChordPattern.ReplaceAllStringFunc(text, func(inline string) string {
+		symbol := inline[1 : len(inline)-1]
+
+		transposed, err := TransposeChord(symbol, amount, flats)
+		if err != nil {
+			return inline
+		}
+
+		return "[" + transposed + "]"
+	})
+}
+
+func TransposeChordSheet(chordsheet datatypes.JSON, amount int, flats bool) (datatypes.JSON, error) {
+	return mapChordSheet(chordsheet, func(text string) (string, error) {
+		return TransposeText(text, amount, flats), nil
+	})
+}
@@ -0,0 +1,249 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+const ChordProExtension = ".cho"
+
+type chordProSection struct {
+	kind  string
+	label string
+	lines []string
+}
+
+// chordProEnvironments maps the ChordPro section environments
+// to the chord sheet tag they are stored under.
+var chordProEnvironments = map[string]string{
+	"start_of_verse":  "Verse",
+	"sov":             "Verse",
+	"start_of_chorus": "Chorus",
+	"soc":             "Chorus",
+	"start_of_bridge": "Bridge",
+	"sob":             "Bridge",
+}
+
+var chordProEnvironmentEnds = map[string]bool{
+	"end_of_verse":  true,
+	"eov":           true,
+	"end_of_chorus": true,
+	"eoc":           true,
+	"end_of_bridge": true,
+	"eob":           true,
+}
+
+func parseDirective(line string) (string, string) {
+	content := strings.TrimSpace(line[1 : len(line)-1])
+	name, value, _ := strings.Cut(content, ":")
+
+	return strings.ToLower(strings.TrimSpace(name)), strings.TrimSpace(value)
+}
+
+func (section *chordProSection) text() string {
+	end := len(section.lines)
+	for end > 0 && strings.TrimSpace(section.lines[end-1]) == "" {
+		end--
+	}
+
+	return strings.Join(section.lines[:end], "\n")
+}
+
+// numberedTag returns the tag of the n-th section of the kind. Chord sheets only number
+// sections up to a limit, the sections past it share the tag of the last numbered one.
+func numberedTag(kind string, n int) string {
+	for ; n > 0; n-- {
+		if tag := fmt.Sprintf("%s %d", kind, n); isValidTag(tag) {
+			return tag
+		}
+	}
+
+	return kind
+}
+
+// nameSections gives every section its chord sheet tag. Sections with a
+// valid label keep it, the others are numbered when their kind occurs more than once.
+// A repeated section is only kept the first time it is played and sections past the
+// last numbered tag of their kind are appended to the section with that tag.
+func nameSections(sections []*chordProSection) ([]chordSheetSection, error) {
+	kindCount := make(map[string]int)
+
+	for _, section := range sections {
+		if !isValidTag(section.label) {
+			kindCount[section.kind]++
+		}
+	}
+
+	kindIndex := make(map[string]int)
+	tagIndex := make(map[string]int, len(sections))
+	texts := make(map[string]string, len(sections))
+	chordsheet := make([]chordSheetSection, 0, len(sections))
+
+	for _, section := range sections {
+		tag := section.label
+		capped := false
+
+		if !isValidTag(tag) {
+			tag = section.kind
+			if kindCount[section.kind] > 1 {
+				kindIndex[section.kind]++
+				tag = numberedTag(section.kind, kindIndex[section.kind])
+				capped = tag != fmt.Sprintf("%s %d", section.kind, kindIndex[section.kind])
+			}
+		}
+
+		text := section.text()
+		if existing, exists := texts[tag]; exists {
+			switch {
+			case existing == text:
+			case capped:
+				texts[tag] = existing + "\n\n" + text
+				chordsheet[tagIndex[tag]].Text = texts[tag]
+			default:
+				return nil, fmt.Errorf("section %s occurs more than once", tag)
+			}
+
+			continue
+		}
+
+		texts[tag] = text
+		tagIndex[tag] = len(chordsheet)
+		chordsheet = append(chordsheet, chordSheetSection{Tag: tag, Text: text})
+	}
+
+	return chordsheet, nil
+}
+
+// ParseChordPro maps a ChordPro document onto the fields of a song.
+// Lyrics outside of an environment are stored as verses, separated by blank lines.
+func ParseChordPro(source string) (*domain.Song, error) {
+	song := &domain.Song{}
+	sections := make([]*chordProSection, 0)
+
+	var current *chordProSection
+
+	inEnvironment := false
+
+	for _, line := range strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(trimmed, "#"):
+			continue
+		case strings.HasPrefix(trimmed, "{") && strings.HasSuffix(trimmed, "}"):
+			name, value := parseDirective(trimmed)
+
+			switch {
+			case name == "title" || name == "t":
+				song.Title = value
+			case name == "subtitle" || name == "st":
+				song.Subtitle = value
+			case name == "key":
+				song.Key = value
+			case name == "tempo":
+				tempo, err := strconv.ParseUint(value, 10, 32)
+				if err != nil {
+					return nil, fmt.Errorf("%s is not a valid tempo", value)
+				}
+
+				song.Bpm = uint(tempo)
+			case name == "ccli":
+				song.CCLINumber = value
+			case name == "copyright":
+				song.Copyright = value
+			case chordProEnvironments[name] != "":
+				current = &chordProSection{kind: chordProEnvironments[name], label: value}
+				sections = append(sections, current)
+				inEnvironment = true
+			case chordProEnvironmentEnds[name]:
+				current = nil
+				inEnvironment = false
+			case (name == "comment" || name == "c") && isValidTag(value) && !inEnvironment:
+				current = &chordProSection{kind: "Verse", label: value}
+				sections = append(sections, current)
+			}
+		case trimmed == "" && !inEnvironment:
+			if current != nil && len(current.lines) > 0 {
+				current = nil
+			}
+		default:
+			if current == nil {
+				current = &chordProSection{kind: "Verse"}
+				sections = append(sections, current)
+			}
+
+			current.lines = append(current.lines, strings.TrimRight(line, " \t"))
+		}
+	}
+
+	if song.Title == "" {
+		return nil, fmt.Errorf("chordpro is missing a title")
+	}
+
+	chordsheet, err := nameSections(sections)
+	if err != nil {
+		return nil, err
+	}
+
+	song.ChordSheet, err = writeChordSheet(chordsheet, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return song, nil
+}
+
+func chordProEnvironment(tag string) string {
+	switch {
+	case strings.HasPrefix(tag, "Chorus"):
+		return "chorus"
+	case strings.HasPrefix(tag, "Bridge"):
+		return "bridge"
+	default:
+		return "verse"
+	}
+}
+
+// WriteChordPro renders a song as a ChordPro document,
+// sections are written in the order of the chord sheet.
+func WriteChordPro(song *domain.Song) (string, error) {
+	chordsheet, err := readChordSheet(song.ChordSheet)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "{title: %s}\n", song.Title)
+
+	if song.Subtitle != "" {
+		fmt.Fprintf(&builder, "{subtitle: %s}\n", song.Subtitle)
+	}
+
+	fmt.Fprintf(&builder, "{key: %s}\n", song.Key)
+
+	if song.Bpm > 0 {
+		fmt.Fprintf(&builder, "{tempo: %d}\n", song.Bpm)
+	}
+
+	if song.CCLINumber != "" {
+		fmt.Fprintf(&builder, "{ccli: %s}\n", song.CCLINumber)
+	}
+
+	if song.Copyright != "" {
+		fmt.Fprintf(&builder, "{copyright: %s}\n", song.Copyright)
+	}
+
+	for _, section := range chordsheet {
+		environment := chordProEnvironment(section.Tag)
+
+		fmt.Fprintf(&builder, "\n{start_of_%s: %s}\n", environment, section.Tag)
+		builder.WriteString(section.Text)
+		fmt.Fprintf(&builder, "\n{end_of_%s}\n", environment)
+	}
+
+	return builder.String(), nil
+}
@@ -1,9 +1,15 @@
 package util
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"gorm.io/datatypes"
 )
 
@@ -14,6 +20,14 @@ var validTags = []string{
 	"Pre-Chorus", "Bridge", "Tag", "Intro", "Outro", "Intermezzo",
 }
 
+// chordSheetSection is a section of a chord sheet as it is stored. A chord
+// sheet is either a list of sections in play order or an object of tags
+// to section texts, in which case the sections are ordered by SortedTags.
+type chordSheetSection struct {
+	Tag  string `json:"tag"`
+	Text string `json:"text"`
+}
+
 func isValidTag(tag string) bool {
 	for _, validTag := range validTags {
 		if validTag == tag {
@@ -24,19 +38,242 @@ func isValidTag(tag string) bool {
 	return false
 }
 
-func ValidateChordSheet(chordsheet datatypes.JSON) error {
-	csSchema := map[string]string{}
+func isOrderedChordSheet(chordsheet datatypes.JSON) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(chordsheet), []byte("["))
+}
+
+func readChordSheet(chordsheet datatypes.JSON) ([]chordSheetSection, error) {
+	if isOrderedChordSheet(chordsheet) {
+		sections := make([]chordSheetSection, 0)
+
+		if err := json.Unmarshal(chordsheet, &sections); err != nil {
+			return nil, fmt.Errorf("could not parse chordsheet: %s", err.Error())
+		}
+
+		seen := make(map[string]bool, len(sections))
+
+		for _, section := range sections {
+			if seen[section.Tag] {
+				return nil, fmt.Errorf("section %s occurs more than once", section.Tag)
+			}
+
+			seen[section.Tag] = true
+		}
+
+		return sections, nil
+	}
 
-	err := json.Unmarshal([]byte(chordsheet.String()), &csSchema)
+	tagged := map[string]string{}
+
+	if err := json.Unmarshal([]byte(chordsheet.String()), &tagged); err != nil {
+		return nil, fmt.Errorf("could not parse chordsheet: %s", err.Error())
+	}
+
+	sections := make([]chordSheetSection, 0, len(tagged))
+
+	for _, tag := range SortedTags(tagged) {
+		sections = append(sections, chordSheetSection{Tag: tag, Text: tagged[tag]})
+	}
+
+	return sections, nil
+}
+
+func writeChordSheet(sections []chordSheetSection, ordered bool) (datatypes.JSON, error) {
+	var value any = sections
+
+	if !ordered {
+		tagged := make(map[string]string, len(sections))
+		for _, section := range sections {
+			tagged[section.Tag] = section.Text
+		}
+
+		value = tagged
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("could not write chordsheet: %s", err.Error())
+	}
+
+	return datatypes.JSON(encoded), nil
+}
+
+// mapChordSheet replaces the text of every section of the chord sheet,
+// keeping the form the chord sheet was stored in.
+func mapChordSheet(chordsheet datatypes.JSON, mapper func(text string) (string, error)) (datatypes.JSON, error) {
+	sections, err := readChordSheet(chordsheet)
 	if err != nil {
-		return fmt.Errorf("could not parse chordsheet: %s", err.Error())
+		return nil, err
 	}
 
-	for key := range csSchema {
-		if !isValidTag(key) {
-			return fmt.Errorf("%s is not a valid tag", key)
+	for idx := range sections {
+		sections[idx].Text, err = mapper(sections[idx].Text)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return writeChordSheet(sections, isOrderedChordSheet(chordsheet))
+}
+
+// annotationPattern matches the bracketed annotations that are kept as text: no chord [N.C.],
+// repeats such as [x2] and free comments marked with an asterisk, such as [*softly].
+var annotationPattern = regexp.MustCompile(`^(N\.C\.|x[0-9]+|\*.*)$`)
+
+func isAnnotation(symbol string) bool {
+	return annotationPattern.MatchString(symbol)
+}
+
+// checkChordSheetLine reports brackets in the line that are empty, nested or never closed
+// and brackets that hold neither a chord nor an annotation.
+func checkChordSheetLine(line string) error {
+	start := -1
+
+	for idx, char := range line {
+		switch char {
+		case '[':
+			if start >= 0 {
+				return fmt.Errorf("nested bracket in line %q", line)
+			}
+
+			start = idx + 1
+
+			if strings.HasPrefix(line[start:], "]") {
+				return fmt.Errorf("empty brackets in line %q", line)
+			}
+		case ']':
+			if start < 0 {
+				return fmt.Errorf("unopened bracket in line %q", line)
+			}
+
+			symbol := strings.TrimSpace(line[start:idx])
+			if _, err := ParseChord(symbol); err != nil && !isNashvilleChord(symbol) && !isAnnotation(symbol) {
+				return fmt.Errorf("%s is not a valid chord in line %q", symbol, line)
+			}
+
+			start = -1
 		}
 	}
 
+	if start >= 0 {
+		return fmt.Errorf("unclosed bracket in line %q", line)
+	}
+
 	return nil
 }
+
+// parseChordSheetLine splits the inline chords from the lyrics of the line. Annotations,
+// such as [x2] or [N.C.], and brackets that do not hold a chord stay in the lyrics.
+func parseChordSheetLine(line string) domain.ChordSheetLine {
+	var lyrics strings.Builder
+
+	chords := make([]domain.ChordPosition, 0)
+	offset := 0
+	rest := line
+
+	for {
+		start := strings.IndexByte(rest, '[')
+		if start < 0 {
+			break
+		}
+
+		end := strings.IndexByte(rest[start:], ']')
+		if end < 0 {
+			break
+		}
+
+		symbol := strings.TrimSpace(rest[start+1 : start+end])
+		if _, err := ParseChord(symbol); err != nil && !isNashvilleChord(symbol) {
+			lyrics.WriteString(rest[:start+end+1])
+			offset += utf8.RuneCountInString(rest[:start+end+1])
+			rest = rest[start+end+1:]
+
+			continue
+		}
+
+		lyrics.WriteString(rest[:start])
+		offset += utf8.RuneCountInString(rest[:start])
+
+		chords = append(chords, domain.ChordPosition{Offset: offset, Symbol: symbol})
+		rest = rest[start+end+1:]
+	}
+
+	lyrics.WriteString(rest)
+
+	return domain.ChordSheetLine{Lyrics: lyrics.String(), Chords: chords}
+}
+
+func splitLines(text string) []string {
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
+
+// ParseChordSheet parses the stored chord sheet into its sections, lines and chords.
+// The brackets are not checked, see ValidateChordSheet.
+func ParseChordSheet(chordsheet datatypes.JSON) (*domain.ChordSheet, error) {
+	sections, err := readChordSheet(chordsheet)
+	if err != nil {
+		return nil, err
+	}
+
+	document := &domain.ChordSheet{Sections: make([]domain.ChordSheetSection, len(sections))}
+
+	for idx, section := range sections {
+		lines := splitLines(section.Text)
+		parsed := domain.ChordSheetSection{Tag: section.Tag, Lines: make([]domain.ChordSheetLine, len(lines))}
+
+		for lineIdx, line := range lines {
+			parsed.Lines[lineIdx] = parseChordSheetLine(line)
+		}
+
+		document.Sections[idx] = parsed
+	}
+
+	return document, nil
+}
+
+// ValidateChordSheet checks the tags of the chord sheet and that every bracket is closed
+// and holds either a chord or an annotation.
+func ValidateChordSheet(chordsheet datatypes.JSON) error {
+	sections, err := readChordSheet(chordsheet)
+	if err != nil {
+		return err
+	}
+
+	for _, section := range sections {
+		if !isValidTag(section.Tag) {
+			return fmt.Errorf("%s is not a valid tag", section.Tag)
+		}
+
+		for _, line := range splitLines(section.Text) {
+			if err := checkChordSheetLine(line); err != nil {
+				return fmt.Errorf("%s: %s", section.Tag, err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// SortedTags returns the tags of the chord sheet sections in the order
+// of the valid tags, followed by any unknown tags in alphabetical order.
+func SortedTags(sections map[string]string) []string {
+	tags := make([]string, 0, len(sections))
+
+	for _, tag := range validTags {
+		if _, exists := sections[tag]; exists {
+			tags = append(tags, tag)
+		}
+	}
+
+	extraTags := make([]string, 0)
+
+	for tag := range sections {
+		if !isValidTag(tag) {
+			extraTags = append(extraTags, tag)
+		}
+	}
+
+	sort.Strings(extraTags)
+
+	return append(tags, extraTags...)
+}
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+// NewSongCursor points a cursor at the song, storing the field it is sorted by.
+func NewSongCursor(song domain.Song, sort domain.SongSort) domain.SongCursor {
+	cursor := domain.SongCursor{ID: song.ID}
+
+	switch sort {
+	case domain.SongSortTitle:
+		cursor.Value = song.Title
+	case domain.SongSortBpm:
+		cursor.Value = strconv.FormatUint(uint64(song.Bpm), 10)
+	case domain.SongSortUpdatedAt:
+		cursor.Value = song.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case domain.SongSortLastPlayed:
+		if song.LastPlayed != nil {
+			cursor.Value = song.LastPlayed.UTC().Format(time.RFC3339Nano)
+		}
+	}
+
+	return cursor
+}
+
+// EncodeSongCursor turns the cursor into an opaque token for clients to pass back.
+func EncodeSongCursor(cursor domain.SongCursor) string {
+	raw, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeSongCursor(token string) (*domain.SongCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domain.NewBadRequestErr("invalid cursor")
+	}
+
+	var cursor domain.SongCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.ID <= 0 {
+		return nil, domain.NewBadRequestErr("invalid cursor")
+	}
+
+	return &cursor, nil
+}
@@ -0,0 +1,52 @@
+package util
+
+import (
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"gorm.io/datatypes"
+)
+
+// DiffChordSheets compares two chord sheets section by section. Sections are
+// listed in the order of the new chord sheet, followed by the removed sections.
+func DiffChordSheets(from, to datatypes.JSON) ([]domain.SectionDiff, error) {
+	fromSections, err := readChordSheet(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toSections, err := readChordSheet(to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromTexts := make(map[string]string, len(fromSections))
+	for _, section := range fromSections {
+		fromTexts[section.Tag] = section.Text
+	}
+
+	diffs := make([]domain.SectionDiff, 0, len(toSections))
+	seen := make(map[string]bool, len(toSections))
+
+	for _, section := range toSections {
+		seen[section.Tag] = true
+		fromText, exists := fromTexts[section.Tag]
+
+		switch {
+		case !exists:
+			diffs = append(diffs, domain.SectionDiff{Tag: section.Tag, Status: domain.DiffAdded, To: section.Text})
+		case fromText != section.Text:
+			diffs = append(diffs, domain.SectionDiff{
+				Tag: section.Tag, Status: domain.DiffChanged, From: fromText, To: section.Text,
+			})
+		default:
+			diffs = append(diffs, domain.SectionDiff{Tag: section.Tag, Status: domain.DiffUnchanged})
+		}
+	}
+
+	for _, section := range fromSections {
+		if !seen[section.Tag] {
+			diffs = append(diffs, domain.SectionDiff{Tag: section.Tag, Status: domain.DiffRemoved, From: section.Text})
+		}
+	}
+
+	return diffs, nil
+}
@@ -0,0 +1,241 @@
+package util
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+// lyricShingleSize is the number of consecutive words compared between lyrics.
+const lyricShingleSize = 3
+
+type duplicateCandidate struct {
+	song     domain.Song
+	title    []rune
+	shingles map[string]bool
+}
+
+// NormalizeTitle folds the title and drops bracketed additions, punctuation
+// and extra whitespace, so "Amazing Grace (My Chains)" becomes "amazing grace".
+func NormalizeTitle(title string) string {
+	var builder strings.Builder
+
+	depth := 0
+
+	for _, char := range FoldText(title) {
+		switch {
+		case char == '(' || char == '[':
+			depth++
+		case char == ')' || char == ']':
+			if depth > 0 {
+				depth--
+			}
+		case depth > 0:
+		case isWordRune(char):
+			builder.WriteRune(char)
+		default:
+			builder.WriteRune(' ')
+		}
+	}
+
+	return strings.Join(strings.Fields(builder.String()), " ")
+}
+
+func levenshtein(first, second []rune) int {
+	previous := make([]int, len(second)+1)
+	current := make([]int, len(second)+1)
+
+	for idx := range previous {
+		previous[idx] = idx
+	}
+
+	for i := 1; i <= len(first); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(second); j++ {
+			cost := 1
+			if first[i-1] == second[j-1] {
+				cost = 0
+			}
+
+			current[j] = previous[j-1] + cost
+
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(second)]
+}
+
+func titleSimilarity(first, second []rune) float64 {
+	longest := len(first)
+	if len(second) > longest {
+		longest = len(second)
+	}
+
+	if longest == 0 {
+		return 0
+	}
+
+	return 1 - float64(levenshtein(first, second))/float64(longest)
+}
+
+// TitleSimilarity compares the normalized titles by edit distance,
+// 1 means the titles are the same and 0 that they share nothing.
+func TitleSimilarity(first, second string) float64 {
+	return titleSimilarity([]rune(NormalizeTitle(first)), []rune(NormalizeTitle(second)))
+}
+
+func lyricShingles(song domain.Song) map[string]bool {
+	shingles := make(map[string]bool)
+
+	chordsheet, err := ParseChordSheet(song.ChordSheet)
+	if err != nil {
+		return shingles
+	}
+
+	words := make([]string, 0)
+
+	for _, section := range chordsheet.Sections {
+		for _, line := range section.Lines {
+			for _, term := range searchTerms(line.Lyrics) {
+				words = append(words, term.text)
+			}
+		}
+	}
+
+	for idx := 0; idx+lyricShingleSize <= len(words); idx++ {
+		shingles[strings.Join(words[idx:idx+lyricShingleSize], " ")] = true
+	}
+
+	return shingles
+}
+
+func lyricSimilarity(first, second map[string]bool) float64 {
+	if len(first) == 0 || len(second) == 0 {
+		return -1
+	}
+
+	shared := 0
+
+	for shingle := range first {
+		if second[shingle] {
+			shared++
+		}
+	}
+
+	return float64(shared) / float64(len(first)+len(second)-shared)
+}
+
+// duplicateScore weighs the title and lyric similarity of two songs,
+// songs without lyrics are compared by title alone. Matching lyrics count
+// on their own, while the same title with different lyrics is penalised.
+func duplicateScore(first, second *duplicateCandidate) float64 {
+	title := titleSimilarity(first.title, second.title)
+	lyric := lyricSimilarity(first.shingles, second.shingles)
+
+	switch {
+	case lyric < 0:
+		return title
+	case lyric >= title:
+		return lyric
+	default:
+		return (title + lyric) / 2
+	}
+}
+
+func findRoot(parents []int, idx int) int {
+	for parents[idx] != idx {
+		parents[idx] = parents[parents[idx]]
+		idx = parents[idx]
+	}
+
+	return idx
+}
+
+// FindDuplicates groups the songs that score at least the threshold against
+// another song of the group. Groups are sorted by their highest similarity.
+func FindDuplicates(songs []domain.Song, threshold float64) []domain.DuplicateGroup {
+	candidates := make([]duplicateCandidate, len(songs))
+	parents := make([]int, len(songs))
+	similarities := make([]float64, len(songs))
+
+	for idx, song := range songs {
+		candidates[idx] = duplicateCandidate{
+			song:     song,
+			title:    []rune(NormalizeTitle(song.Title)),
+			shingles: lyricShingles(song),
+		}
+		parents[idx] = idx
+	}
+
+	for i := range candidates {
+		for j := i + 1; j < len(candidates); j++ {
+			score := duplicateScore(&candidates[i], &candidates[j])
+			if score < threshold {
+				continue
+			}
+
+			first, second := findRoot(parents, i), findRoot(parents, j)
+			if first != second {
+				parents[second] = first
+			}
+
+			if score > similarities[first] {
+				similarities[first] = score
+			}
+
+			if similarities[second] > similarities[first] {
+				similarities[first] = similarities[second]
+			}
+		}
+	}
+
+	groups := make(map[int]*domain.DuplicateGroup)
+	roots := make([]int, 0)
+
+	for idx, candidate := range candidates {
+		root := findRoot(parents, idx)
+
+		group, exists := groups[root]
+		if !exists {
+			group = &domain.DuplicateGroup{Songs: make([]domain.Song, 0, 2)}
+			groups[root] = group
+			roots = append(roots, root)
+		}
+
+		group.Songs = append(group.Songs, candidate.song)
+	}
+
+	duplicates := make([]domain.DuplicateGroup, 0)
+
+	for _, root := range roots {
+		group := groups[root]
+		if len(group.Songs) < 2 {
+			continue
+		}
+
+		group.Similarity = similarities[root]
+
+		sort.Slice(group.Songs, func(i, j int) bool {
+			return group.Songs[i].ID < group.Songs[j].ID
+		})
+
+		duplicates = append(duplicates, *group)
+	}
+
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		return duplicates[i].Similarity > duplicates[j].Similarity
+	})
+
+	return duplicates
+}
@@ -0,0 +1,100 @@
+package util
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"gorm.io/datatypes"
+)
+
+// BarsPerLine is the number of bars a line of a chord sheet section is assumed
+// to take and BeatsPerBar the number of beats in a bar when estimating durations.
+const (
+	BarsPerLine = 2
+	BeatsPerBar = 4
+)
+
+// EstimateDuration estimates the duration in seconds of playing the sections of the
+// chord sheet in the order of the arrangement at the given bpm, as the number of bars
+// of the played sections times the length of a bar. Without a bpm or with an invalid
+// chord sheet or arrangement the duration cannot be estimated and 0 is returned.
+func EstimateDuration(arrangement, chordsheet datatypes.JSON, bpm uint) uint {
+	if bpm == 0 {
+		return 0
+	}
+
+	sections, err := ExpandArrangement(arrangement, chordsheet)
+	if err != nil {
+		return 0
+	}
+
+	var lines uint
+
+	for _, section := range sections {
+		for _, line := range strings.Split(section.Text, "\n") {
+			if strings.TrimSpace(line) != "" {
+				lines++
+			}
+		}
+	}
+
+	beats := lines * BarsPerLine * BeatsPerBar
+
+	return (beats*60 + bpm/2) / bpm
+}
+
+// SongDuration returns the duration of the song, estimated
+// from its chord sheet when it was not given explicitly.
+func SongDuration(song *domain.Song) uint {
+	if song.Duration > 0 {
+		return song.Duration
+	}
+
+	return EstimateDuration(nil, song.ChordSheet, song.Bpm)
+}
+
+// EntryDuration returns the duration override of the entry, otherwise the duration
+// of the song or an estimate of playing the song in the arrangement of the entry.
+// Items other than songs only last as long as their own duration.
+func EntryDuration(entry *domain.SetlistEntry, song *domain.Song) uint {
+	if entry.Duration > 0 || !entry.IsSong() {
+		return entry.Duration
+	}
+
+	if song.Duration > 0 {
+		return song.Duration
+	}
+
+	return EstimateDuration(entry.Arrangement, song.ChordSheet, song.Bpm)
+}
+
+// PlanSetlist plans the entries of a setlist back to back in rank order, entries
+// of which the song is missing from songs are planned without a duration.
+func PlanSetlist(setlistID int64, entries []domain.SetlistEntry, songs map[int64]domain.Song) domain.SetlistTiming {
+	ranked := make([]domain.SetlistEntry, len(entries))
+	copy(ranked, entries)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Rank < ranked[j].Rank
+	})
+
+	timing := domain.SetlistTiming{
+		SetlistID: setlistID,
+		Entries:   make([]domain.EntryTiming, len(ranked)),
+	}
+
+	for idx := range ranked {
+		entry := &ranked[idx]
+		song := songs[entry.SongID]
+		duration := EntryDuration(entry, &song)
+
+		timing.Entries[idx] = domain.EntryTiming{
+			EntryID:  entry.ID,
+			Start:    timing.RunTime,
+			Duration: duration,
+		}
+		timing.RunTime += duration
+	}
+
+	return timing
+}
@@ -0,0 +1,79 @@
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// ETag returns the entity tag of a version of a resource. Versions are compared
+// in milliseconds, the precision with which the database stores the update time.
+func ETag(resource string, id int64, updatedAt time.Time) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%d", resource, id, updatedAt.UnixMilli())))
+
+	return fmt.Sprintf("%q", hex.EncodeToString(sum[:12]))
+}
+
+// SetlistETag returns the entity tag of the setlist at the given time. The status is part of
+// the tag, since a published setlist locks when its deadline passes without being changed.
+func SetlistETag(setlist *domain.Setlist, now time.Time) string {
+	return ETag("setlist:"+string(setlist.StatusAt(now)), setlist.ID, setlist.UpdatedAt)
+}
+
+// etagListed reports whether the If-Match or If-None-Match header lists the tag or is a wildcard.
+// The weak comparison of If-None-Match ignores the weak prefix, the strong one of If-Match does not.
+func etagListed(header, etag string, weak bool) bool {
+	for _, listed := range strings.Split(header, ",") {
+		listed = strings.TrimSpace(listed)
+
+		if listed == "*" {
+			return true
+		}
+
+		if weak {
+			listed = strings.TrimPrefix(listed, "W/")
+		}
+
+		if listed == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NotModified sets the ETag header of the response and writes a 304 Not Modified
+// when the If-None-Match header lists the tag, in which case it returns true.
+func NotModified(ctx *gin.Context, etag string) bool {
+	ctx.Header("ETag", etag)
+
+	header := ctx.GetHeader("If-None-Match")
+	if header != "" && etagListed(header, etag, true) {
+		ctx.Status(http.StatusNotModified)
+
+		return true
+	}
+
+	return false
+}
+
+// CheckIfMatch returns an error when the request has no If-Match header or when the
+// header does not list the tag of the current version of the resource.
+func CheckIfMatch(ctx *gin.Context, etag string) error {
+	header := ctx.GetHeader("If-Match")
+	if header == "" {
+		return domain.NewPreconditionRequiredErr("If-Match header is required to change this resource")
+	}
+
+	if !etagListed(header, etag, false) {
+		return domain.NewPreconditionFailedErr("resource was changed by someone else, fetch it again before changing it")
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package util
+
+import (
+	"regexp"
+
+	"gorm.io/datatypes"
+)
+
+// nashvilleDegrees holds the scale degree of every interval above the tonic.
+// Minor keys are numbered from their own tonic, so in Am a C chord is a b3.
+var nashvilleDegrees = [semitones]string{"1", "b2", "2", "b3", "3", "4", "#4", "5", "b6", "6", "b7", "7"}
+
+var nashvillePattern = regexp.MustCompile(`^([#b]?[1-7])([^/]*)(?:/([#b]?[1-7]))?$`)
+
+// isNashvilleChord reports whether the symbol is a chord written as a scale degree, such as "b3/b7".
+func isNashvilleChord(symbol string) bool {
+	match := nashvillePattern.FindStringSubmatch(symbol)
+
+	return match != nil && qualityPattern.MatchString(match[2])
+}
+
+func (chord Chord) Nashville(tonic int) string {
+	symbol := nashvilleDegrees[shift(chord.Root, -tonic)] + chord.Quality
+
+	if chord.Bass >= 0 {
+		symbol += "/" + nashvilleDegrees[shift(chord.Bass, -tonic)]
+	}
+
+	return symbol
+}
+
+func NashvilleChord(symbol, key string) (string, error) {
+	tonic, _, err := parseKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	chord, err := ParseChord(symbol)
+	if err != nil {
+		return "", err
+	}
+
+	return chord.Nashville(tonic), nil
+}
+
+// NashvilleText replaces every inline chord in the text by its scale degree
+// relative to the key. Bracketed annotations that are not chords are left untouched.
+func NashvilleText(text, key string) (string, error) {
+	tonic, _, err := parseKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	return inlineChordPattern.ReplaceAllStringFunc(text, func(inline string) string {
+		chord, err := ParseChord(inline[1 : len(inline)-1])
+		if err != nil {
+			return inline
+		}
+
+		return "[" + chord.Nashville(tonic) + "]"
+	}), nil
+}
+
+func NashvilleChordSheet(chordsheet datatypes.JSON, key string) (datatypes.JSON, error) {
+	return mapChordSheet(chordsheet, func(text string) (string, error) {
+		return NashvilleText(text, key)
+	})
+}
@@ -0,0 +1,206 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+type PDFFont int
+
+const (
+	PDFFontRegular PDFFont = iota
+	PDFFontBold
+	PDFFontMono
+	PDFFontMonoBold
+)
+
+const (
+	pdfPageWidth  = 595.0
+	pdfPageHeight = 842.0
+	pdfMargin     = 50.0
+	pdfLeading    = 1.25
+)
+
+// pdfFonts are the standard Type 1 fonts every PDF reader provides,
+// so no font program has to be embedded in the document.
+var pdfFonts = [...]string{"Helvetica", "Helvetica-Bold", "Courier", "Courier-Bold"}
+
+// pdfCharWidths is the average glyph width of a font relative to its size,
+// used to wrap text without shipping the font metrics.
+var pdfCharWidths = [...]float64{0.52, 0.56, 0.6, 0.6}
+
+var winAnsiRunes = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87,
+	'ˆ': 0x88, '‰': 0x89, 'Š': 0x8A, '‹': 0x8B, 'Œ': 0x8C, 'Ž': 0x8E,
+	'‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97,
+	'˜': 0x98, '™': 0x99, 'š': 0x9A, '›': 0x9B, 'œ': 0x9C, 'ž': 0x9E, 'Ÿ': 0x9F,
+}
+
+// PDF is a minimal writer for text-only documents on A4 pages. Lines are
+// written top to bottom and a new page is started when the current one is full.
+type PDF struct {
+	pages []*bytes.Buffer
+	y     float64
+}
+
+func NewPDF() *PDF {
+	return &PDF{}
+}
+
+func (pdf *PDF) AddPage() {
+	pdf.pages = append(pdf.pages, &bytes.Buffer{})
+	pdf.y = pdfPageHeight - pdfMargin
+}
+
+func (pdf *PDF) current() *bytes.Buffer {
+	if len(pdf.pages) == 0 {
+		pdf.AddPage()
+	}
+
+	return pdf.pages[len(pdf.pages)-1]
+}
+
+// Ensure starts a new page when less than height points are left on the current one.
+func (pdf *PDF) Ensure(height float64) {
+	pdf.current()
+
+	if pdf.y-height < pdfMargin {
+		pdf.AddPage()
+	}
+}
+
+func (pdf *PDF) Space(height float64) {
+	pdf.current()
+	pdf.y -= height
+}
+
+func encodePDFText(text string) string {
+	var builder strings.Builder
+
+	for _, char := range text {
+		var encoded byte
+
+		switch {
+		case char < 0x80 || (char >= 0xA0 && char <= 0xFF):
+			encoded = byte(char)
+		case winAnsiRunes[char] != 0:
+			encoded = winAnsiRunes[char]
+		default:
+			encoded = '?'
+		}
+
+		if encoded == '(' || encoded == ')' || encoded == '\\' {
+			builder.WriteByte('\\')
+		}
+
+		builder.WriteByte(encoded)
+	}
+
+	return builder.String()
+}
+
+func wrapText(text string, width int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	lines := make([]string, 0)
+	line := words[0]
+
+	for _, word := range words[1:] {
+		if len([]rune(line))+1+len([]rune(word)) > width {
+			lines = append(lines, line)
+			line = word
+
+			continue
+		}
+
+		line += " " + word
+	}
+
+	return append(lines, line)
+}
+
+func (pdf *PDF) writeLine(font PDFFont, size float64, text string) {
+	height := size * pdfLeading
+
+	pdf.Ensure(height)
+	pdf.y -= size
+
+	fmt.Fprintf(pdf.current(), "BT /F%d %.1f Tf %.1f %.1f Td (%s) Tj ET\n",
+		font+1, size, pdfMargin, pdf.y, encodePDFText(text))
+
+	pdf.y -= height - size
+}
+
+// Write adds text in the given font and size at the cursor. Proportional
+// text is wrapped at the page margins, monospaced text is written as is
+// so that chords stay aligned with the lyrics below them.
+func (pdf *PDF) Write(font PDFFont, size float64, text string) {
+	if font == PDFFontMono || font == PDFFontMonoBold {
+		pdf.writeLine(font, size, text)
+
+		return
+	}
+
+	width := int((pdfPageWidth - 2*pdfMargin) / (size * pdfCharWidths[font]))
+
+	for _, line := range wrapText(text, width) {
+		pdf.writeLine(font, size, line)
+	}
+}
+
+func (pdf *PDF) Bytes() []byte {
+	pdf.current()
+
+	var output bytes.Buffer
+
+	offsets := make([]int, 0)
+	writeObject := func(body string) {
+		offsets = append(offsets, output.Len())
+		fmt.Fprintf(&output, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	fontCount := len(pdfFonts)
+	firstPage := 3 + fontCount
+
+	output.WriteString("%PDF-1.4\n")
+
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+
+	kids := make([]string, len(pdf.pages))
+	for idx := range pdf.pages {
+		kids[idx] = fmt.Sprintf("%d 0 R", firstPage+2*idx)
+	}
+
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pdf.pages)))
+
+	fonts := make([]string, fontCount)
+
+	for idx, font := range pdfFonts {
+		writeObject(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", font))
+		fonts[idx] = fmt.Sprintf("/F%d %d 0 R", idx+1, 3+idx)
+	}
+
+	for idx, page := range pdf.pages {
+		writeObject(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, strings.Join(fonts, " "), firstPage+2*idx+1,
+		))
+		writeObject(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	xref := output.Len()
+
+	fmt.Fprintf(&output, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+
+	for _, offset := range offsets {
+		fmt.Fprintf(&output, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&output, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return output.Bytes()
+}
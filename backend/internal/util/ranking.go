@@ -0,0 +1,161 @@
+package util
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+// RankGap is the distance between the ranks of neighbouring entries
+// when the ranks of a setlist are spread out again.
+const RankGap int64 = 1024
+
+// SortEntries sorts the entries by setlist and rank, entries with the same rank keep the order of their ids.
+func SortEntries(entries []domain.SetlistEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].SetlistID != entries[j].SetlistID {
+			return entries[i].SetlistID < entries[j].SetlistID
+		}
+
+		if entries[i].Rank != entries[j].Rank {
+			return entries[i].Rank < entries[j].Rank
+		}
+
+		return entries[i].ID < entries[j].ID
+	})
+}
+
+// keptRanks marks the longest run of entries, in their new order,
+// whose ranks already increase and can therefore stay as they are. Ranks below one are never kept.
+func keptRanks(entries []domain.SetlistEntry) []bool {
+	lengths := make([]int, len(entries))
+	previous := make([]int, len(entries))
+	last := -1
+
+	for i := range entries {
+		previous[i] = -1
+
+		if entries[i].Rank < 1 {
+			continue
+		}
+
+		lengths[i] = 1
+
+		for j := 0; j < i; j++ {
+			if lengths[j] > 0 && entries[j].Rank < entries[i].Rank && lengths[j]+1 > lengths[i] {
+				lengths[i], previous[i] = lengths[j]+1, j
+			}
+		}
+
+		if last < 0 || lengths[i] > lengths[last] {
+			last = i
+		}
+	}
+
+	kept := make([]bool, len(entries))
+	for idx := last; idx >= 0; idx = previous[idx] {
+		kept[idx] = true
+	}
+
+	return kept
+}
+
+// spreadRanks gives the entries between two kept entries ranks evenly spaced between lower and upper,
+// an upper of zero means there is no kept entry after them. It returns false when the gap is too small.
+func spreadRanks(entries []domain.SetlistEntry, lower, upper int64) bool {
+	count := int64(len(entries))
+	step := RankGap
+
+	if upper > 0 {
+		if lower == 0 && upper > RankGap*(count+1) {
+			lower = upper - RankGap*(count+1)
+		}
+
+		step = (upper - lower) / (count + 1)
+		if step == 0 {
+			return false
+		}
+	}
+
+	for idx := range entries {
+		entries[idx].Rank = lower + step*int64(idx+1)
+	}
+
+	return true
+}
+
+// RerankEntries puts the entries of a setlist in the order of the given ids and returns them with their new
+// ranks, together with the entries whose rank changed. The ranks of the longest run of entries that are already
+// in order are kept and the moved entries are placed in the gaps between them, only when a gap is too small
+// are the ranks of all entries spread out by RankGap.
+func RerankEntries(entries []domain.SetlistEntry, order []int64) ([]domain.SetlistEntry, []domain.SetlistEntry, error) {
+	if len(order) != len(entries) {
+		return nil, nil, fmt.Errorf("order must contain each of the %d entries of the setlist exactly once", len(entries))
+	}
+
+	byID := make(map[int64]domain.SetlistEntry, len(entries))
+	for _, entry := range entries {
+		byID[entry.ID] = entry
+	}
+
+	ordered := make([]domain.SetlistEntry, len(order))
+
+	for idx, id := range order {
+		entry, exists := byID[id]
+		if !exists {
+			return nil, nil, fmt.Errorf("entry %d is not part of the setlist or appears more than once", id)
+		}
+
+		delete(byID, id)
+
+		ordered[idx] = entry
+	}
+
+	kept := keptRanks(ordered)
+	reranked := make([]domain.SetlistEntry, len(ordered))
+	copy(reranked, ordered)
+
+	spread := true
+
+	for start := 0; start < len(reranked) && spread; {
+		if kept[start] {
+			start++
+
+			continue
+		}
+
+		end := start
+		for end < len(reranked) && !kept[end] {
+			end++
+		}
+
+		var lower, upper int64
+		if start > 0 {
+			lower = reranked[start-1].Rank
+		}
+
+		if end < len(reranked) {
+			upper = reranked[end].Rank
+		}
+
+		spread = spreadRanks(reranked[start:end], lower, upper)
+		start = end
+	}
+
+	if !spread {
+		for idx := range reranked {
+			reranked[idx].Rank = RankGap * int64(idx+1)
+		}
+	}
+
+	changed := make([]domain.SetlistEntry, 0)
+
+	for idx := range reranked {
+		if reranked[idx].Rank != ordered[idx].Rank {
+			changed = append(changed, reranked[idx])
+		}
+	}
+
+	return reranked, changed, nil
+}
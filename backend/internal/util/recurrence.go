@@ -0,0 +1,374 @@
+package util
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+const (
+	daysPerWeek     = 7
+	maxWeekOrdinal  = 5
+	maxMonthDay     = 31
+	untilDateFormat = "20060102"
+	untilTimeFormat = "20060102T150405Z"
+)
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+func parseIntList(value string, low, high int, allowNegative bool) ([]int, error) {
+	parts := strings.Split(value, ",")
+	parsed := make([]int, 0, len(parts))
+
+	for _, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a number", part)
+		}
+
+		magnitude := number
+		if allowNegative && number < 0 {
+			magnitude = -number
+		}
+
+		if magnitude < low || magnitude > high {
+			return nil, fmt.Errorf("%d must be between %d and %d", number, low, high)
+		}
+
+		parsed = append(parsed, number)
+	}
+
+	sort.Ints(parsed)
+
+	return parsed, nil
+}
+
+func parseRecurrenceDay(value string) (domain.RecurrenceDay, error) {
+	if len(value) < 2 {
+		return domain.RecurrenceDay{}, fmt.Errorf("%s is not a valid day", value)
+	}
+
+	weekday, exists := weekdays[value[len(value)-2:]]
+	if !exists {
+		return domain.RecurrenceDay{}, fmt.Errorf("%s is not a valid day", value)
+	}
+
+	day := domain.RecurrenceDay{Weekday: weekday}
+
+	if prefix := value[:len(value)-2]; prefix != "" {
+		ordinal, err := strconv.Atoi(prefix)
+		if err != nil || ordinal == 0 || ordinal < -maxWeekOrdinal || ordinal > maxWeekOrdinal {
+			return domain.RecurrenceDay{}, fmt.Errorf("%s is not a valid day", value)
+		}
+
+		day.Ordinal = ordinal
+	}
+
+	return day, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if until, err := time.Parse(untilTimeFormat, value); err == nil {
+		return until, nil
+	}
+
+	until, err := time.Parse(untilDateFormat, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("UNTIL must be formatted as %s or %s", untilDateFormat, untilTimeFormat)
+	}
+
+	return until.Add(24*time.Hour - time.Second), nil
+}
+
+func setRecurrencePart(recurrence *domain.Recurrence, name, value string) error {
+	var err error
+
+	switch name {
+	case "FREQ":
+		recurrence.Frequency = domain.RecurrenceFrequency(value)
+	case "INTERVAL":
+		recurrence.Interval, err = strconv.Atoi(value)
+		if err != nil || recurrence.Interval < 1 {
+			return fmt.Errorf("INTERVAL must be a positive number")
+		}
+	case "COUNT":
+		recurrence.Count, err = strconv.Atoi(value)
+		if err != nil || recurrence.Count < 1 {
+			return fmt.Errorf("COUNT must be a positive number")
+		}
+	case "UNTIL":
+		recurrence.Until, err = parseUntil(value)
+	case "BYDAY":
+		for _, part := range strings.Split(value, ",") {
+			day, dayErr := parseRecurrenceDay(part)
+			if dayErr != nil {
+				return dayErr
+			}
+
+			recurrence.ByDay = append(recurrence.ByDay, day)
+		}
+	case "BYMONTHDAY":
+		recurrence.ByMonthDay, err = parseIntList(value, 1, maxMonthDay, true)
+	case "BYHOUR":
+		recurrence.ByHour, err = parseIntList(value, 0, 23, false)
+	case "BYMINUTE":
+		recurrence.ByMinute, err = parseIntList(value, 0, 59, false)
+	default:
+		return fmt.Errorf("%s is not a supported rule part", name)
+	}
+
+	return err
+}
+
+// ParseRecurrence parses an RRULE such as "FREQ=WEEKLY;BYDAY=SU;BYHOUR=10;BYMINUTE=0"
+// or "FREQ=MONTHLY;BYDAY=-1FR", the "RRULE:" prefix is optional.
+func ParseRecurrence(rule string) (*domain.Recurrence, error) {
+	rule = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(rule)), "RRULE:")
+	recurrence := &domain.Recurrence{Interval: 1}
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(rule, ";") {
+		name, value, found := strings.Cut(part, "=")
+		if !found || value == "" {
+			return nil, fmt.Errorf("%s is not a valid rule part", part)
+		}
+
+		if seen[name] {
+			return nil, fmt.Errorf("%s is given more than once", name)
+		}
+
+		seen[name] = true
+
+		if err := setRecurrencePart(recurrence, name, value); err != nil {
+			return nil, err
+		}
+	}
+
+	switch recurrence.Frequency {
+	case domain.Daily, domain.Weekly, domain.Monthly:
+	case "":
+		return nil, fmt.Errorf("FREQ is required")
+	default:
+		return nil, fmt.Errorf("%s is not a supported frequency", recurrence.Frequency)
+	}
+
+	if recurrence.Count > 0 && !recurrence.Until.IsZero() {
+		return nil, fmt.Errorf("COUNT and UNTIL cannot both be given")
+	}
+
+	if recurrence.Frequency != domain.Monthly {
+		if len(recurrence.ByMonthDay) > 0 && recurrence.Frequency == domain.Weekly {
+			return nil, fmt.Errorf("BYMONTHDAY cannot be used with a WEEKLY rule")
+		}
+
+		for _, day := range recurrence.ByDay {
+			if day.Ordinal != 0 {
+				return nil, fmt.Errorf("BYDAY can only have ordinals in a MONTHLY rule")
+			}
+		}
+	}
+
+	return recurrence, nil
+}
+
+func date(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+func daysIn(month time.Time) int {
+	return date(month.Year(), month.Month()+1, 0, month.Location()).Day()
+}
+
+func matchesWeekday(byDay []domain.RecurrenceDay, day time.Time) bool {
+	if len(byDay) == 0 {
+		return true
+	}
+
+	for _, recurrenceDay := range byDay {
+		if recurrenceDay.Weekday == day.Weekday() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesMonthDay(byMonthDay []int, day time.Time) bool {
+	if len(byMonthDay) == 0 {
+		return true
+	}
+
+	for _, monthDay := range byMonthDay {
+		if monthDay < 0 {
+			monthDay += daysIn(day) + 1
+		}
+
+		if monthDay == day.Day() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// monthDays returns the days of the month that match the rule, by default the
+// day of the month of start which is skipped in months that are too short.
+func monthDays(recurrence *domain.Recurrence, month time.Time, start time.Time) []time.Time {
+	days := make([]time.Time, 0)
+	length := daysIn(month)
+
+	if len(recurrence.ByDay) == 0 && len(recurrence.ByMonthDay) == 0 {
+		if start.Day() <= length {
+			days = append(days, month.AddDate(0, 0, start.Day()-1))
+		}
+
+		return days
+	}
+
+	for dayOfMonth := 1; dayOfMonth <= length; dayOfMonth++ {
+		day := date(month.Year(), month.Month(), dayOfMonth, month.Location())
+
+		if !matchesMonthDay(recurrence.ByMonthDay, day) {
+			continue
+		}
+
+		if len(recurrence.ByDay) == 0 {
+			days = append(days, day)
+
+			continue
+		}
+
+		for _, byDay := range recurrence.ByDay {
+			if byDay.Weekday != day.Weekday() {
+				continue
+			}
+
+			fromStart := (dayOfMonth-1)/daysPerWeek + 1
+			fromEnd := -((length-dayOfMonth)/daysPerWeek + 1)
+
+			if byDay.Ordinal == 0 || byDay.Ordinal == fromStart || byDay.Ordinal == fromEnd {
+				days = append(days, day)
+
+				break
+			}
+		}
+	}
+
+	return days
+}
+
+// periodDays returns the first day of the nth period of the rule after start
+// and the days in that period that match the rule in chronological order.
+func periodDays(recurrence *domain.Recurrence, start time.Time, period int) (time.Time, []time.Time) {
+	loc := start.Location()
+	step := period * recurrence.Interval
+
+	switch recurrence.Frequency {
+	case domain.Weekly:
+		offset := (int(start.Weekday()) + daysPerWeek - 1) % daysPerWeek
+		week := date(start.Year(), start.Month(), start.Day()-offset+step*daysPerWeek, loc)
+		byDay := recurrence.ByDay
+
+		if len(byDay) == 0 {
+			byDay = []domain.RecurrenceDay{{Weekday: start.Weekday()}}
+		}
+
+		days := make([]time.Time, 0, len(byDay))
+
+		for _, day := range byDay {
+			dayOffset := (int(day.Weekday) + daysPerWeek - 1) % daysPerWeek
+			days = append(days, date(week.Year(), week.Month(), week.Day()+dayOffset, loc))
+		}
+
+		sort.Slice(days, func(i, j int) bool {
+			return days[i].Before(days[j])
+		})
+
+		return week, days
+	case domain.Monthly:
+		month := date(start.Year(), start.Month()+time.Month(step), 1, loc)
+
+		return month, monthDays(recurrence, month, start)
+	default:
+		day := date(start.Year(), start.Month(), start.Day()+step, loc)
+		if !matchesWeekday(recurrence.ByDay, day) || !matchesMonthDay(recurrence.ByMonthDay, day) {
+			return day, nil
+		}
+
+		return day, []time.Time{day}
+	}
+}
+
+// Occurrences returns at most limit occurrences of the rule between from and to inclusive.
+// The rule starts at start and is followed in the wall clock of loc, so an occurrence at
+// 10:00 stays at 10:00 across daylight saving changes. The hours and minutes of the
+// occurrences default to those of start in loc.
+func Occurrences(
+	recurrence *domain.Recurrence,
+	start time.Time,
+	loc *time.Location,
+	from time.Time,
+	to time.Time,
+	limit int,
+) []time.Time {
+	start = start.In(loc)
+	hours, minutes := recurrence.ByHour, recurrence.ByMinute
+
+	if len(hours) == 0 {
+		hours = []int{start.Hour()}
+	}
+
+	if len(minutes) == 0 {
+		minutes = []int{start.Minute()}
+	}
+
+	occurrences := make([]time.Time, 0)
+	count := 0
+
+	for period := 0; ; period++ {
+		periodStart, days := periodDays(recurrence, start, period)
+		if periodStart.After(to) {
+			return occurrences
+		}
+
+		for _, day := range days {
+			for _, hour := range hours {
+				for _, minute := range minutes {
+					occurrence := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+					if occurrence.Before(start) {
+						continue
+					}
+
+					count++
+
+					if (recurrence.Count > 0 && count > recurrence.Count) ||
+						(!recurrence.Until.IsZero() && occurrence.After(recurrence.Until)) ||
+						occurrence.After(to) {
+						return occurrences
+					}
+
+					if occurrence.Before(from) {
+						continue
+					}
+
+					occurrences = append(occurrences, occurrence)
+					if len(occurrences) >= limit {
+						return occurrences
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,370 @@
+package util
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	titleWeight    = 3.0
+	subtitleWeight = 2.0
+	lyricWeight    = 1.0
+)
+
+type searchTerm struct {
+	text  string
+	start int
+	end   int
+}
+
+type searchLine struct {
+	text  string
+	terms []searchTerm
+}
+
+type searchField struct {
+	section string
+	weight  float64
+	lines   []searchLine
+}
+
+type searchDocument struct {
+	song   domain.Song
+	fields []searchField
+}
+
+// SearchIndex finds songs by the words in their title, subtitle and lyrics.
+// Words are compared case and accent insensitive, the last word of a query
+// also matches as a prefix so results show up while typing.
+// It is safe for concurrent use.
+type SearchIndex struct {
+	mu        sync.RWMutex
+	documents []searchDocument
+}
+
+func foldRune(char rune) rune {
+	decomposed := norm.NFD.String(string(char))
+
+	for _, base := range decomposed {
+		return unicode.ToLower(base)
+	}
+
+	return char
+}
+
+// FoldText lowercases the text and strips accents, every character
+// is folded into exactly one character so offsets are preserved.
+func FoldText(text string) string {
+	var builder strings.Builder
+
+	for _, char := range text {
+		builder.WriteRune(foldRune(char))
+	}
+
+	return builder.String()
+}
+
+func isWordRune(char rune) bool {
+	return unicode.IsLetter(char) || unicode.IsDigit(char)
+}
+
+func searchTerms(text string) []searchTerm {
+	terms := make([]searchTerm, 0)
+	folded := []rune(FoldText(text))
+	start := -1
+
+	for idx := 0; idx <= len(folded); idx++ {
+		if idx < len(folded) && isWordRune(folded[idx]) {
+			if start < 0 {
+				start = idx
+			}
+
+			continue
+		}
+
+		if start >= 0 {
+			terms = append(terms, searchTerm{text: string(folded[start:idx]), start: start, end: idx})
+			start = -1
+		}
+	}
+
+	return terms
+}
+
+func newSearchField(section string, weight float64, lines ...string) searchField {
+	field := searchField{section: section, weight: weight, lines: make([]searchLine, 0, len(lines))}
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		field.lines = append(field.lines, searchLine{text: line, terms: searchTerms(line)})
+	}
+
+	return field
+}
+
+func newSearchDocument(song domain.Song) searchDocument {
+	document := searchDocument{
+		song: song,
+		fields: []searchField{
+			newSearchField("", titleWeight, song.Title),
+			newSearchField("", subtitleWeight, song.Subtitle),
+		},
+	}
+
+	if chordsheet, err := ParseChordSheet(song.ChordSheet); err == nil {
+		for _, section := range chordsheet.Sections {
+			lyrics := make([]string, len(section.Lines))
+			for idx, line := range section.Lines {
+				lyrics[idx] = line.Lyrics
+			}
+
+			document.fields = append(document.fields, newSearchField(section.Tag, lyricWeight, lyrics...))
+		}
+	}
+
+	return document
+}
+
+// NewSearchIndex indexes the title, subtitle and the lyrics without chords of the songs.
+func NewSearchIndex(songs []domain.Song) *SearchIndex {
+	index := &SearchIndex{documents: make([]searchDocument, 0, len(songs))}
+
+	for _, song := range songs {
+		index.documents = append(index.documents, newSearchDocument(song))
+	}
+
+	return index
+}
+
+// Put indexes the song, replacing the song with the same id if it was indexed before.
+func (index *SearchIndex) Put(song domain.Song) {
+	document := newSearchDocument(song)
+
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	for idx := range index.documents {
+		if index.documents[idx].song.ID == song.ID {
+			index.documents[idx] = document
+
+			return
+		}
+	}
+
+	index.documents = append(index.documents, document)
+}
+
+// Remove drops the songs from the index.
+func (index *SearchIndex) Remove(sids ...int64) {
+	removed := make(map[int64]bool, len(sids))
+	for _, sid := range sids {
+		removed[sid] = true
+	}
+
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	documents := index.documents[:0]
+
+	for _, document := range index.documents {
+		if !removed[document.song.ID] {
+			documents = append(documents, document)
+		}
+	}
+
+	index.documents = documents
+}
+
+func matchesTerm(term, query string, prefix bool) bool {
+	if prefix {
+		return strings.HasPrefix(term, query)
+	}
+
+	return term == query
+}
+
+// matches counts how often every word of the query occurs in the line
+// and reports whether the line contains the whole query as a phrase.
+func (line searchLine) matches(queries []string) ([]int, bool) {
+	matched := make([]int, len(queries))
+	phrase := false
+
+	for idx, term := range line.terms {
+		for queryIdx, query := range queries {
+			if matchesTerm(term.text, query, queryIdx == len(queries)-1) {
+				matched[queryIdx]++
+			}
+		}
+
+		if len(queries) > 1 && idx+len(queries) <= len(line.terms) {
+			inPhrase := true
+
+			for queryIdx, query := range queries {
+				if !matchesTerm(line.terms[idx+queryIdx].text, query, queryIdx == len(queries)-1) {
+					inPhrase = false
+
+					break
+				}
+			}
+
+			phrase = phrase || inPhrase
+		}
+	}
+
+	return matched, phrase
+}
+
+func highlights(line searchLine, queries []string) []domain.TextRange {
+	ranges := make([]domain.TextRange, 0)
+
+	for _, term := range line.terms {
+		for queryIdx, query := range queries {
+			if matchesTerm(term.text, query, queryIdx == len(queries)-1) {
+				ranges = append(ranges, domain.TextRange{Start: term.start, End: term.end})
+
+				break
+			}
+		}
+	}
+
+	return ranges
+}
+
+type searchMatch struct {
+	document    *searchDocument
+	frequencies []float64
+	phrase      float64
+	snippet     *searchLine
+	section     string
+	coverage    int
+}
+
+func (document *searchDocument) match(queries []string) *searchMatch {
+	result := &searchMatch{document: document, frequencies: make([]float64, len(queries))}
+
+	for fieldIdx := range document.fields {
+		field := &document.fields[fieldIdx]
+
+		for lineIdx := range field.lines {
+			line := &field.lines[lineIdx]
+			matched, phrase := line.matches(queries)
+			coverage := 0
+
+			for queryIdx, count := range matched {
+				result.frequencies[queryIdx] += field.weight * float64(count)
+
+				if count > 0 {
+					coverage++
+				}
+			}
+
+			if phrase {
+				result.phrase += field.weight * float64(len(queries))
+			}
+
+			// Lyrics are preferred as snippet, the title is already shown with the song.
+			if coverage > result.coverage || (coverage > 0 && coverage == result.coverage && field.section != "" && result.section == "") {
+				result.snippet = line
+				result.section = field.section
+				result.coverage = coverage
+			}
+		}
+	}
+
+	return result
+}
+
+func (match *searchMatch) isComplete() bool {
+	for _, frequency := range match.frequencies {
+		if frequency == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Search ranks the songs containing every word of the query by how often
+// and where the words occur, weighting rare words higher and rewarding
+// songs containing the words as a phrase.
+func (index *SearchIndex) Search(query string, limit int) []domain.SongSearchResult {
+	terms := searchTerms(query)
+	queries := make([]string, len(terms))
+
+	for idx, term := range terms {
+		queries[idx] = term.text
+	}
+
+	if len(queries) == 0 {
+		return []domain.SongSearchResult{}
+	}
+
+	index.mu.RLock()
+	defer index.mu.RUnlock()
+
+	matches := make([]*searchMatch, 0)
+	documentFrequency := make([]int, len(queries))
+
+	for idx := range index.documents {
+		match := index.documents[idx].match(queries)
+
+		for queryIdx, frequency := range match.frequencies {
+			if frequency > 0 {
+				documentFrequency[queryIdx]++
+			}
+		}
+
+		if match.isComplete() {
+			matches = append(matches, match)
+		}
+	}
+
+	results := make([]domain.SongSearchResult, 0, len(matches))
+	total := float64(len(index.documents))
+
+	for _, match := range matches {
+		score := match.phrase
+
+		for queryIdx, frequency := range match.frequencies {
+			idf := math.Log(1 + total/float64(documentFrequency[queryIdx]))
+			score += idf * frequency / (frequency + 1)
+		}
+
+		result := domain.SongSearchResult{
+			Song:       match.document.song,
+			Score:      math.Round(score*1000) / 1000,
+			Section:    match.section,
+			Highlights: []domain.TextRange{},
+		}
+
+		if match.snippet != nil {
+			result.Snippet = match.snippet.text
+			result.Highlights = highlights(*match.snippet, queries)
+		}
+
+		results = append(results, result)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+
+		return results[i].Song.Title < results[j].Song.Title
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results
+}
@@ -0,0 +1,181 @@
+package util
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+// MaxKeyDistance is the largest key change in semitones that still flows,
+// MinTempoSwing is the smallest bpm change that is considered a swing.
+const (
+	MaxKeyDistance = 2
+	MinTempoSwing  = 30
+)
+
+// KeyDistance returns the smallest number of semitones between the two keys,
+// minor keys are compared by their relative major so "G" and "Em" are 0 apart.
+func KeyDistance(from, to string) (int, error) {
+	fromRoot, fromMinor, err := parseKey(from)
+	if err != nil {
+		return 0, err
+	}
+
+	toRoot, toMinor, err := parseKey(to)
+	if err != nil {
+		return 0, err
+	}
+
+	if fromMinor {
+		fromRoot = shift(fromRoot, 3)
+	}
+
+	if toMinor {
+		toRoot = shift(toRoot, 3)
+	}
+
+	distance := shift(toRoot, -fromRoot)
+	if distance > semitones/2 {
+		distance = semitones - distance
+	}
+
+	return distance, nil
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
+
+// suggestTranspose finds the transposition of the song key within the transpose
+// limits that is the closest to current and within MaxKeyDistance of previous.
+func suggestTranspose(previous, key string, current int16) (*domain.TransposeSuggestion, bool) {
+	var best *domain.TransposeSuggestion
+
+	bestDistance := 0
+
+	for transpose := TransposeMin; transpose <= TransposeMax; transpose++ {
+		candidate, err := TransposeKey(key, transpose)
+		if err != nil {
+			return nil, false
+		}
+
+		distance, err := KeyDistance(previous, candidate)
+		if err != nil || distance > MaxKeyDistance {
+			continue
+		}
+
+		if best != nil {
+			change, bestChange := abs(transpose-int(current)), abs(int(best.Transpose)-int(current))
+
+			if change > bestChange || (change == bestChange && distance >= bestDistance) {
+				continue
+			}
+		}
+
+		best = &domain.TransposeSuggestion{Transpose: int16(transpose), Key: candidate}
+		bestDistance = distance
+	}
+
+	return best, best != nil
+}
+
+// AnalyzeSetlist walks the song entries in rank order and reports key jumps, tempo swings
+// and repeated songs between them, other items in between are skipped. For every key
+// jump it suggests a transposition of the later entry relative to the entry before it.
+func AnalyzeSetlist(setlistID int64, entries []domain.SetlistEntry, songs map[int64]domain.Song) *domain.SetlistAnalysis {
+	ranked := make([]domain.SetlistEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsSong() {
+			ranked = append(ranked, entry)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Rank < ranked[j].Rank
+	})
+
+	analysis := &domain.SetlistAnalysis{
+		SetlistID:   setlistID,
+		Transitions: make([]domain.EntryTransition, 0),
+		Warnings:    make([]domain.SetlistWarning, 0),
+		Suggestions: make([]domain.TransposeSuggestion, 0),
+	}
+
+	keys := make([]string, len(ranked))
+	played := make(map[int64]int64)
+
+	for idx, entry := range ranked {
+		song := songs[entry.SongID]
+
+		if key, err := TransposeKey(song.Key, int(entry.Transpose)); err == nil {
+			keys[idx] = key
+		}
+
+		if first, exists := played[entry.SongID]; exists {
+			analysis.Warnings = append(analysis.Warnings, domain.SetlistWarning{
+				Type:        domain.RepeatedSongWarning,
+				FromEntryID: first,
+				ToEntryID:   entry.ID,
+				Message:     fmt.Sprintf("%s is already in the setlist", song.Title),
+			})
+		} else {
+			played[entry.SongID] = entry.ID
+		}
+
+		if idx == 0 {
+			continue
+		}
+
+		previous := ranked[idx-1]
+		previousSong := songs[previous.SongID]
+		transition := domain.EntryTransition{
+			FromEntryID: previous.ID,
+			ToEntryID:   entry.ID,
+			FromKey:     keys[idx-1],
+			ToKey:       keys[idx],
+		}
+
+		if transition.FromKey != "" && transition.ToKey != "" {
+			transition.KeyDistance, _ = KeyDistance(transition.FromKey, transition.ToKey)
+		}
+
+		if previousSong.Bpm > 0 && song.Bpm > 0 {
+			transition.BpmChange = int(song.Bpm) - int(previousSong.Bpm)
+		}
+
+		analysis.Transitions = append(analysis.Transitions, transition)
+
+		if transition.KeyDistance > MaxKeyDistance {
+			analysis.Warnings = append(analysis.Warnings, domain.SetlistWarning{
+				Type:        domain.KeyJumpWarning,
+				FromEntryID: previous.ID,
+				ToEntryID:   entry.ID,
+				Message: fmt.Sprintf(
+					"key jumps %d semitones from %s to %s", transition.KeyDistance, transition.FromKey, transition.ToKey,
+				),
+			})
+
+			if suggestion, ok := suggestTranspose(transition.FromKey, song.Key, entry.Transpose); ok {
+				suggestion.EntryID = entry.ID
+				analysis.Suggestions = append(analysis.Suggestions, *suggestion)
+			}
+		}
+
+		if abs(transition.BpmChange) >= MinTempoSwing {
+			analysis.Warnings = append(analysis.Warnings, domain.SetlistWarning{
+				Type:        domain.TempoSwingWarning,
+				FromEntryID: previous.ID,
+				ToEntryID:   entry.ID,
+				Message:     fmt.Sprintf("tempo changes from %d to %d bpm", previousSong.Bpm, song.Bpm),
+			})
+		}
+	}
+
+	return analysis
+}
@@ -0,0 +1,82 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+// scripturePattern matches a book followed by a chapter, optionally with verses and
+// ranges separated by commas, e.g. "John 3:16", "1 Cor 13:4-8" or "Psalm 23:1-3, 5".
+var scripturePattern = regexp.MustCompile(
+	`^(?:[1-3] ?)?\p{L}[\p{L}.]*(?: \p{L}[\p{L}.]*)* \d{1,3}(?::\d{1,3})?(?:-\d{1,3}(?::\d{1,3})?)?` +
+		`(?:, ?\d{1,3}(?::\d{1,3})?(?:-\d{1,3}(?::\d{1,3})?)?)*$`,
+)
+
+// NormalizeScriptureReference collapses the whitespace in the reference
+// and checks that it refers to a chapter or verses of a book.
+func NormalizeScriptureReference(reference string) (string, error) {
+	normalized := strings.Join(strings.Fields(reference), " ")
+
+	if !scripturePattern.MatchString(normalized) {
+		return "", fmt.Errorf("%q is not a valid scripture reference", reference)
+	}
+
+	return normalized, nil
+}
+
+// NormalizeSetlistItem defaults the type of the entry to a song and checks that the
+// entry only holds the fields of its type. Non-song items cannot refer to a song,
+// headers need a title, free-text items a title or content and scripture items
+// a valid reference. Whether a song item refers to an existing song is left to the caller.
+func NormalizeSetlistItem(entry *domain.SetlistEntry) error {
+	if entry.Type == "" {
+		entry.Type = domain.SongItem
+	}
+
+	if !entry.Type.IsValid() {
+		return fmt.Errorf("%s is not a valid item type", entry.Type)
+	}
+
+	entry.Title = strings.TrimSpace(entry.Title)
+	entry.Content = strings.TrimSpace(entry.Content)
+
+	if entry.Type != domain.ScriptureItem && entry.Reference != "" {
+		return fmt.Errorf("%s items cannot have a reference", entry.Type)
+	}
+
+	if entry.IsSong() {
+		return nil
+	}
+
+	arrangement, err := ParseArrangement(entry.Arrangement)
+	if err != nil {
+		return err
+	}
+
+	if entry.SongID != 0 || entry.Transpose != 0 || len(arrangement) > 0 || entry.Languages != "" {
+		return fmt.Errorf("%s items cannot have a song, transpose, arrangement or languages", entry.Type)
+	}
+
+	switch entry.Type {
+	case domain.HeaderItem:
+		if entry.Title == "" {
+			return fmt.Errorf("header items must have a title")
+		}
+	case domain.TextItem:
+		if entry.Title == "" && entry.Content == "" {
+			return fmt.Errorf("text items must have a title or content")
+		}
+	case domain.ScriptureItem:
+		reference, err := NormalizeScriptureReference(entry.Reference)
+		if err != nil {
+			return err
+		}
+
+		entry.Reference = reference
+	}
+
+	return nil
+}
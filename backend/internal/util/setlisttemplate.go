@@ -0,0 +1,69 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+)
+
+// SlotEntry returns the setlist entry a template slot becomes, a placeholder
+// becomes a text item titled with the name of the slot.
+func SlotEntry(slot *domain.TemplateSlot, setlistID int64) domain.SetlistEntry {
+	if slot.IsPlaceholder() {
+		return domain.SetlistEntry{
+			Type:      domain.TextItem,
+			SetlistID: setlistID,
+			Title:     slot.Name,
+			Notes:     slot.Notes,
+			Duration:  slot.Duration,
+			Rank:      slot.Rank,
+		}
+	}
+
+	return domain.SetlistEntry{
+		Type:      slot.Type,
+		SongID:    slot.SongID,
+		SetlistID: setlistID,
+		Title:     slot.Title,
+		Content:   slot.Content,
+		Reference: slot.Reference,
+		Transpose: slot.Transpose,
+		Notes:     slot.Notes,
+		Duration:  slot.Duration,
+		Rank:      slot.Rank,
+	}
+}
+
+// NormalizeTemplateSlot checks the slot the way NormalizeSetlistItem checks an entry,
+// placeholders only need a name. Whether a song slot refers to an existing song
+// is left to the caller.
+func NormalizeTemplateSlot(slot *domain.TemplateSlot) error {
+	slot.Name = strings.TrimSpace(slot.Name)
+
+	if slot.IsPlaceholder() {
+		if slot.Name == "" {
+			return fmt.Errorf("song placeholders must have a name")
+		}
+
+		if slot.Title != "" || slot.Content != "" || slot.Reference != "" || slot.Transpose != 0 {
+			return fmt.Errorf("song placeholders only have a name, notes and a duration")
+		}
+
+		slot.Type = domain.SongItem
+
+		return nil
+	}
+
+	entry := SlotEntry(slot, 0)
+	if err := NormalizeSetlistItem(&entry); err != nil {
+		return err
+	}
+
+	slot.Type = entry.Type
+	slot.Title = entry.Title
+	slot.Content = entry.Content
+	slot.Reference = entry.Reference
+
+	return nil
+}
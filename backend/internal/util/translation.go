@@ -0,0 +1,222 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"golang.org/x/text/language"
+	"gorm.io/datatypes"
+)
+
+// ParseLanguage returns the canonical form of a BCP 47 language tag, such as "nl" or "en-US".
+func ParseLanguage(tag string) (string, error) {
+	parsed, err := language.Parse(strings.TrimSpace(tag))
+	if err != nil {
+		return "", domain.NewBadRequestErr(fmt.Sprintf("%s is not a valid language", tag))
+	}
+
+	return parsed.String(), nil
+}
+
+// ParseLanguages parses a comma separated list of language tags,
+// empty and repeated languages are left out.
+func ParseLanguages(field string) ([]string, error) {
+	languages := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, tag := range strings.Split(field, ",") {
+		if strings.TrimSpace(tag) == "" {
+			continue
+		}
+
+		parsed, err := ParseLanguage(tag)
+		if err != nil {
+			return nil, err
+		}
+
+		if !seen[parsed] {
+			seen[parsed] = true
+			languages = append(languages, parsed)
+		}
+	}
+
+	return languages, nil
+}
+
+func readTranslation(lyrics datatypes.JSON) (map[string][]string, error) {
+	tagged := map[string]string{}
+
+	if err := json.Unmarshal(lyrics, &tagged); err != nil {
+		return nil, fmt.Errorf("could not parse lyrics: %s", err.Error())
+	}
+
+	sections := make(map[string][]string, len(tagged))
+
+	for tag, text := range tagged {
+		sections[tag] = strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	}
+
+	return sections, nil
+}
+
+// ValidateTranslation checks that the translated lyrics follow the section
+// structure of the chord sheet, every translated section has to exist in the
+// chord sheet with as many lines. Translations do not hold chords of their own.
+func ValidateTranslation(lyrics datatypes.JSON, chordsheet datatypes.JSON) error {
+	translated, err := readTranslation(lyrics)
+	if err != nil {
+		return err
+	}
+
+	document, err := ParseChordSheet(chordsheet)
+	if err != nil {
+		return err
+	}
+
+	lineCounts := make(map[string]int, len(document.Sections))
+	for _, section := range document.Sections {
+		lineCounts[section.Tag] = len(section.Lines)
+	}
+
+	tags := make([]string, 0, len(translated))
+	for tag := range translated {
+		tags = append(tags, tag)
+	}
+
+	sort.Strings(tags)
+
+	for _, tag := range tags {
+		lineCount, exists := lineCounts[tag]
+		if !exists {
+			return fmt.Errorf("section %s does not exist in the chord sheet", tag)
+		}
+
+		if len(translated[tag]) != lineCount {
+			return fmt.Errorf("%s: expected %d lines, got %d", tag, lineCount, len(translated[tag]))
+		}
+
+		for _, line := range translated[tag] {
+			if strings.ContainsAny(line, "[]") {
+				return fmt.Errorf("%s: translations cannot contain chords", tag)
+			}
+		}
+	}
+
+	return nil
+}
+
+// anchorChords moves the chords of a line onto translated lyrics, every chord keeps
+// its relative position in the line as the words of a translation rarely line up.
+func anchorChords(line domain.ChordSheetLine, lyrics string) domain.ChordSheetLine {
+	from := utf8.RuneCountInString(line.Lyrics)
+	to := utf8.RuneCountInString(lyrics)
+	chords := make([]domain.ChordPosition, len(line.Chords))
+
+	for idx, chord := range line.Chords {
+		offset := to
+
+		if from > 0 {
+			offset = int(math.Round(float64(chord.Offset) * float64(to) / float64(from)))
+		}
+
+		if offset > to {
+			offset = to
+		}
+
+		chords[idx] = domain.ChordPosition{Offset: offset, Symbol: chord.Symbol}
+	}
+
+	return domain.ChordSheetLine{Lyrics: lyrics, Chords: chords}
+}
+
+func formatChordSheetLine(line domain.ChordSheetLine) string {
+	var builder strings.Builder
+
+	runes := []rune(line.Lyrics)
+	offset := 0
+
+	for _, chord := range line.Chords {
+		if chord.Offset > offset {
+			builder.WriteString(string(runes[offset:chord.Offset]))
+			offset = chord.Offset
+		}
+
+		builder.WriteString("[" + chord.Symbol + "]")
+	}
+
+	builder.WriteString(string(runes[offset:]))
+
+	return builder.String()
+}
+
+// TranslateChordSheet replaces the lyrics of the chord sheet with the translated lyrics,
+// keeping the chords. Sections and lines without a translation keep their original lyrics.
+func TranslateChordSheet(chordsheet datatypes.JSON, lyrics datatypes.JSON) (datatypes.JSON, error) {
+	translated, err := readTranslation(lyrics)
+	if err != nil {
+		return nil, err
+	}
+
+	sections, err := readChordSheet(chordsheet)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, section := range sections {
+		translatedLines, exists := translated[section.Tag]
+		if !exists {
+			continue
+		}
+
+		lines := strings.Split(strings.ReplaceAll(section.Text, "\r\n", "\n"), "\n")
+
+		for lineIdx, line := range lines {
+			if lineIdx >= len(translatedLines) {
+				break
+			}
+
+			lines[lineIdx] = formatChordSheetLine(anchorChords(parseChordSheetLine(line), translatedLines[lineIdx]))
+		}
+
+		sections[idx].Text = strings.Join(lines, "\n")
+	}
+
+	return writeChordSheet(sections, isOrderedChordSheet(chordsheet))
+}
+
+// AddTranslatedLines shows the lyrics of the other chord sheet in the given language
+// below every line of the document, the chord sheets must share their structure.
+func AddTranslatedLines(document *domain.ChordSheet, lang string, chordsheet datatypes.JSON) error {
+	translated, err := ParseChordSheet(chordsheet)
+	if err != nil {
+		return err
+	}
+
+	for idx := range document.Sections {
+		if idx >= len(translated.Sections) {
+			break
+		}
+
+		lines := translated.Sections[idx].Lines
+
+		for lineIdx := range document.Sections[idx].Lines {
+			if lineIdx >= len(lines) {
+				break
+			}
+
+			line := &document.Sections[idx].Lines[lineIdx]
+			if line.Translations == nil {
+				line.Translations = make(map[string]string)
+			}
+
+			line.Translations[lang] = lines[lineIdx].Lyrics
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestParseArrangementItem(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		item   string
+		tag    string
+		repeat int
+	}{
+		{"Chorus", "Chorus", 1},
+		{"Verse 2", "Verse 2", 1},
+		{"Chorus x2", "Chorus", 2},
+		{"Chorus X3", "Chorus", 3},
+		{"Bridge (x4)", "Bridge", 4},
+		{" Tag ×2 ", "Tag", 2},
+	}
+
+	for _, test := range tests {
+		tag, repeat, err := util.ParseArrangementItem(test.item)
+		assert.NoError(t, err)
+		assert.Equal(t, test.tag, tag)
+		assert.Equal(t, test.repeat, repeat)
+	}
+
+	_, _, err := util.ParseArrangementItem("Chorus x0")
+	assert.Error(t, err)
+
+	_, _, err = util.ParseArrangementItem("Chorus x100")
+	assert.Error(t, err)
+}
+
+func TestExpandArrangement(t *testing.T) {
+	t.Parallel()
+
+	chordsheet := datatypes.JSON([]byte(`{"Verse 1": "[G]Foo", "Chorus": "[C]Bar", "Bridge": "[D]Baz"}`))
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		arrangement := datatypes.JSON([]byte(`["Verse 1", "chorus x2", "Bridge", "Chorus"]`))
+
+		expanded, err := util.ExpandArrangement(arrangement, chordsheet)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.ArrangedSection{
+			{Tag: "Verse 1", Text: "[G]Foo"},
+			{Tag: "Chorus", Text: "[C]Bar"},
+			{Tag: "Chorus", Text: "[C]Bar"},
+			{Tag: "Bridge", Text: "[D]Baz"},
+			{Tag: "Chorus", Text: "[C]Bar"},
+		}, expanded)
+	})
+
+	t.Run("Correct empty arrangement", func(t *testing.T) {
+		t.Parallel()
+
+		for _, arrangement := range []string{"", "null", "[]"} {
+			expanded, err := util.ExpandArrangement(datatypes.JSON([]byte(arrangement)), chordsheet)
+			assert.NoError(t, err)
+			assert.Equal(t, []domain.ArrangedSection{
+				{Tag: "Verse 1", Text: "[G]Foo"},
+				{Tag: "Chorus", Text: "[C]Bar"},
+				{Tag: "Bridge", Text: "[D]Baz"},
+			}, expanded)
+		}
+	})
+
+	t.Run("Fail missing section", func(t *testing.T) {
+		t.Parallel()
+
+		err := util.ValidateArrangement(datatypes.JSON([]byte(`["Verse 1", "Bridge 2"]`)), chordsheet)
+		assert.EqualError(t, err, "song has no section Bridge 2")
+	})
+
+	t.Run("Fail invalid arrangement", func(t *testing.T) {
+		t.Parallel()
+
+		err := util.ValidateArrangement(datatypes.JSON([]byte(`{arrangement: ["V1"]}`)), chordsheet)
+		assert.Error(t, err)
+	})
+}
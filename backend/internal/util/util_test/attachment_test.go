@@ -0,0 +1,57 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSniffContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		head     []byte
+		expected string
+	}{
+		{"sheet.pdf", []byte("%PDF-1.7\n"), "application/pdf"},
+		{"reference.mp3", []byte("ID3\x04\x00"), "audio/mpeg"},
+		{"practice.flac", []byte("fLaC\x00\x00\x00\x22"), "audio/flac"},
+		{"cover.png", []byte("\x89PNG\x0D\x0A\x1A\x0A"), "image/png"},
+	}
+
+	for _, test := range tests {
+		contentType, err := util.SniffContentType(test.name, test.head)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, contentType)
+	}
+}
+
+func TestSniffContentTypeNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	expErr := domain.NewBadRequestErr("")
+
+	_, err := util.SniffContentType("lyrics.txt", []byte("Amazing grace"))
+	assert.ErrorAs(t, err, &expErr)
+
+	_, err = util.SniffContentType("practice.mp3", []byte("<!DOCTYPE html>"))
+	assert.ErrorAs(t, err, &expErr)
+
+	_, err = util.SniffContentType("setup.exe", []byte("MZ\x90\x00"))
+	assert.ErrorAs(t, err, &expErr)
+}
+
+func TestNewStorageKey(t *testing.T) {
+	t.Parallel()
+
+	first, err := util.NewStorageKey()
+	assert.NoError(t, err)
+	assert.Len(t, first, 32)
+
+	second, err := util.NewStorageKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
@@ -0,0 +1,65 @@
+package util_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrincipal(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("user", mockUser)
+
+		user, err := util.Principal(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, mockUser, user)
+	})
+
+	t.Run("Fail no user", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+		user, err := util.Principal(ctx)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, user)
+	})
+
+	t.Run("Fail not a user", func(t *testing.T) {
+		t.Parallel()
+
+		expErr := domain.NewInternalErr()
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("user", "foo")
+
+		user, err := util.Principal(ctx)
+		assert.ErrorAs(t, err, &expErr)
+		assert.Nil(t, user)
+	})
+}
+
+func TestIdentified(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	mockUser := &domain.User{ID: 1, Permission: domain.MEMBER}
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	assert.Nil(t, util.Identified(ctx))
+
+	ctx.Set("user", mockUser)
+	assert.Equal(t, mockUser, util.Identified(ctx))
+}
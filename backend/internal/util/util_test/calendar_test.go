@@ -0,0 +1,174 @@
+package util_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderCalendar(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.March, 31, 10, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	modified := time.Date(2024, time.March, 20, 12, 30, 0, 0, time.UTC)
+	generated := time.Date(2024, time.March, 25, 9, 15, 0, 0, time.UTC)
+
+	calendar := &domain.Calendar{
+		Name:      "Setlists",
+		Generated: generated,
+		Events: []domain.CalendarEvent{
+			{
+				UID:         "setlist-1@mkvstage",
+				Summary:     "Easter; morning, service",
+				Description: "Songs:\n1. Amazing Grace (G)",
+				Status:      domain.EventConfirmed,
+				Start:       start,
+				Duration:    45 * time.Minute,
+				Modified:    modified,
+			},
+			{
+				UID:     "setlist-2@mkvstage",
+				Summary: "Rehearsal",
+				Start:   start.AddDate(0, 0, 7),
+			},
+		},
+	}
+
+	expected := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//mkvstage//setlists//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Setlists",
+		"BEGIN:VEVENT",
+		"UID:setlist-1@mkvstage",
+		"DTSTAMP:20240320T123000Z",
+		"LAST-MODIFIED:20240320T123000Z",
+		"DTSTART:20240331T080000Z",
+		"DURATION:PT2700S",
+		`SUMMARY:Easter\; morning\, service`,
+		`DESCRIPTION:Songs:\n1. Amazing Grace (G)`,
+		"STATUS:CONFIRMED",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:setlist-2@mkvstage",
+		"DTSTAMP:20240325T091500Z",
+		"DTSTART:20240407T080000Z",
+		"SUMMARY:Rehearsal",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	assert.Equal(t, expected, string(util.RenderCalendar(calendar)))
+}
+
+func TestRenderCalendarFoldsLongLines(t *testing.T) {
+	t.Parallel()
+
+	calendar := &domain.Calendar{
+		Name: "Setlists",
+		Events: []domain.CalendarEvent{
+			{
+				UID:     "setlist-1@mkvstage",
+				Summary: strings.Repeat("Opwekking ", 6) + strings.Repeat("é", 20),
+				Start:   time.Date(2024, time.March, 31, 10, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	rendered := string(util.RenderCalendar(calendar))
+	lines := strings.Split(strings.TrimSuffix(rendered, "\r\n"), "\r\n")
+
+	var summary strings.Builder
+
+	for idx, line := range lines {
+		assert.LessOrEqual(t, len(line), 75)
+		assert.True(t, utf8.ValidString(line), line)
+
+		if strings.HasPrefix(line, "SUMMARY:") {
+			summary.WriteString(strings.TrimPrefix(line, "SUMMARY:"))
+
+			for _, continuation := range lines[idx+1:] {
+				if !strings.HasPrefix(continuation, " ") {
+					break
+				}
+
+				summary.WriteString(strings.TrimPrefix(continuation, " "))
+			}
+		}
+	}
+
+	assert.Equal(t, calendar.Events[0].Summary, summary.String())
+}
+
+func TestSetlistEvent(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Date(2024, time.March, 31, 10, 0, 0, 0, time.UTC)
+	setlist := &domain.Setlist{
+		ID:       4,
+		Name:     "Easter",
+		Deadline: deadline,
+		Status:   domain.SetlistPublished,
+	}
+	entries := []domain.SetlistEntry{
+		{ID: 2, SongID: 2, SetlistID: 4, Transpose: 2, Rank: 2000},
+		{ID: 3, Type: domain.HeaderItem, SetlistID: 4, Title: "Worship", Rank: 500},
+		{ID: 1, SongID: 1, SetlistID: 4, Rank: 1000},
+		{ID: 4, Type: domain.TextItem, SetlistID: 4, Title: "Sermon", Duration: 1800, Rank: 3000},
+	}
+	songs := map[int64]domain.Song{
+		1: {ID: 1, Title: "Amazing Grace", Key: "G", Duration: 240},
+		2: {ID: 2, Title: "How Great Thou Art", Key: "C", Duration: 300},
+	}
+	assignments := []domain.UserRole{
+		{ID: 2, User: &domain.User{FirstName: "Jane", LastName: "Doe"}, Role: &domain.Role{Name: "Vocals"}},
+		{ID: 1, User: &domain.User{FirstName: "John", LastName: "Doe"}, Role: &domain.Role{Name: "Guitar"}},
+	}
+
+	event := util.SetlistEvent(setlist, entries, songs, assignments)
+
+	assert.Equal(t, domain.CalendarEvent{
+		UID:         "setlist-4@mkvstage",
+		Summary:     "Easter",
+		Description: "Songs:\n1. Amazing Grace (G)\n2. How Great Thou Art (D)\nRoles:\nGuitar: John Doe\nVocals: Jane Doe",
+		Status:      domain.EventConfirmed,
+		Start:       deadline,
+		Duration:    2340 * time.Second,
+	}, event)
+}
+
+func TestSetlistEventDraft(t *testing.T) {
+	t.Parallel()
+
+	setlist := &domain.Setlist{
+		ID:       1,
+		Name:     "Draft",
+		Deadline: time.Now().Add(time.Hour),
+		Status:   domain.SetlistDraft,
+	}
+
+	event := util.SetlistEvent(setlist, nil, map[int64]domain.Song{}, nil)
+	assert.Equal(t, domain.EventTentative, event.Status)
+	assert.Empty(t, event.Description)
+	assert.Zero(t, event.Duration)
+}
+
+func TestNewCalendarToken(t *testing.T) {
+	t.Parallel()
+
+	first, err := util.NewCalendarToken()
+	assert.NoError(t, err)
+	assert.Len(t, first, 64)
+
+	second, err := util.NewCalendarToken()
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
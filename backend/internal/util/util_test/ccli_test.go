@@ -0,0 +1,32 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteCCLIReport(t *testing.T) {
+	t.Parallel()
+
+	report := &domain.CCLIReport{
+		Rows: []domain.CCLIReportRow{
+			{SongID: 1, CCLINumber: "22025", Title: "Amazing Grace", Authors: "John Newton", Copyright: "Public Domain", TimesUsed: 3},
+			{SongID: 2, Title: "Foo, Bar", TimesUsed: 1, MissingCCLINumber: true},
+		},
+	}
+
+	expected := "CCLI Song Number,Title,Authors,Copyright,Publisher,Times Used,Missing CCLI Number\n" +
+		"22025,Amazing Grace,John Newton,Public Domain,,3,\n" +
+		",\"Foo, Bar\",,,,1,yes\n"
+
+	csv, err := util.WriteCCLIReport(report)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(csv))
+
+	csv, err = util.WriteCCLIReport(&domain.CCLIReport{})
+	assert.NoError(t, err)
+	assert.Equal(t, "CCLI Song Number,Title,Authors,Copyright,Publisher,Times Used,Missing CCLI Number\n", string(csv))
+}
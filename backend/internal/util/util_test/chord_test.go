@@ -0,0 +1,126 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestParseChord(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{"C", "Am", "F#m7b5", "Bbmaj7", "Gsus4", "Cadd9", "D/F#", "Ebm7/Db", "E7#9", "Dm(maj7)", "A2"}
+	for _, symbol := range valid {
+		_, err := util.ParseChord(symbol)
+		assert.NoError(t, err, symbol)
+	}
+
+	invalid := []string{"", "H", "x2", "Cfoo", "D/X", "Verse"}
+	for _, symbol := range invalid {
+		_, err := util.ParseChord(symbol)
+		assert.Error(t, err, symbol)
+	}
+}
+
+func TestTransposeChord(t *testing.T) {
+	t.Parallel()
+
+	expecteds := []struct {
+		symbol    string
+		amount    int
+		flats     bool
+		transpose string
+	}{
+		{"C", 2, false, "D"},
+		{"G", 3, true, "Bb"},
+		{"G", 3, false, "A#"},
+		{"Am7", -2, false, "Gm7"},
+		{"D/F#", 2, false, "E/G#"},
+		{"Bbmaj7", 1, false, "Bmaj7"},
+		{"Gsus4", 5, false, "Csus4"},
+		{"Cadd9/E", -1, false, "Badd9/D#"},
+		{"Cadd9/E", -1, true, "Badd9/Eb"},
+		{"B", 1, false, "C"},
+	}
+
+	for _, exp := range expecteds {
+		transposed, err := util.TransposeChord(exp.symbol, exp.amount, exp.flats)
+		assert.NoError(t, err)
+		assert.Equal(t, exp.transpose, transposed)
+	}
+}
+
+func TestTransposeKey(t *testing.T) {
+	t.Parallel()
+
+	expecteds := []struct {
+		key       string
+		amount    int
+		transpose string
+	}{
+		{"G", 0, "G"},
+		{"Gb", 0, "Gb"},
+		{"G", 3, "Bb"},
+		{"G", -1, "F#"},
+		{"C", 1, "Db"},
+		{"Am", 1, "Bbm"},
+		{"Em", -1, "Ebm"},
+		{"F#m", 6, "Cm"},
+	}
+
+	for _, exp := range expecteds {
+		key, err := util.TransposeKey(exp.key, exp.amount)
+		assert.NoError(t, err)
+		assert.Equal(t, exp.transpose, key)
+	}
+
+	_, err := util.TransposeKey("Cmaj7", 1)
+	assert.Error(t, err)
+}
+
+func TestCompatibleKeys(t *testing.T) {
+	t.Parallel()
+
+	expecteds := []struct {
+		key  string
+		keys []string
+	}{
+		{"G", []string{"G", "Em"}},
+		{"Em", []string{"Em", "G"}},
+		{"C#", []string{"C#", "Db", "A#m", "Bbm"}},
+		{"Ebm", []string{"D#m", "Ebm", "F#", "Gb"}},
+	}
+
+	for _, exp := range expecteds {
+		keys, err := util.CompatibleKeys(exp.key)
+		assert.NoError(t, err)
+		assert.Equal(t, exp.keys, keys)
+	}
+
+	_, err := util.CompatibleKeys("H")
+	assert.Error(t, err)
+}
+
+func TestTransposeText(t *testing.T) {
+	t.Parallel()
+
+	text := "[G]Amazing [G7]grace, how [C/G]sweet the [G]sound [x2]"
+	expected := "[A]Amazing [A7]grace, how [D/A]sweet the [A]sound [x2]"
+
+	assert.Equal(t, expected, util.TransposeText(text, 2, false))
+}
+
+func TestTransposeChordSheet(t *testing.T) {
+	t.Parallel()
+
+	mockCS := datatypes.JSON([]byte(`{"Verse": "[D]Foo [Bm]bar", "Chorus": "[G]Baz"}`))
+
+	transposed, err := util.TransposeChordSheet(mockCS, 1, true)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"Verse": "[Eb]Foo [Cm]bar", "Chorus": "[Ab]Baz"}`, transposed.String())
+
+	_, err = util.TransposeChordSheet(datatypes.JSON([]byte(`{"Verse"`)), 1, true)
+	assert.Error(t, err)
+}
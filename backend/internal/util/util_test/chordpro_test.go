@@ -0,0 +1,152 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+const mockChordPro = `# A comment
+{title: Amazing Grace}
+{subtitle: My Chains Are Gone}
+{key: G}
+{tempo: 72}
+
+[G]Amazing grace, how [C]sweet the [G]sound
+That saved a wretch like [D]me
+
+[G]I once was lost, but [C]now am [G]found
+
+{start_of_chorus}
+My [C]chains are [G]gone
+
+I've been set [D]free
+{end_of_chorus}
+
+{c: Bridge}
+The [Em]earth shall [C]soon
+`
+
+func TestParseChordPro(t *testing.T) {
+	t.Parallel()
+
+	song, err := util.ParseChordPro(mockChordPro)
+	assert.NoError(t, err)
+	assert.Equal(t, "Amazing Grace", song.Title)
+	assert.Equal(t, "My Chains Are Gone", song.Subtitle)
+	assert.Equal(t, "G", song.Key)
+	assert.Equal(t, uint(72), song.Bpm)
+	assert.JSONEq(t, `[
+		{"tag": "Verse 1", "text": "[G]Amazing grace, how [C]sweet the [G]sound\nThat saved a wretch like [D]me"},
+		{"tag": "Verse 2", "text": "[G]I once was lost, but [C]now am [G]found"},
+		{"tag": "Chorus", "text": "My [C]chains are [G]gone\n\nI've been set [D]free"},
+		{"tag": "Bridge", "text": "The [Em]earth shall [C]soon"}
+	]`, song.ChordSheet.String())
+	assert.NoError(t, util.ValidateChordSheet(song.ChordSheet))
+}
+
+func TestParseChordProLabels(t *testing.T) {
+	t.Parallel()
+
+	source := "{t: Foo}\n{start_of_verse: Verse 2}\n[A]Foo\n{end_of_verse}\n{soc: Chorus 2}\n[D]Bar\n{eoc}"
+
+	song, err := util.ParseChordPro(source)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"tag": "Verse 2", "text": "[A]Foo"}, {"tag": "Chorus 2", "text": "[D]Bar"}]`, song.ChordSheet.String())
+}
+
+func TestParseChordProManySections(t *testing.T) {
+	t.Parallel()
+
+	source := "{t: Foo}\n[A]One\n\n[A]Two\n\n[A]Three\n\n[A]Four\n\n[A]Five\n\n[A]Six\n" +
+		"{sob}\n[D]Up\n{eob}\n{sob}\n[D]Down\n{eob}"
+
+	song, err := util.ParseChordPro(source)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"tag": "Verse 1", "text": "[A]One"},
+		{"tag": "Verse 2", "text": "[A]Two"},
+		{"tag": "Verse 3", "text": "[A]Three"},
+		{"tag": "Verse 4", "text": "[A]Four"},
+		{"tag": "Verse 5", "text": "[A]Five\n\n[A]Six"},
+		{"tag": "Bridge", "text": "[D]Up\n\n[D]Down"}
+	]`, song.ChordSheet.String())
+	assert.NoError(t, util.ValidateChordSheet(song.ChordSheet))
+}
+
+func TestParseChordProLicense(t *testing.T) {
+	t.Parallel()
+
+	source := "{title: Foo}\n{key: G}\n{ccli: 22025}\n{copyright: 2023 Bar Music}\n{soc}\n[G]Bar\n{eoc}\n"
+
+	song, err := util.ParseChordPro(source)
+	assert.NoError(t, err)
+	assert.Equal(t, "22025", song.CCLINumber)
+	assert.Equal(t, "2023 Bar Music", song.Copyright)
+
+	chordpro, err := util.WriteChordPro(song)
+	assert.NoError(t, err)
+	assert.Equal(t, "{title: Foo}\n{key: G}\n{ccli: 22025}\n{copyright: 2023 Bar Music}\n"+
+		"\n{start_of_chorus: Chorus}\n[G]Bar\n{end_of_chorus}\n", chordpro)
+}
+
+func TestParseChordProInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := util.ParseChordPro("{key: G}\n[G]Foo")
+	assert.Error(t, err)
+
+	_, err = util.ParseChordPro("{title: Foo}\n{tempo: fast}")
+	assert.Error(t, err)
+
+	_, err = util.ParseChordPro("{title: Foo}\n{soc}\nFoo\n{eoc}\n{soc: Chorus}\nBar\n{eoc}")
+	assert.Error(t, err)
+}
+
+func TestWriteChordPro(t *testing.T) {
+	t.Parallel()
+
+	song := &domain.Song{
+		Title:      "Foo",
+		Key:        "D",
+		Bpm:        100,
+		ChordSheet: datatypes.JSON([]byte(`{"Chorus": "[G]Bar", "Verse 1": "[D]Foo"}`)),
+	}
+
+	expected := "{title: Foo}\n{key: D}\n{tempo: 100}\n" +
+		"\n{start_of_verse: Verse 1}\n[D]Foo\n{end_of_verse}\n" +
+		"\n{start_of_chorus: Chorus}\n[G]Bar\n{end_of_chorus}\n"
+
+	chordpro, err := util.WriteChordPro(song)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, chordpro)
+
+	parsed, err := util.ParseChordPro(chordpro)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"tag": "Verse 1", "text": "[D]Foo"}, {"tag": "Chorus", "text": "[G]Bar"}]`, parsed.ChordSheet.String())
+}
+
+func TestWriteChordProOrdered(t *testing.T) {
+	t.Parallel()
+
+	song := &domain.Song{
+		Title:      "Foo",
+		Key:        "D",
+		ChordSheet: datatypes.JSON([]byte(`[{"tag": "Chorus", "text": "[G]Bar"}, {"tag": "Verse", "text": "[D]Foo"}]`)),
+	}
+
+	expected := "{title: Foo}\n{key: D}\n" +
+		"\n{start_of_chorus: Chorus}\n[G]Bar\n{end_of_chorus}\n" +
+		"\n{start_of_verse: Verse}\n[D]Foo\n{end_of_verse}\n"
+
+	chordpro, err := util.WriteChordPro(song)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, chordpro)
+
+	parsed, err := util.ParseChordPro(chordpro)
+	assert.NoError(t, err)
+	assert.JSONEq(t, song.ChordSheet.String(), parsed.ChordSheet.String())
+}
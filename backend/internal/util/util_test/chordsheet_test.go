@@ -3,6 +3,7 @@ package util_test
 import (
 	"testing"
 
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/util"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/datatypes"
@@ -31,3 +32,122 @@ func TestChordSheetInvalidTag(t *testing.T) {
 	err := util.ValidateChordSheet(mockCS)
 	assert.Error(t, err)
 }
+
+func TestChordSheetOrdered(t *testing.T) {
+	t.Parallel()
+
+	mockCS := datatypes.JSON([]byte(`[{"tag": "Chorus", "text": "[G]Foo"}, {"tag": "Verse", "text": "Bar"}]`))
+	assert.NoError(t, util.ValidateChordSheet(mockCS))
+
+	mockCS = datatypes.JSON([]byte(`[{"tag": "Verse", "text": "Foo"}, {"tag": "Verse", "text": "Bar"}]`))
+	assert.Error(t, util.ValidateChordSheet(mockCS))
+}
+
+func TestChordSheetMalformedBracket(t *testing.T) {
+	t.Parallel()
+
+	mockCS := datatypes.JSON([]byte(`{"Verse" : "[G]Foo []bar"}`))
+	assert.Error(t, util.ValidateChordSheet(mockCS))
+
+	mockCS = datatypes.JSON([]byte(`{"Verse" : "[G]Foo [Dbar"}`))
+	assert.Error(t, util.ValidateChordSheet(mockCS))
+
+	mockCS = datatypes.JSON([]byte(`{"Verse" : "[G]Foo [D[m]bar"}`))
+	assert.Error(t, util.ValidateChordSheet(mockCS))
+
+	mockCS = datatypes.JSON([]byte(`{"Verse" : "[G]Foo D]bar"}`))
+	assert.Error(t, util.ValidateChordSheet(mockCS))
+}
+
+func TestChordSheetInvalidChord(t *testing.T) {
+	t.Parallel()
+
+	mockCS := datatypes.JSON([]byte(`{"Verse" : "[G]Foo [Hm]bar"}`))
+	assert.Error(t, util.ValidateChordSheet(mockCS))
+
+	mockCS = datatypes.JSON([]byte(`{"Verse" : "[G]Foo [softly]bar"}`))
+	assert.Error(t, util.ValidateChordSheet(mockCS))
+
+	mockCS = datatypes.JSON([]byte(`{"Verse" : "[G]Foo [b3/5]bar [x2] [*softly]"}`))
+	assert.NoError(t, util.ValidateChordSheet(mockCS))
+}
+
+func TestChordSheetAnnotations(t *testing.T) {
+	t.Parallel()
+
+	mockCS := datatypes.JSON([]byte(`{"Verse" : "[G]Foo [N.C.]bar [x2]\n[*softly]baz"}`))
+	assert.NoError(t, util.ValidateChordSheet(mockCS))
+
+	document, err := util.ParseChordSheet(mockCS)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ChordSheetLine{
+		{Lyrics: "Foo [N.C.]bar [x2]", Chords: []domain.ChordPosition{{Offset: 0, Symbol: "G"}}},
+		{Lyrics: "[*softly]baz", Chords: []domain.ChordPosition{}},
+	}, document.Sections[0].Lines)
+
+	mockCS = datatypes.JSON([]byte(`{"Verse" : "[G]Foo [Dbar"}`))
+	document, err = util.ParseChordSheet(mockCS)
+	assert.NoError(t, err)
+	assert.Equal(t, "Foo [Dbar", document.Sections[0].Lines[0].Lyrics)
+}
+
+func TestParseChordSheet(t *testing.T) {
+	t.Parallel()
+
+	mockCS := datatypes.JSON([]byte(`{
+		"Chorus": "[D]Hallelujah",
+		"Verse 1": "[G]Amazing [C/G]gráce how [G]sweet\nThe sound [D]"
+	}`))
+
+	expected := &domain.ChordSheet{
+		Sections: []domain.ChordSheetSection{
+			{
+				Tag: "Verse 1",
+				Lines: []domain.ChordSheetLine{
+					{
+						Lyrics: "Amazing gráce how sweet",
+						Chords: []domain.ChordPosition{
+							{Offset: 0, Symbol: "G"},
+							{Offset: 8, Symbol: "C/G"},
+							{Offset: 18, Symbol: "G"},
+						},
+					},
+					{
+						Lyrics: "The sound ",
+						Chords: []domain.ChordPosition{{Offset: 10, Symbol: "D"}},
+					},
+				},
+			},
+			{
+				Tag: "Chorus",
+				Lines: []domain.ChordSheetLine{
+					{
+						Lyrics: "Hallelujah",
+						Chords: []domain.ChordPosition{{Offset: 0, Symbol: "D"}},
+					},
+				},
+			},
+		},
+	}
+
+	document, err := util.ParseChordSheet(mockCS)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, document)
+}
+
+func TestParseChordSheetKeepsOrder(t *testing.T) {
+	t.Parallel()
+
+	mockCS := datatypes.JSON([]byte(`[{"tag": "Outro", "text": ""}, {"tag": "Intro", "text": "[A]"}]`))
+
+	document, err := util.ParseChordSheet(mockCS)
+	assert.NoError(t, err)
+	assert.Len(t, document.Sections, 2)
+	assert.Equal(t, "Outro", document.Sections[0].Tag)
+	assert.Equal(t, "Intro", document.Sections[1].Tag)
+	assert.Equal(t, []domain.ChordPosition{{Offset: 0, Symbol: "A"}}, document.Sections[1].Lines[0].Chords)
+
+	transposed, err := util.TransposeChordSheet(mockCS, 2, false)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"tag": "Outro", "text": ""}, {"tag": "Intro", "text": "[B]"}]`, transposed.String())
+}
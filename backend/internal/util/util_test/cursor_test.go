@@ -0,0 +1,47 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSongCursor(t *testing.T) {
+	t.Parallel()
+
+	played := time.Date(2023, time.May, 1, 10, 0, 0, 0, time.UTC)
+	song := domain.Song{ID: 4, Title: "Foo", Bpm: 120, UpdatedAt: played, LastPlayed: &played}
+
+	assert.Equal(t, domain.SongCursor{ID: 4}, util.NewSongCursor(song, domain.SongSortID))
+	assert.Equal(t, domain.SongCursor{ID: 4, Value: "Foo"}, util.NewSongCursor(song, domain.SongSortTitle))
+	assert.Equal(t, domain.SongCursor{ID: 4, Value: "120"}, util.NewSongCursor(song, domain.SongSortBpm))
+	assert.Equal(t, domain.SongCursor{ID: 4, Value: "2023-05-01T10:00:00Z"}, util.NewSongCursor(song, domain.SongSortUpdatedAt))
+	assert.Equal(t, domain.SongCursor{ID: 4, Value: "2023-05-01T10:00:00Z"}, util.NewSongCursor(song, domain.SongSortLastPlayed))
+
+	song.LastPlayed = nil
+	assert.Equal(t, domain.SongCursor{ID: 4}, util.NewSongCursor(song, domain.SongSortLastPlayed))
+
+	cursor := util.NewSongCursor(song, domain.SongSortTitle)
+	decoded, err := util.DecodeSongCursor(util.EncodeSongCursor(cursor))
+	assert.NoError(t, err)
+	assert.Equal(t, &cursor, decoded)
+}
+
+func TestDecodeSongCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor, err := util.DecodeSongCursor("")
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	expErr := domain.NewBadRequestErr("")
+
+	_, err = util.DecodeSongCursor("!!")
+	assert.ErrorAs(t, err, &expErr)
+
+	_, err = util.DecodeSongCursor("e30")
+	assert.ErrorAs(t, err, &expErr)
+}
@@ -0,0 +1,58 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "amazing grace", util.NormalizeTitle("Amazing Grace"))
+	assert.Equal(t, "amazing grace", util.NormalizeTitle("Amazing Grace (My Chains)"))
+	assert.Equal(t, "amazing grace", util.NormalizeTitle("  Amazing   grace "))
+	assert.Equal(t, "ik zal er zijn", util.NormalizeTitle("Ik zal er zijn! [Live]"))
+	assert.Equal(t, "cafe", util.NormalizeTitle("Café"))
+}
+
+func TestTitleSimilarity(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 1.0, util.TitleSimilarity("Amazing Grace", "Amazing grace (My Chains)"))
+	assert.InDelta(t, 12.0/13.0, util.TitleSimilarity("Amazing Grace", "Amazing Grase"), 0.001)
+	assert.Less(t, util.TitleSimilarity("Amazing Grace", "Oceans"), 0.3)
+	assert.Equal(t, 0.0, util.TitleSimilarity("", "(Live)"))
+}
+
+func TestFindDuplicates(t *testing.T) {
+	t.Parallel()
+
+	lyrics := datatypes.JSON([]byte(`[{"tag": "Verse 1", "text": "[G]Amazing grace how sweet the sound\nThat saved a wretch like me"}]`))
+	otherLyrics := datatypes.JSON([]byte(`[{"tag": "Verse 1", "text": "You call me out upon the waters\nThe great unknown where feet may fail"}]`))
+
+	songs := []domain.Song{
+		{ID: 1, Title: "Amazing Grace", ChordSheet: lyrics},
+		{ID: 2, Title: "Oceans", ChordSheet: otherLyrics},
+		{ID: 3, Title: "Amazing Grace (My Chains)"},
+		{ID: 4, Title: "Grace Amazing", ChordSheet: lyrics},
+		{ID: 5, Title: "Holy"},
+		{ID: 6, Title: "Oceans", ChordSheet: lyrics},
+	}
+
+	duplicates := util.FindDuplicates(songs, 0.8)
+	assert.Len(t, duplicates, 1)
+	assert.Equal(t, 1.0, duplicates[0].Similarity)
+
+	ids := make([]int64, len(duplicates[0].Songs))
+	for idx, song := range duplicates[0].Songs {
+		ids[idx] = song.ID
+	}
+
+	assert.Equal(t, []int64{1, 3, 4, 6}, ids)
+
+	assert.Empty(t, util.FindDuplicates(songs[:2], 0.8))
+}
@@ -0,0 +1,99 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestEstimateDuration(t *testing.T) {
+	t.Parallel()
+
+	chordsheet := datatypes.JSON([]byte(`{"Verse 1": "[A]Foo\n[E]Bar", "Chorus": "[D]Baz\n\n[A]Qux"}`))
+
+	expecteds := []struct {
+		arrangement datatypes.JSON
+		chordsheet  datatypes.JSON
+		bpm         uint
+		duration    uint
+	}{
+		{nil, chordsheet, 120, 16},
+		{nil, chordsheet, 60, 32},
+		{nil, chordsheet, 90, 21},
+		{datatypes.JSON([]byte(`["Verse 1", "Chorus x2"]`)), chordsheet, 120, 24},
+		{nil, chordsheet, 0, 0},
+		{datatypes.JSON([]byte(`["Bridge"]`)), chordsheet, 120, 0},
+		{nil, datatypes.JSON([]byte(`foo`)), 120, 0},
+	}
+
+	for _, exp := range expecteds {
+		assert.Equal(t, exp.duration, util.EstimateDuration(exp.arrangement, exp.chordsheet, exp.bpm))
+	}
+}
+
+func TestEntryDuration(t *testing.T) {
+	t.Parallel()
+
+	song := &domain.Song{
+		Bpm:        120,
+		ChordSheet: datatypes.JSON([]byte(`{"Verse 1": "[A]Foo", "Chorus": "[D]Baz"}`)),
+	}
+	entry := &domain.SetlistEntry{Arrangement: datatypes.JSON([]byte(`["Chorus x3"]`))}
+
+	assert.Equal(t, uint(8), util.SongDuration(song))
+	assert.Equal(t, uint(12), util.EntryDuration(entry, song))
+
+	timedSong := *song
+	timedSong.Duration = 300
+
+	assert.Equal(t, uint(300), util.SongDuration(&timedSong))
+	assert.Equal(t, uint(300), util.EntryDuration(entry, &timedSong))
+
+	timedEntry := *entry
+	timedEntry.Duration = 240
+
+	assert.Equal(t, uint(240), util.EntryDuration(&timedEntry, &timedSong))
+
+	header := &domain.SetlistEntry{Type: domain.HeaderItem, Title: "Sermon"}
+
+	assert.Equal(t, uint(0), util.EntryDuration(header, &domain.Song{}))
+
+	header.Duration = 1800
+
+	assert.Equal(t, uint(1800), util.EntryDuration(header, &domain.Song{}))
+}
+
+func TestPlanSetlist(t *testing.T) {
+	t.Parallel()
+
+	songs := map[int64]domain.Song{
+		1: {ID: 1, Duration: 300},
+		2: {ID: 2, Bpm: 120, ChordSheet: datatypes.JSON([]byte(`{"Verse 1": "[A]Foo"}`))},
+	}
+
+	entries := []domain.SetlistEntry{
+		{ID: 3, SongID: 2, Rank: 2000},
+		{ID: 2, SongID: 1, Rank: 1000, Duration: 180},
+		{ID: 4, SongID: 5, Rank: 3000},
+		{ID: 5, SongID: 1, Rank: 4000},
+	}
+
+	assert.Equal(t, domain.SetlistTiming{
+		SetlistID: 1,
+		RunTime:   484,
+		Entries: []domain.EntryTiming{
+			{EntryID: 2, Start: 0, Duration: 180},
+			{EntryID: 3, Start: 180, Duration: 4},
+			{EntryID: 4, Start: 184, Duration: 0},
+			{EntryID: 5, Start: 184, Duration: 300},
+		},
+	}, util.PlanSetlist(1, entries, songs))
+
+	assert.Equal(t, domain.SetlistTiming{
+		SetlistID: 1,
+		Entries:   []domain.EntryTiming{},
+	}, util.PlanSetlist(1, nil, songs))
+}
@@ -0,0 +1,146 @@
+package util_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newConditionalContext(t *testing.T, headers map[string]string) (*gin.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	writer := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(writer)
+
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, "/", nil)
+	assert.NoError(t, err)
+
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	ctx.Request = req
+
+	return ctx, writer
+}
+
+func TestETag(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2024, time.March, 31, 10, 0, 0, 123456789, time.UTC)
+	etag := util.ETag("song", 1, updatedAt)
+
+	assert.Regexp(t, `^"[0-9a-f]{24}"$`, etag)
+	assert.Equal(t, etag, util.ETag("song", 1, updatedAt.Truncate(time.Millisecond)))
+	assert.NotEqual(t, etag, util.ETag("song", 1, updatedAt.Add(time.Millisecond)))
+	assert.NotEqual(t, etag, util.ETag("song", 2, updatedAt))
+	assert.NotEqual(t, etag, util.ETag("setlist", 1, updatedAt))
+}
+
+func TestSetlistETag(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Date(2024, time.March, 31, 10, 0, 0, 0, time.UTC)
+	setlist := &domain.Setlist{ID: 1, Deadline: deadline, Status: domain.SetlistPublished, UpdatedAt: deadline.Add(-time.Hour)}
+	etag := util.SetlistETag(setlist, deadline.Add(-time.Minute))
+
+	assert.Equal(t, etag, util.SetlistETag(setlist, deadline.Add(-time.Second)))
+	assert.NotEqual(t, etag, util.SetlistETag(setlist, deadline.Add(time.Second)))
+}
+
+func TestNotModified(t *testing.T) {
+	t.Parallel()
+
+	etag := util.ETag("song", 1, time.Time{})
+
+	tests := []struct {
+		name     string
+		header   string
+		expected bool
+	}{
+		{name: "Correct match", header: etag, expected: true},
+		{name: "Correct weak match", header: "W/" + etag, expected: true},
+		{name: "Correct listed", header: `"foo", ` + etag, expected: true},
+		{name: "Correct wildcard", header: "*", expected: true},
+		{name: "Correct no header", header: "", expected: false},
+		{name: "Correct other version", header: `"foo"`, expected: false},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			headers := map[string]string{}
+			if test.header != "" {
+				headers["If-None-Match"] = test.header
+			}
+
+			ctx, writer := newConditionalContext(t, headers)
+
+			assert.Equal(t, test.expected, util.NotModified(ctx, etag))
+			assert.Equal(t, etag, writer.Header().Get("ETag"))
+
+			if test.expected {
+				ctx.Writer.WriteHeaderNow()
+				assert.Equal(t, http.StatusNotModified, writer.Code)
+			}
+		})
+	}
+}
+
+func TestCheckIfMatch(t *testing.T) {
+	t.Parallel()
+
+	etag := util.ETag("setlist", 1, time.Time{})
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, _ := newConditionalContext(t, map[string]string{"If-Match": etag})
+		assert.NoError(t, util.CheckIfMatch(ctx, etag))
+	})
+
+	t.Run("Correct wildcard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, _ := newConditionalContext(t, map[string]string{"If-Match": "*"})
+		assert.NoError(t, util.CheckIfMatch(ctx, etag))
+	})
+
+	t.Run("Fail missing", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, _ := newConditionalContext(t, map[string]string{})
+
+		err := util.CheckIfMatch(ctx, etag)
+		assert.Equal(t, http.StatusPreconditionRequired, domain.Status(err))
+	})
+
+	t.Run("Fail stale", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, _ := newConditionalContext(t, map[string]string{"If-Match": util.ETag("setlist", 1, time.Now())})
+
+		err := util.CheckIfMatch(ctx, etag)
+		assert.Equal(t, http.StatusPreconditionFailed, domain.Status(err))
+	})
+
+	t.Run("Fail weak", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, _ := newConditionalContext(t, map[string]string{"If-Match": "W/" + etag})
+
+		err := util.CheckIfMatch(ctx, etag)
+		assert.Equal(t, http.StatusPreconditionFailed, domain.Status(err))
+	})
+}
@@ -0,0 +1,53 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestNashvilleChord(t *testing.T) {
+	t.Parallel()
+
+	expecteds := []struct {
+		symbol string
+		key    string
+		number string
+	}{
+		{"C", "C", "1"},
+		{"Am7", "C", "6m7"},
+		{"G/B", "C", "5/7"},
+		{"Bb", "C", "b7"},
+		{"Fm", "C", "4m"},
+		{"F#m7b5", "C", "#4m7b5"},
+		{"Am", "Am", "1m"},
+		{"C", "Am", "b3"},
+		{"E7", "Am", "57"},
+		{"Dsus4", "Eb", "7sus4"},
+		{"Ab/C", "Eb", "4/6"},
+	}
+
+	for _, exp := range expecteds {
+		number, err := util.NashvilleChord(exp.symbol, exp.key)
+		assert.NoError(t, err)
+		assert.Equal(t, exp.number, number, exp.symbol)
+	}
+
+	_, err := util.NashvilleChord("C", "X")
+	assert.Error(t, err)
+}
+
+func TestNashvilleChordSheet(t *testing.T) {
+	t.Parallel()
+
+	mockCS := datatypes.JSON([]byte(`{"Verse": "[G]Foo [D/F#]bar [x2]", "Chorus": "[C]Baz [Em]"}`))
+
+	numbered, err := util.NashvilleChordSheet(mockCS, "G")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"Verse": "[1]Foo [5/7]bar [x2]", "Chorus": "[4]Baz [6m]"}`, numbered.String())
+
+	_, err = util.NashvilleChordSheet(mockCS, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,119 @@
+package util_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestPDFBytes(t *testing.T) {
+	t.Parallel()
+
+	pdf := util.NewPDF()
+	pdf.Write(util.PDFFontRegular, 12, "Foo (bar) \\ café")
+	pdf.AddPage()
+	pdf.Write(util.PDFFontMono, 10, "G   D/F#")
+
+	output := pdf.Bytes()
+
+	assert.True(t, bytes.HasPrefix(output, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(output, []byte("%%EOF\n")))
+	assert.Contains(t, string(output), "/Count 2")
+	assert.Contains(t, string(output), "(Foo \\(bar\\) \\\\ caf\xe9) Tj")
+	assert.Contains(t, string(output), "(G   D/F#) Tj")
+	assert.Equal(t, output, pdf.Bytes())
+}
+
+func TestPDFPageBreak(t *testing.T) {
+	t.Parallel()
+
+	pdf := util.NewPDF()
+
+	for i := 0; i < 100; i++ {
+		pdf.Write(util.PDFFontRegular, 12, "Foobar")
+	}
+
+	assert.Contains(t, string(pdf.Bytes()), "/Count 3")
+}
+
+func TestPDFWrap(t *testing.T) {
+	t.Parallel()
+
+	pdf := util.NewPDF()
+	pdf.Write(util.PDFFontRegular, 12, strings.Repeat("foobar ", 40))
+
+	assert.Equal(t, 4, strings.Count(string(pdf.Bytes()), "Tj"))
+}
+
+func TestRenderBookletPDF(t *testing.T) {
+	t.Parallel()
+
+	booklet := &domain.Booklet{
+		Setlist: domain.Setlist{
+			ID:       1,
+			Name:     "Sunday service",
+			Deadline: time.Date(2023, time.May, 7, 10, 0, 0, 0, time.UTC),
+		},
+		Assignments: []domain.UserRole{
+			{
+				User: &domain.User{FirstName: "John", LastName: "Doe"},
+				Role: &domain.Role{Name: "Guitar"},
+			},
+		},
+		Charts: []domain.Chart{
+			{
+				Sheet: domain.Sheet{
+					Title:       "Foo",
+					OriginalKey: "G",
+					Key:         "A",
+					Transpose:   2,
+					Bpm:         120,
+					ChordSheet:  datatypes.JSON([]byte(`{"Chorus":"[D]Bar","Verse":"[A]Foo [E]bar"}`)),
+				},
+				Arrangement: []string{"Verse", "Chorus"},
+				Notes:       "Start soft",
+			},
+		},
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		output, err := util.RenderBookletPDF(booklet)
+		assert.NoError(t, err)
+
+		content := string(output)
+		assert.Contains(t, content, "/Count 2")
+		assert.Contains(t, content, "(Sunday service) Tj")
+		assert.Contains(t, content, "(Sunday 7 May 2023 10:00) Tj")
+		assert.Contains(t, content, "(Guitar: John Doe) Tj")
+		assert.Contains(t, content, "(1. Foo \\(A\\)) Tj")
+		assert.Contains(t, content, "(Key: A | BPM: 120 | Transposed +2 from G) Tj")
+		assert.Contains(t, content, "(Order: Verse - Chorus) Tj")
+		assert.Contains(t, content, "(Notes: Start soft) Tj")
+		assert.Contains(t, content, "(A   E) Tj")
+		assert.Contains(t, content, "(Foo bar) Tj")
+		assert.Less(t, strings.Index(content, "(Verse) Tj"), strings.Index(content, "(Chorus) Tj"))
+	})
+
+	t.Run("Fail invalid chordsheet", func(t *testing.T) {
+		t.Parallel()
+
+		invalid := &domain.Booklet{
+			Setlist: booklet.Setlist,
+			Charts: []domain.Chart{
+				{Sheet: domain.Sheet{Title: "Foo", ChordSheet: datatypes.JSON([]byte(`["Verse"]`))}},
+			},
+		}
+
+		output, err := util.RenderBookletPDF(invalid)
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
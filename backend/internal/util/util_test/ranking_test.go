@@ -0,0 +1,134 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func ranksOf(entries []domain.SetlistEntry) map[int64]int64 {
+	ranks := make(map[int64]int64, len(entries))
+	for _, entry := range entries {
+		ranks[entry.ID] = entry.Rank
+	}
+
+	return ranks
+}
+
+func TestSortEntries(t *testing.T) {
+	t.Parallel()
+
+	entries := []domain.SetlistEntry{
+		{ID: 4, SetlistID: 2, Rank: 100},
+		{ID: 3, SetlistID: 1, Rank: 200},
+		{ID: 2, SetlistID: 1, Rank: 100},
+		{ID: 1, SetlistID: 1, Rank: 200},
+	}
+
+	util.SortEntries(entries)
+
+	ids := make([]int64, len(entries))
+	for idx, entry := range entries {
+		ids[idx] = entry.ID
+	}
+
+	assert.Equal(t, []int64{2, 1, 3, 4}, ids)
+}
+
+func TestRerankEntries(t *testing.T) {
+	t.Parallel()
+
+	entries := []domain.SetlistEntry{
+		{ID: 1, Rank: 1024},
+		{ID: 2, Rank: 2048},
+		{ID: 3, Rank: 3072},
+		{ID: 4, Rank: 4096},
+	}
+
+	t.Run("Correct move to the middle", func(t *testing.T) {
+		t.Parallel()
+
+		reranked, changed, err := util.RerankEntries(entries, []int64{1, 4, 2, 3})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64]int64{1: 1024, 4: 1536, 2: 2048, 3: 3072}, ranksOf(reranked))
+		assert.Equal(t, []domain.SetlistEntry{{ID: 4, Rank: 1536}}, changed)
+	})
+
+	t.Run("Correct move to the front", func(t *testing.T) {
+		t.Parallel()
+
+		reranked, changed, err := util.RerankEntries(entries, []int64{4, 1, 2, 3})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{4, 1, 2, 3}, []int64{reranked[0].ID, reranked[1].ID, reranked[2].ID, reranked[3].ID})
+		assert.Equal(t, []domain.SetlistEntry{{ID: 4, Rank: 512}}, changed)
+	})
+
+	t.Run("Correct move to the back", func(t *testing.T) {
+		t.Parallel()
+
+		_, changed, err := util.RerankEntries(entries, []int64{2, 3, 4, 1})
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.SetlistEntry{{ID: 1, Rank: 5120}}, changed)
+	})
+
+	t.Run("Correct unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		reranked, changed, err := util.RerankEntries(entries, []int64{1, 2, 3, 4})
+		assert.NoError(t, err)
+		assert.Equal(t, entries, reranked)
+		assert.Empty(t, changed)
+	})
+
+	t.Run("Correct rebalance", func(t *testing.T) {
+		t.Parallel()
+
+		crowded := []domain.SetlistEntry{
+			{ID: 1, Rank: 1},
+			{ID: 2, Rank: 2},
+			{ID: 3, Rank: 3},
+		}
+
+		reranked, changed, err := util.RerankEntries(crowded, []int64{3, 1, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64]int64{3: 1024, 1: 2048, 2: 3072}, ranksOf(reranked))
+		assert.Len(t, changed, 3)
+	})
+
+	t.Run("Correct duplicate ranks", func(t *testing.T) {
+		t.Parallel()
+
+		duplicates := []domain.SetlistEntry{
+			{ID: 1, Rank: 0},
+			{ID: 2, Rank: 0},
+			{ID: 3, Rank: 0},
+		}
+
+		reranked, _, err := util.RerankEntries(duplicates, []int64{3, 2, 1})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64]int64{3: 1024, 2: 2048, 1: 3072}, ranksOf(reranked))
+	})
+
+	t.Run("Fail missing entry", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := util.RerankEntries(entries, []int64{1, 2, 3})
+		assert.Error(t, err)
+	})
+
+	t.Run("Fail duplicate entry", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := util.RerankEntries(entries, []int64{1, 2, 3, 3})
+		assert.Error(t, err)
+	})
+
+	t.Run("Fail unknown entry", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := util.RerankEntries(entries, []int64{1, 2, 3, 5})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,170 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		recurrence, err := util.ParseRecurrence("RRULE:FREQ=WEEKLY;BYDAY=SU;BYHOUR=10;BYMINUTE=0")
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.Recurrence{
+			Frequency: domain.Weekly,
+			Interval:  1,
+			ByDay:     []domain.RecurrenceDay{{Weekday: time.Sunday}},
+			ByHour:    []int{10},
+			ByMinute:  []int{0},
+		}, recurrence)
+
+		recurrence, err = util.ParseRecurrence("freq=monthly;byday=1fr,-1su;interval=2;until=20241231")
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.Recurrence{
+			Frequency: domain.Monthly,
+			Interval:  2,
+			ByDay:     []domain.RecurrenceDay{{Weekday: time.Friday, Ordinal: 1}, {Weekday: time.Sunday, Ordinal: -1}},
+			Until:     time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC),
+		}, recurrence)
+
+		recurrence, err = util.ParseRecurrence("FREQ=MONTHLY;BYMONTHDAY=15,-1;COUNT=6")
+		assert.NoError(t, err)
+		assert.Equal(t, []int{-1, 15}, recurrence.ByMonthDay)
+		assert.Equal(t, 6, recurrence.Count)
+	})
+
+	t.Run("Fail invalid rule", func(t *testing.T) {
+		t.Parallel()
+
+		invalid := []string{
+			"",
+			"BYDAY=SU",
+			"FREQ=YEARLY",
+			"FREQ=WEEKLY;BYDAY=XX",
+			"FREQ=WEEKLY;BYDAY=1SU",
+			"FREQ=WEEKLY;BYMONTHDAY=1",
+			"FREQ=MONTHLY;BYDAY=6SU",
+			"FREQ=MONTHLY;BYMONTHDAY=32",
+			"FREQ=DAILY;BYHOUR=24",
+			"FREQ=DAILY;BYMINUTE=60",
+			"FREQ=DAILY;INTERVAL=0",
+			"FREQ=DAILY;COUNT=2;UNTIL=20241231",
+			"FREQ=DAILY;UNTIL=2024-12-31",
+			"FREQ=DAILY;FREQ=WEEKLY",
+			"FREQ=DAILY;WKST=MO",
+			"FREQ=DAILY;COUNT",
+		}
+
+		for _, rule := range invalid {
+			_, err := util.ParseRecurrence(rule)
+			assert.Error(t, err, rule)
+		}
+	})
+}
+
+func occurrences(t *testing.T, rule string, start time.Time, to time.Time, limit int) []time.Time {
+	t.Helper()
+
+	recurrence, err := util.ParseRecurrence(rule)
+	assert.NoError(t, err)
+
+	return util.Occurrences(recurrence, start, start.Location(), start, to, limit)
+}
+
+func TestOccurrences(t *testing.T) {
+	t.Parallel()
+
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	assert.NoError(t, err)
+
+	t.Run("Correct weekly across daylight saving", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2024, time.March, 17, 0, 0, 0, 0, amsterdam)
+		dates := occurrences(t, "FREQ=WEEKLY;BYDAY=SU;BYHOUR=10;BYMINUTE=0", start, start.AddDate(0, 0, 28), 10)
+
+		assert.Equal(t, []time.Time{
+			time.Date(2024, time.March, 17, 10, 0, 0, 0, amsterdam),
+			time.Date(2024, time.March, 24, 10, 0, 0, 0, amsterdam),
+			time.Date(2024, time.March, 31, 10, 0, 0, 0, amsterdam),
+			time.Date(2024, time.April, 7, 10, 0, 0, 0, amsterdam),
+		}, dates)
+		assert.Equal(t, 9, dates[1].UTC().Hour())
+		assert.Equal(t, 8, dates[2].UTC().Hour())
+	})
+
+	t.Run("Correct monthly ordinal weekday", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2024, time.January, 1, 0, 0, 0, 0, amsterdam)
+		dates := occurrences(t, "FREQ=MONTHLY;BYDAY=1FR;BYHOUR=19;BYMINUTE=30", start, start.AddDate(0, 3, 0), 10)
+
+		assert.Equal(t, []time.Time{
+			time.Date(2024, time.January, 5, 19, 30, 0, 0, amsterdam),
+			time.Date(2024, time.February, 2, 19, 30, 0, 0, amsterdam),
+			time.Date(2024, time.March, 1, 19, 30, 0, 0, amsterdam),
+		}, dates)
+
+		dates = occurrences(t, "FREQ=MONTHLY;BYDAY=-1SU", start, start.AddDate(0, 2, 0), 10)
+		assert.Equal(t, []time.Time{
+			time.Date(2024, time.January, 28, 0, 0, 0, 0, amsterdam),
+			time.Date(2024, time.February, 25, 0, 0, 0, 0, amsterdam),
+		}, dates)
+	})
+
+	t.Run("Correct monthly skips short months", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2024, time.January, 31, 9, 0, 0, 0, time.UTC)
+		dates := occurrences(t, "FREQ=MONTHLY", start, start.AddDate(0, 3, 0), 10)
+
+		assert.Equal(t, []time.Time{
+			time.Date(2024, time.January, 31, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, time.March, 31, 9, 0, 0, 0, time.UTC),
+		}, dates)
+
+		dates = occurrences(t, "FREQ=MONTHLY;BYMONTHDAY=-1", start, start.AddDate(0, 2, 0), 10)
+		assert.Equal(t, time.Date(2024, time.February, 29, 9, 0, 0, 0, time.UTC), dates[1])
+	})
+
+	t.Run("Correct interval, count and until", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2024, time.January, 7, 10, 0, 0, 0, time.UTC)
+		to := start.AddDate(1, 0, 0)
+
+		dates := occurrences(t, "FREQ=WEEKLY;INTERVAL=2;COUNT=3", start, to, 10)
+		assert.Equal(t, []time.Time{start, start.AddDate(0, 0, 14), start.AddDate(0, 0, 28)}, dates)
+
+		dates = occurrences(t, "FREQ=DAILY;UNTIL=20240109", start, to, 10)
+		assert.Len(t, dates, 3)
+
+		dates = occurrences(t, "FREQ=DAILY;BYDAY=MO,WE", start, to, 4)
+		assert.Equal(t, []time.Time{
+			start.AddDate(0, 0, 1), start.AddDate(0, 0, 3), start.AddDate(0, 0, 8), start.AddDate(0, 0, 10),
+		}, dates)
+	})
+
+	t.Run("Correct from later than start", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2024, time.January, 7, 10, 0, 0, 0, time.UTC)
+		from := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+		recurrence, err := util.ParseRecurrence("FREQ=WEEKLY;COUNT=10")
+		assert.NoError(t, err)
+
+		dates := util.Occurrences(recurrence, start, time.UTC, from, from.AddDate(1, 0, 0), 10)
+		assert.Equal(t, []time.Time{
+			time.Date(2024, time.March, 3, 10, 0, 0, 0, time.UTC),
+			time.Date(2024, time.March, 10, 10, 0, 0, 0, time.UTC),
+		}, dates)
+	})
+}
@@ -0,0 +1,144 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+var mockSearchSongs = []domain.Song{
+	{
+		ID:         1,
+		Title:      "Amazing Grace",
+		Subtitle:   "My Chains Are Gone",
+		ChordSheet: datatypes.JSON([]byte(`[{"tag":"Verse 1","text":"[G]Amazing grace, how [C]sweet the [G]sound\nThat saved a wretch like [D]me"},{"tag":"Chorus","text":"My [C]chains are [G]gone"}]`)),
+	},
+	{
+		ID:         2,
+		Title:      "Holy Spirit",
+		ChordSheet: datatypes.JSON([]byte(`{"Verse":"There's nothing worth more, that will [D]ever come close\nNo thing can compare, You're our living hope"}`)),
+	},
+	{
+		ID:         3,
+		Title:      "Père Éternel",
+		ChordSheet: datatypes.JSON([]byte(`{"Chorus":"[A]Gloire à [E]toi, Père [D]éternel"}`)),
+	},
+}
+
+func TestFoldText(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "pere eternel", util.FoldText("Père Éternel"))
+	assert.Equal(t, "grace", util.FoldText("GRÂCE"))
+	assert.Equal(t, len([]rune("Ça ira")), len([]rune(util.FoldText("Ça ira"))))
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	index := util.NewSearchIndex(mockSearchSongs)
+
+	results := index.Search("sweet sound", 10)
+	assert.Len(t, results, 1)
+	assert.Equal(t, int64(1), results[0].Song.ID)
+	assert.Equal(t, "Verse 1", results[0].Section)
+	assert.Equal(t, "Amazing grace, how sweet the sound", results[0].Snippet)
+	assert.Equal(t, []domain.TextRange{{Start: 19, End: 24}, {Start: 29, End: 34}}, results[0].Highlights)
+
+	results = index.Search("sweet hope", 10)
+	assert.Empty(t, results)
+
+	results = index.Search("", 10)
+	assert.Empty(t, results)
+}
+
+func TestSearchIndexPutRemove(t *testing.T) {
+	t.Parallel()
+
+	index := util.NewSearchIndex(mockSearchSongs)
+
+	index.Put(domain.Song{ID: 2, Title: "Living Hope"})
+	index.Put(domain.Song{ID: 4, Title: "Sweet Hour"})
+
+	results := index.Search("hope", 10)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "Living Hope", results[0].Song.Title)
+
+	results = index.Search("sweet", 10)
+	assert.Len(t, results, 2)
+
+	index.Remove(1, 4)
+
+	results = index.Search("sweet", 10)
+	assert.Empty(t, results)
+}
+
+func TestSearchFolding(t *testing.T) {
+	t.Parallel()
+
+	index := util.NewSearchIndex(mockSearchSongs)
+
+	results := index.Search("ETERNEL", 10)
+	assert.Len(t, results, 1)
+	assert.Equal(t, int64(3), results[0].Song.ID)
+	assert.Equal(t, "Gloire à toi, Père éternel", results[0].Snippet)
+	assert.Equal(t, []domain.TextRange{{Start: 19, End: 26}}, results[0].Highlights)
+}
+
+func TestSearchChordsStripped(t *testing.T) {
+	t.Parallel()
+
+	index := util.NewSearchIndex(mockSearchSongs)
+
+	assert.Empty(t, index.Search("D", 10))
+}
+
+func TestSearchPrefix(t *testing.T) {
+	t.Parallel()
+
+	index := util.NewSearchIndex(mockSearchSongs)
+
+	results := index.Search("living ho", 10)
+	assert.Len(t, results, 1)
+	assert.Equal(t, int64(2), results[0].Song.ID)
+
+	assert.Empty(t, index.Search("ho living", 10))
+}
+
+func TestSearchRanking(t *testing.T) {
+	t.Parallel()
+
+	songs := []domain.Song{
+		{ID: 1, Title: "Foo", ChordSheet: datatypes.JSON([]byte(`{"Verse":"grace upon grace"}`))},
+		{ID: 2, Title: "Grace", ChordSheet: datatypes.JSON([]byte(`{"Verse":"Bar"}`))},
+		{ID: 3, Title: "Baz", ChordSheet: datatypes.JSON([]byte(`{"Verse":"Only grace"}`))},
+	}
+
+	results := util.NewSearchIndex(songs).Search("grace", 10)
+	assert.Len(t, results, 3)
+	assert.Equal(t, int64(2), results[0].Song.ID)
+	assert.Equal(t, int64(1), results[1].Song.ID)
+	assert.Equal(t, int64(3), results[2].Song.ID)
+	assert.Equal(t, "Grace", results[0].Snippet)
+	assert.Equal(t, "", results[0].Section)
+
+	results = util.NewSearchIndex(songs).Search("grace", 1)
+	assert.Len(t, results, 1)
+}
+
+func TestSearchPhrase(t *testing.T) {
+	t.Parallel()
+
+	songs := []domain.Song{
+		{ID: 1, Title: "Foo", ChordSheet: datatypes.JSON([]byte(`{"Verse":"living is hope"}`))},
+		{ID: 2, Title: "Bar", ChordSheet: datatypes.JSON([]byte(`{"Verse":"living hope"}`))},
+	}
+
+	results := util.NewSearchIndex(songs).Search("living hope", 10)
+	assert.Len(t, results, 2)
+	assert.Equal(t, int64(2), results[0].Song.ID)
+	assert.Greater(t, results[0].Score, results[1].Score)
+}
@@ -0,0 +1,126 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyDistance(t *testing.T) {
+	t.Parallel()
+
+	expecteds := []struct {
+		from     string
+		to       string
+		distance int
+	}{
+		{"C", "C", 0},
+		{"C", "D", 2},
+		{"D", "C", 2},
+		{"C", "F#", 6},
+		{"B", "C#", 2},
+		{"G", "Em", 0},
+		{"Am", "Bm", 2},
+		{"Db", "C#", 0},
+		{"C", "Bb", 2},
+	}
+
+	for _, exp := range expecteds {
+		distance, err := util.KeyDistance(exp.from, exp.to)
+		assert.NoError(t, err)
+		assert.Equal(t, exp.distance, distance, exp.from+" "+exp.to)
+	}
+
+	_, err := util.KeyDistance("C", "Cmaj7")
+	assert.Error(t, err)
+
+	_, err = util.KeyDistance("", "C")
+	assert.Error(t, err)
+}
+
+func TestAnalyzeSetlist(t *testing.T) {
+	t.Parallel()
+
+	songs := map[int64]domain.Song{
+		1: {ID: 1, Title: "Foo", Key: "C", Bpm: 70},
+		2: {ID: 2, Title: "Bar", Key: "F#", Bpm: 110},
+		3: {ID: 3, Title: "Baz", Key: "Em", Bpm: 100},
+		4: {ID: 4, Title: "Qux"},
+	}
+
+	t.Run("Flowing setlist", func(t *testing.T) {
+		t.Parallel()
+
+		entries := []domain.SetlistEntry{
+			{ID: 2, SongID: 3, Rank: 2},
+			{ID: 1, SongID: 2, Transpose: 1, Rank: 1},
+			{ID: 3, SongID: 4, Rank: 3},
+		}
+
+		analysis := util.AnalyzeSetlist(5, entries, songs)
+		assert.Equal(t, &domain.SetlistAnalysis{
+			SetlistID: 5,
+			Transitions: []domain.EntryTransition{
+				{FromEntryID: 1, ToEntryID: 2, FromKey: "G", ToKey: "Em", KeyDistance: 0, BpmChange: -10},
+				{FromEntryID: 2, ToEntryID: 3, FromKey: "Em"},
+			},
+			Warnings:    []domain.SetlistWarning{},
+			Suggestions: []domain.TransposeSuggestion{},
+		}, analysis)
+	})
+
+	t.Run("Awkward setlist", func(t *testing.T) {
+		t.Parallel()
+
+		entries := []domain.SetlistEntry{
+			{ID: 1, SongID: 1, Rank: 1},
+			{ID: 2, SongID: 2, Rank: 2},
+			{ID: 3, SongID: 1, Rank: 3},
+		}
+
+		analysis := util.AnalyzeSetlist(5, entries, songs)
+		assert.Equal(t, []domain.EntryTransition{
+			{FromEntryID: 1, ToEntryID: 2, FromKey: "C", ToKey: "F#", KeyDistance: 6, BpmChange: 40},
+			{FromEntryID: 2, ToEntryID: 3, FromKey: "F#", ToKey: "C", KeyDistance: 6, BpmChange: -40},
+		}, analysis.Transitions)
+		assert.Equal(t, []domain.SetlistWarning{
+			{Type: domain.KeyJumpWarning, FromEntryID: 1, ToEntryID: 2, Message: "key jumps 6 semitones from C to F#"},
+			{Type: domain.TempoSwingWarning, FromEntryID: 1, ToEntryID: 2, Message: "tempo changes from 70 to 110 bpm"},
+			{Type: domain.RepeatedSongWarning, FromEntryID: 1, ToEntryID: 3, Message: "Foo is already in the setlist"},
+			{Type: domain.KeyJumpWarning, FromEntryID: 2, ToEntryID: 3, Message: "key jumps 6 semitones from F# to C"},
+			{Type: domain.TempoSwingWarning, FromEntryID: 2, ToEntryID: 3, Message: "tempo changes from 110 to 70 bpm"},
+		}, analysis.Warnings)
+		assert.Equal(t, []domain.TransposeSuggestion{
+			{EntryID: 2, Transpose: -4, Key: "D"},
+			{EntryID: 3, Transpose: -4, Key: "Ab"},
+		}, analysis.Suggestions)
+	})
+
+	t.Run("Skips other items", func(t *testing.T) {
+		t.Parallel()
+
+		entries := []domain.SetlistEntry{
+			{ID: 1, Type: domain.SongItem, SongID: 1, Transpose: 2, Rank: 1},
+			{ID: 2, Type: domain.ScriptureItem, Reference: "John 3:16", Rank: 2},
+			{ID: 3, Type: domain.SongItem, SongID: 2, Transpose: -4, Rank: 3},
+		}
+
+		analysis := util.AnalyzeSetlist(5, entries, songs)
+		assert.Equal(t, []domain.EntryTransition{
+			{FromEntryID: 1, ToEntryID: 3, FromKey: "D", ToKey: "D", KeyDistance: 0, BpmChange: 40},
+		}, analysis.Transitions)
+		assert.Len(t, analysis.Warnings, 1)
+		assert.Empty(t, analysis.Suggestions)
+	})
+
+	t.Run("Empty setlist", func(t *testing.T) {
+		t.Parallel()
+
+		analysis := util.AnalyzeSetlist(5, nil, songs)
+		assert.Empty(t, analysis.Transitions)
+		assert.Empty(t, analysis.Warnings)
+		assert.Empty(t, analysis.Suggestions)
+	})
+}
@@ -0,0 +1,85 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestNormalizeScriptureReference(t *testing.T) {
+	t.Parallel()
+
+	expecteds := []struct {
+		reference  string
+		normalized string
+	}{
+		{"John 3:16", "John 3:16"},
+		{"  1  Cor 13:4-8 ", "1 Cor 13:4-8"},
+		{"Psalm 23", "Psalm 23"},
+		{"Psalm 23:1-3, 5", "Psalm 23:1-3, 5"},
+		{"Song of Songs 2:1", "Song of Songs 2:1"},
+		{"Gen 1:1-2:3", "Gen 1:1-2:3"},
+		{"1Jn. 4:8", "1Jn. 4:8"},
+		{"Jesaja 40:31", "Jesaja 40:31"},
+	}
+
+	for _, exp := range expecteds {
+		normalized, err := util.NormalizeScriptureReference(exp.reference)
+		assert.NoError(t, err, exp.reference)
+		assert.Equal(t, exp.normalized, normalized)
+	}
+
+	invalid := []string{"", "John", "3:16", "John 3:", "John 3:16-", "4 John 1:1", "John 3:16; 4:1"}
+	for _, reference := range invalid {
+		_, err := util.NormalizeScriptureReference(reference)
+		assert.Error(t, err, reference)
+	}
+}
+
+func TestNormalizeSetlistItem(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		song := &domain.SetlistEntry{SongID: 1, Transpose: 2}
+		assert.NoError(t, util.NormalizeSetlistItem(song))
+		assert.Equal(t, domain.SongItem, song.Type)
+
+		header := &domain.SetlistEntry{Type: domain.HeaderItem, Title: " Worship ", Duration: 60}
+		assert.NoError(t, util.NormalizeSetlistItem(header))
+		assert.Equal(t, "Worship", header.Title)
+
+		text := &domain.SetlistEntry{Type: domain.TextItem, Content: "Announcements", Arrangement: datatypes.JSON("null")}
+		assert.NoError(t, util.NormalizeSetlistItem(text))
+
+		scripture := &domain.SetlistEntry{Type: domain.ScriptureItem, Reference: "John  3:16"}
+		assert.NoError(t, util.NormalizeSetlistItem(scripture))
+		assert.Equal(t, "John 3:16", scripture.Reference)
+	})
+
+	t.Run("Fail", func(t *testing.T) {
+		t.Parallel()
+
+		invalid := []domain.SetlistEntry{
+			{Type: "sermon", Title: "Sermon"},
+			{Type: domain.SongItem, SongID: 1, Reference: "John 3:16"},
+			{Type: domain.HeaderItem, Title: "Worship", Reference: "John 3:16"},
+			{Type: domain.HeaderItem},
+			{Type: domain.HeaderItem, Title: "Worship", SongID: 1},
+			{Type: domain.TextItem, Title: "Prayer", Transpose: 1},
+			{Type: domain.TextItem, Title: "Prayer", Languages: "nl"},
+			{Type: domain.TextItem, Title: "Prayer", Arrangement: datatypes.JSON(`["Verse 1"]`)},
+			{Type: domain.TextItem, Title: " ", Content: " "},
+			{Type: domain.ScriptureItem},
+			{Type: domain.ScriptureItem, Reference: "John"},
+		}
+
+		for idx := range invalid {
+			assert.Error(t, util.NormalizeSetlistItem(&invalid[idx]), invalid[idx])
+		}
+	})
+}
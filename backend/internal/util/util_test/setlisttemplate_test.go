@@ -0,0 +1,70 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlotEntry(t *testing.T) {
+	t.Parallel()
+
+	placeholder := &domain.TemplateSlot{Name: "Opening song", Notes: "upbeat", Duration: 240, Rank: 1}
+	assert.Equal(t, domain.SetlistEntry{
+		Type:      domain.TextItem,
+		SetlistID: 2,
+		Title:     "Opening song",
+		Notes:     "upbeat",
+		Duration:  240,
+		Rank:      1,
+	}, util.SlotEntry(placeholder, 2))
+
+	song := &domain.TemplateSlot{Name: "Closing", Type: domain.SongItem, SongID: 3, Transpose: -1, Rank: 5}
+	assert.Equal(t, domain.SetlistEntry{
+		Type:      domain.SongItem,
+		SongID:    3,
+		SetlistID: 2,
+		Transpose: -1,
+		Rank:      5,
+	}, util.SlotEntry(song, 2))
+}
+
+func TestNormalizeTemplateSlot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Correct", func(t *testing.T) {
+		t.Parallel()
+
+		placeholder := &domain.TemplateSlot{Name: " Worship ", Rank: 1}
+		assert.NoError(t, util.NormalizeTemplateSlot(placeholder))
+		assert.Equal(t, "Worship", placeholder.Name)
+		assert.Equal(t, domain.SongItem, placeholder.Type)
+
+		scripture := &domain.TemplateSlot{Type: domain.ScriptureItem, Reference: "John  3:16", Rank: 2}
+		assert.NoError(t, util.NormalizeTemplateSlot(scripture))
+		assert.Equal(t, "John 3:16", scripture.Reference)
+
+		header := &domain.TemplateSlot{Type: domain.HeaderItem, Title: " Response ", Rank: 3}
+		assert.NoError(t, util.NormalizeTemplateSlot(header))
+		assert.Equal(t, "Response", header.Title)
+	})
+
+	t.Run("Fail invalid slot", func(t *testing.T) {
+		t.Parallel()
+
+		invalid := []domain.TemplateSlot{
+			{Rank: 1},
+			{Name: "Worship", Title: "Worship", Rank: 1},
+			{Name: "Worship", Transpose: 2, Rank: 1},
+			{Type: domain.HeaderItem, Rank: 1},
+			{Type: domain.ScriptureItem, Reference: "John", Rank: 1},
+			{Type: "video", Title: "Intro", Rank: 1},
+		}
+
+		for idx := range invalid {
+			assert.Error(t, util.NormalizeTemplateSlot(&invalid[idx]), invalid[idx])
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/96Asch/mkvstage-server/backend/internal/domain"
+	"github.com/96Asch/mkvstage-server/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestParseLanguages(t *testing.T) {
+	t.Parallel()
+
+	languages, err := util.ParseLanguages(" NL, en-us,,nl ")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"nl", "en-US"}, languages)
+
+	languages, err = util.ParseLanguages("")
+	assert.NoError(t, err)
+	assert.Empty(t, languages)
+
+	expErr := domain.NewBadRequestErr("")
+	_, err = util.ParseLanguages("nl,not a language")
+	assert.ErrorAs(t, err, &expErr)
+}
+
+func TestValidateTranslation(t *testing.T) {
+	t.Parallel()
+
+	chordsheet := datatypes.JSON([]byte(`[{"tag": "Verse 1", "text": "[G]Amazing grace\nHow [D]sweet"}, {"tag": "Chorus", "text": "[C]Free"}]`))
+
+	assert.NoError(t, util.ValidateTranslation(datatypes.JSON([]byte(`{"Verse 1": "Genade zo groot\nHoe zoet"}`)), chordsheet))
+	assert.Error(t, util.ValidateTranslation(datatypes.JSON([]byte(`{"Bridge": "Vrij"}`)), chordsheet))
+	assert.Error(t, util.ValidateTranslation(datatypes.JSON([]byte(`{"Verse 1": "Genade zo groot"}`)), chordsheet))
+	assert.Error(t, util.ValidateTranslation(datatypes.JSON([]byte(`{"Chorus": "[C]Vrij"}`)), chordsheet))
+	assert.Error(t, util.ValidateTranslation(datatypes.JSON([]byte(`["Vrij"]`)), chordsheet))
+}
+
+func TestTranslateChordSheet(t *testing.T) {
+	t.Parallel()
+
+	chordsheet := datatypes.JSON([]byte(`[{"tag": "Verse 1", "text": "[G]Amazing [C]grace\nHow sweet[D]"}, {"tag": "Chorus", "text": "[C]Free"}]`))
+	lyrics := datatypes.JSON([]byte(`{"Verse 1": "Genade zo groot\nHoe zoet klinkt het"}`))
+
+	translated, err := util.TranslateChordSheet(chordsheet, lyrics)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"tag": "Verse 1", "text": "[G]Genade zo[C] groot\nHoe zoet klinkt het[D]"},
+		{"tag": "Chorus", "text": "[C]Free"}
+	]`, translated.String())
+}
+
+func TestAddTranslatedLines(t *testing.T) {
+	t.Parallel()
+
+	document, err := util.ParseChordSheet(datatypes.JSON([]byte(`[{"tag": "Chorus", "text": "[C]Free\n[G]Gone"}]`)))
+	assert.NoError(t, err)
+
+	err = util.AddTranslatedLines(document, "nl", datatypes.JSON([]byte(`[{"tag": "Chorus", "text": "[C]Vrij\n[G]Weg"}]`)))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"nl": "Vrij"}, document.Sections[0].Lines[0].Translations)
+	assert.Equal(t, map[string]string{"nl": "Weg"}, document.Sections[0].Lines[1].Translations)
+}
@@ -9,6 +9,8 @@ import (
 	"reflect"
 	"syscall"
 	"time"
+	// The runtime image has no zoneinfo, series are planned in named timezones.
+	_ "time/tzdata"
 
 	"github.com/96Asch/mkvstage-server/backend/internal/domain"
 	"github.com/96Asch/mkvstage-server/backend/internal/handler"
@@ -65,11 +67,22 @@ func setupMigrations(gormDatabase *gorm.DB) error {
 		&domain.User{},
 		&domain.Bundle{},
 		&domain.Song{},
+		&domain.SongRevision{},
+		&domain.SongTranslation{},
 		&domain.Role{},
 		&domain.UserRole{},
 		&domain.Setlist{},
 		&domain.SetlistEntry{},
 		&domain.SetlistRole{},
+		&domain.SetlistTemplate{},
+		&domain.TemplateSlot{},
+		&domain.TemplateRole{},
+		&domain.SetlistSeries{},
+		&domain.SeriesOccurrence{},
+		&domain.Tag{},
+		&domain.SongTag{},
+		&domain.Attachment{},
+		&domain.CalendarToken{},
 	}
 
 	for _, model := range models {
@@ -81,6 +94,26 @@ func setupMigrations(gormDatabase *gorm.DB) error {
 		}
 	}
 
+	return backfillBundleVersions(gormDatabase)
+}
+
+// backfillBundleVersions gives the bundles stored before bundles were versioned an update
+// time, without one their version is empty and changing them would not be conditional.
+func backfillBundleVersions(gormDatabase *gorm.DB) error {
+	res := gormDatabase.
+		Unscoped().
+		Model(&domain.Bundle{}).
+		Where("updated_at IS NULL OR updated_at < ?", time.Date(1970, time.January, 2, 0, 0, 0, 0, time.UTC)).
+		Update("updated_at", time.Now())
+
+	if err := res.Error; err != nil {
+		return domain.NewInitializationErr(err.Error())
+	}
+
+	if res.RowsAffected > 0 {
+		log.Printf("Backfilled the update time of %d bundles", res.RowsAffected)
+	}
+
 	return nil
 }
 
@@ -125,22 +158,54 @@ func main() {
 	userRepo := repository.NewGormUserRepository(database)
 	bundleRepo := repository.NewGormBundleRepository(database)
 	songRepo := repository.NewGormSongRepository(database)
+	songRevisionRepo := repository.NewGormSongRevisionRepository(database)
+	songTranslationRepo := repository.NewGormSongTranslationRepository(database)
 	userroleRepo := repository.NewGormUserRoleRepository(database)
 	roleRepo := repository.NewGormRoleRepository(database)
 	setlistRepo := repository.NewGormSetlistRepository(database)
 	setlistEntryRepo := repository.NewGormSetlistEntryRepository(database)
 	setlistRoleRepo := repository.NewGormSetlistRoleRepository(database)
+	statsRepo := repository.NewGormStatsRepository(database)
+	tagRepo := repository.NewGormTagRepository(database)
+	attachmentRepo := repository.NewGormAttachmentRepository(database)
+	templateRepo := repository.NewGormSetlistTemplateRepository(database)
+	seriesRepo := repository.NewGormSetlistSeriesRepository(database)
+	calendarTokenRepo := repository.NewGormCalendarTokenRepository(database)
+	transactor := repository.NewGormTransactor(database)
+
+	attachmentDir := os.Getenv("ATTACHMENT_DIR")
+	if attachmentDir == "" {
+		attachmentDir = "./attachments"
+	}
+
+	blobStore, err := store.NewLocalBlobStore(attachmentDir)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	userService := service.NewUserService(userRepo, roleRepo, userroleRepo)
+	userService := service.NewUserService(userRepo, roleRepo, userroleRepo, transactor)
 	tokenService := service.NewTokenService(accessSecret)
 	mhw := middleware.NewGinMiddlewareHandler(userService, tokenService)
 	bundleService := service.NewBundleService(bundleRepo)
-	songService := service.NewSongService(userRepo, songRepo, bundleRepo)
+	songService := service.NewSongService(userRepo, songRepo, bundleRepo, songRevisionRepo, songTranslationRepo)
 	userroleService := service.NewUserRoleService(userroleRepo)
-	roleService := service.NewRoleService(roleRepo, userRepo, userroleRepo)
+	roleService := service.NewRoleService(roleRepo, userRepo, userroleRepo, transactor)
 	setlistService := service.NewSetlistService(userRepo, setlistRepo)
-	setlistEntryService := service.NewSetlistEntryService(setlistEntryRepo, setlistRepo, songRepo)
+	setlistEntryService := service.NewSetlistEntryService(setlistEntryRepo, setlistRepo, songRepo, songTranslationRepo)
 	setlistRoleService := service.NewSetlistRoleService(setlistRoleRepo, setlistRepo, userroleRepo)
+	exportService := service.NewExportService(setlistRepo, setlistEntryRepo, songRepo, setlistRoleRepo, userroleRepo)
+	statsService := service.NewStatsService(statsRepo, songRepo)
+	tagService := service.NewTagService(tagRepo, songRepo)
+	attachmentService := service.NewAttachmentService(attachmentRepo, blobStore, songRepo, setlistRepo, setlistEntryRepo)
+	templateService := service.NewSetlistTemplateService(
+		templateRepo, setlistRepo, setlistEntryRepo, setlistRoleRepo, songRepo, roleRepo, userroleRepo, transactor,
+	)
+	seriesService := service.NewSetlistSeriesService(
+		seriesRepo, templateRepo, setlistRepo, setlistEntryRepo, setlistRoleRepo, transactor,
+	)
+	calendarService := service.NewCalendarService(
+		calendarTokenRepo, userRepo, setlistRepo, setlistEntryRepo, songRepo, setlistRoleRepo, userroleRepo,
+	)
 
 	config := handler.Config{
 		Router: router,
@@ -154,6 +219,14 @@ func main() {
 		SL:     setlistService,
 		SE:     setlistEntryService,
 		SLR:    setlistRoleService,
+		EX:     exportService,
+		ST:     statsService,
+		TG:     tagService,
+		AT:     attachmentService,
+		TP:     templateService,
+		SS:     seriesService,
+		CL:     calendarService,
+		TX:     transactor,
 	}
 
 	run(&config)